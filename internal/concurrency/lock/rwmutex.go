@@ -0,0 +1,91 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package lock
+
+import (
+	"sync"
+)
+
+// RWMutex is a wrapper around sync.RWMutex with a switch for concurrent safe feature.
+// When the safe mode is disabled, all locking operations become no-ops, so
+// containers can offer an unlocked fast path without duplicating their code.
+type RWMutex struct {
+	// mutex is the underlying sync.RWMutex used when safe mode is enabled.
+	// When nil, the mutex is in unsafe mode (no locking occurs).
+	mutex *sync.RWMutex
+}
+
+// NewRWMutex creates and returns a new *RWMutex.
+//
+// Parameters:
+//   - safe: Optional boolean indicating whether the mutex should operate in thread-safe mode.
+//     If not provided or false, the mutex will not perform actual locking (unsafe mode).
+//     If true, the mutex will use an underlying sync.RWMutex for thread safety.
+//
+// Returns:
+//   - A pointer to a newly created RWMutex object
+func NewRWMutex(safe ...bool) *RWMutex {
+	mu := CreateRWMutex(safe...)
+	return &mu
+}
+
+// CreateRWMutex creates and returns a new RWMutex object (not a pointer).
+//
+// Parameters:
+//   - safe: Optional boolean indicating whether the mutex should operate in thread-safe mode.
+//     If not provided or false, the mutex will not perform actual locking (unsafe mode).
+//     If true, the mutex will use an underlying sync.RWMutex for thread safety.
+//
+// Returns:
+//   - A newly created RWMutex object
+func CreateRWMutex(safe ...bool) RWMutex {
+	if len(safe) > 0 && safe[0] {
+		return RWMutex{
+			mutex: new(sync.RWMutex),
+		}
+	}
+	return RWMutex{}
+}
+
+// IsSafe checks and returns whether current mutex is in concurrent-safe usage.
+//
+// Returns:
+//   - true if the mutex is operating in thread-safe mode
+//   - false if the mutex is operating in unsafe mode (no locking)
+func (mu *RWMutex) IsSafe() bool {
+	return mu.mutex != nil
+}
+
+// Lock acquires an exclusive (writing) lock on the mutex.
+// If the mutex is in unsafe mode (not concurrent-safe), this operation does nothing.
+func (mu *RWMutex) Lock() {
+	if mu.mutex != nil {
+		mu.mutex.Lock()
+	}
+}
+
+// Unlock releases an exclusive (writing) lock on the mutex.
+// If the mutex is in unsafe mode (not concurrent-safe), this operation does nothing.
+func (mu *RWMutex) Unlock() {
+	if mu.mutex != nil {
+		mu.mutex.Unlock()
+	}
+}
+
+// RLock acquires a shared (reading) lock on the mutex.
+// If the mutex is in unsafe mode (not concurrent-safe), this operation does nothing.
+func (mu *RWMutex) RLock() {
+	if mu.mutex != nil {
+		mu.mutex.RLock()
+	}
+}
+
+// RUnlock releases a shared (reading) lock on the mutex.
+// If the mutex is in unsafe mode (not concurrent-safe), this operation does nothing.
+func (mu *RWMutex) RUnlock() {
+	if mu.mutex != nil {
+		mu.mutex.RUnlock()
+	}
+}
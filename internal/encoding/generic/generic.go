@@ -0,0 +1,76 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package generic normalizes generic document values, the maps, slices and
+// scalars shared by the codecs of pkg/encoding.
+package generic
+
+import (
+	"encoding/json"
+
+	"github.com/focela/aegis/pkg/conv"
+)
+
+// StringKeys converts maps with non-string keys to map[string]interface{},
+// recursively, converting the keys with conv.String, and slices of maps, such
+// as TOML arrays of tables, to []interface{}. Maps and slices that are
+// already generic are updated in place.
+func StringKeys(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for k, item := range v {
+			v[k] = StringKeys(item)
+		}
+		return v
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			m[conv.String(k)] = StringKeys(item)
+		}
+		return m
+	case []interface{}:
+		for i, item := range v {
+			v[i] = StringKeys(item)
+		}
+		return v
+	case []map[string]interface{}:
+		s := make([]interface{}, len(v))
+		for i, item := range v {
+			s[i] = StringKeys(item)
+		}
+		return s
+	default:
+		return value
+	}
+}
+
+// Numbers returns a copy of `value` in which json.Number values are replaced
+// by int64 or float64, recursively, so that encoders of other formats write
+// them as numbers rather than strings. Other values are returned unchanged.
+func Numbers(value interface{}) interface{} {
+	switch v := value.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		if f, err := v.Float64(); err == nil {
+			return f
+		}
+		return v.String()
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			m[k] = Numbers(item)
+		}
+		return m
+	case []interface{}:
+		s := make([]interface{}, len(v))
+		for i, item := range v {
+			s[i] = Numbers(item)
+		}
+		return s
+	default:
+		return value
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package radix converts byte strings to and from arbitrary-base text by
+// treating them as big-endian integers, as base58 and base62 do.
+package radix
+
+import "fmt"
+
+// Alphabet maps digits to characters and back for one base.
+type Alphabet struct {
+	// digits holds the character of each digit.
+	digits string
+
+	// values maps characters to digits; -1 marks invalid characters.
+	values [256]int8
+}
+
+// NewAlphabet creates and returns an Alphabet whose base is the length of
+// `digits`. It panics if `digits` has repeated or non-ASCII characters.
+func NewAlphabet(digits string) *Alphabet {
+	a := &Alphabet{digits: digits}
+	for i := range a.values {
+		a.values[i] = -1
+	}
+	for i := 0; i < len(digits); i++ {
+		if digits[i] >= 0x80 || a.values[digits[i]] != -1 {
+			panic(fmt.Sprintf("radix: invalid alphabet %q", digits))
+		}
+		a.values[digits[i]] = int8(i)
+	}
+	return a
+}
+
+// Encode returns the digits of `src`. Each leading zero byte is written as
+// one zero digit, so leading zeros survive a round trip.
+func (a *Alphabet) Encode(src []byte) string {
+	base := uint32(len(a.digits))
+	zeros := 0
+	for zeros < len(src) && src[zeros] == 0 {
+		zeros++
+	}
+
+	// Repeatedly divide the number by the base, collecting the remainders in
+	// little-endian order
+	digits := make([]byte, 0, len(src)*138/100+1)
+	for _, b := range src[zeros:] {
+		carry := uint32(b)
+		for i := range digits {
+			carry += uint32(digits[i]) << 8
+			digits[i] = byte(carry % base)
+			carry /= base
+		}
+		for carry > 0 {
+			digits = append(digits, byte(carry%base))
+			carry /= base
+		}
+	}
+
+	out := make([]byte, zeros+len(digits))
+	for i := 0; i < zeros; i++ {
+		out[i] = a.digits[0]
+	}
+	for i, d := range digits {
+		out[len(out)-1-i] = a.digits[d]
+	}
+	return string(out)
+}
+
+// Decode returns the bytes encoded by `s`.
+func (a *Alphabet) Decode(s string) ([]byte, error) {
+	base := uint32(len(a.digits))
+	zeros := 0
+	for zeros < len(s) && s[zeros] == a.digits[0] {
+		zeros++
+	}
+
+	// Multiply the number by the base digit by digit, keeping the bytes in
+	// little-endian order
+	bytes := make([]byte, 0, len(s))
+	for i := zeros; i < len(s); i++ {
+		v := a.values[s[i]]
+		if v < 0 {
+			return nil, fmt.Errorf("invalid character %q at offset %d", s[i], i)
+		}
+		carry := uint32(v)
+		for j := range bytes {
+			carry += uint32(bytes[j]) * base
+			bytes[j] = byte(carry)
+			carry >>= 8
+		}
+		for carry > 0 {
+			bytes = append(bytes, byte(carry))
+			carry >>= 8
+		}
+	}
+
+	out := make([]byte, zeros+len(bytes))
+	for i, b := range bytes {
+		out[len(out)-1-i] = b
+	}
+	return out, nil
+}
+
+// EncodeUint64 returns the digits of `n` without leading zeros.
+func (a *Alphabet) EncodeUint64(n uint64) string {
+	base := uint64(len(a.digits))
+	if n == 0 {
+		return a.digits[:1]
+	}
+	var buf [64]byte
+	i := len(buf)
+	for n > 0 {
+		i--
+		buf[i] = a.digits[n%base]
+		n /= base
+	}
+	return string(buf[i:])
+}
+
+// DecodeUint64 returns the number encoded by `s`.
+func (a *Alphabet) DecodeUint64(s string) (uint64, error) {
+	if s == "" {
+		return 0, fmt.Errorf("empty input")
+	}
+	base := uint64(len(a.digits))
+	var n uint64
+	for i := 0; i < len(s); i++ {
+		v := a.values[s[i]]
+		if v < 0 {
+			return 0, fmt.Errorf("invalid character %q at offset %d", s[i], i)
+		}
+		if n > (^uint64(0)-uint64(v))/base {
+			return 0, fmt.Errorf("value %q overflows uint64", s)
+		}
+		n = n*base + uint64(v)
+	}
+	return n, nil
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package async runs functions in goroutines that cannot crash the process,
+// and combines their results as futures.
+//
+// A panic in a goroutine started with the go statement kills the whole
+// process, and a recover inside the goroutine is easily forgotten. Go and
+// GoWithRecover recover panics into errors with code.CodeInternalPanic,
+// whose detail is the stack of the panic, and route them to the hooks
+// registered with OnPanic, such as a logger or a metrics counter. Without
+// hooks, panics are written to the standard logger, never dropped silently.
+//
+// A Future holds the eventual result of a function: Await waits for it,
+// Then chains a function on its value, and WaitAll, WaitAny and Race combine
+// several, joining their errors so that codes remain visible. Map and
+// ForEach process slices with bounded concurrency, and a Group runs
+// functions like errgroup, cancelling a shared context on the first error.
+// A Pipeline connects stages with channels, each with its own workers.
+package async
+
+import (
+	"context"
+	"log"
+	"slices"
+	"sync"
+
+	"github.com/focela/aegis/pkg/errors"
+	"github.com/focela/aegis/pkg/exec"
+)
+
+// PanicHandler receives the error of a recovered panic, with the context of
+// the goroutine.
+type PanicHandler func(ctx context.Context, err error)
+
+// hookEntry is a registered PanicHandler, compared by identity.
+type hookEntry struct {
+	handler PanicHandler
+}
+
+// hooks holds the panic hooks in registration order.
+var hooks struct {
+	sync.RWMutex
+	entries []*hookEntry
+}
+
+// OnPanic adds `h` to the hooks receiving the panics recovered by Go, and by
+// GoWithRecover without a handler. It returns a function removing the hook.
+func OnPanic(h PanicHandler) (remove func()) {
+	entry := &hookEntry{handler: h}
+	hooks.Lock()
+	hooks.entries = append(hooks.entries, entry)
+	hooks.Unlock()
+	return func() {
+		hooks.Lock()
+		defer hooks.Unlock()
+		hooks.entries = slices.DeleteFunc(hooks.entries, func(e *hookEntry) bool {
+			return e == entry
+		})
+	}
+}
+
+// Go runs `fn` with `ctx` in a new goroutine, recovering a panic and passing
+// its error to the panic hooks.
+func Go(ctx context.Context, fn func(ctx context.Context)) {
+	go func() {
+		err := exec.Try(func() error {
+			fn(ctx)
+			return nil
+		})
+		if err != nil {
+			notify(ctx, err)
+		}
+	}()
+}
+
+// GoWithRecover runs `fn` in a new goroutine, recovering a panic and passing
+// its error to `handler`, or to the panic hooks if `handler` is nil. A panic
+// in `handler` goes to the hooks.
+func GoWithRecover(fn func(), handler func(err error)) {
+	go func() {
+		err := exec.Try(func() error {
+			fn()
+			return nil
+		})
+		if err == nil {
+			return
+		}
+		if handler == nil {
+			notify(context.Background(), err)
+			return
+		}
+		if err := exec.Try(func() error { handler(err); return nil }); err != nil {
+			notify(context.Background(), err)
+		}
+	}()
+}
+
+// notify passes `err` to the panic hooks, or to the standard logger if
+// there are none. A panicking hook is skipped.
+func notify(ctx context.Context, err error) {
+	hooks.RLock()
+	entries := slices.Clone(hooks.entries)
+	hooks.RUnlock()
+	if len(entries) == 0 {
+		log.Printf("async: recovered %v\n%v", err, errors.Code(err).Detail())
+		return
+	}
+	for _, e := range entries {
+		exec.Try(func() error {
+			e.handler(ctx, err)
+			return nil
+		})
+	}
+}
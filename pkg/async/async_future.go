@@ -0,0 +1,170 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package async
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/focela/aegis/pkg/errors"
+	"github.com/focela/aegis/pkg/exec"
+)
+
+// Future is the eventual result of a function running in its own
+// goroutine. It is safe for concurrent use.
+type Future[T any] struct {
+	// ctx is the context of the function, passed on by Then.
+	ctx context.Context
+
+	// done is closed once value and err are set.
+	done  chan struct{}
+	value T
+	err   error
+}
+
+// NewFuture runs `fn` with `ctx` in a new goroutine and returns its Future.
+// A panic in `fn` is recovered into its error, with code.CodeInternalPanic.
+func NewFuture[T any](ctx context.Context, fn func(ctx context.Context) (T, error)) *Future[T] {
+	f := &Future[T]{ctx: ctx, done: make(chan struct{})}
+	go func() {
+		defer close(f.done)
+		f.err = exec.Try(func() error {
+			var err error
+			f.value, err = fn(ctx)
+			return err
+		})
+	}()
+	return f
+}
+
+// Resolved returns a completed Future of `value`.
+func Resolved[T any](value T) *Future[T] {
+	f := &Future[T]{ctx: context.Background(), done: make(chan struct{}), value: value}
+	close(f.done)
+	return f
+}
+
+// Rejected returns a completed Future failed with `err`.
+func Rejected[T any](err error) *Future[T] {
+	f := &Future[T]{ctx: context.Background(), done: make(chan struct{}), err: err}
+	close(f.done)
+	return f
+}
+
+// Await waits for the function to return and returns its result, or
+// ctx.Err() if `ctx` ends first. The function keeps running in that case.
+func (f *Future[T]) Await(ctx context.Context) (T, error) {
+	select {
+	case <-f.done:
+		return f.value, f.err
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
+
+// Done returns a channel closed once the function has returned.
+func (f *Future[T]) Done() <-chan struct{} {
+	return f.done
+}
+
+// Result returns the result of the function, reporting whether it has
+// returned.
+func (f *Future[T]) Result() (value T, err error, ok bool) {
+	select {
+	case <-f.done:
+		return f.value, f.err, true
+	default:
+		var zero T
+		return zero, nil, false
+	}
+}
+
+// Then returns the Future of `fn` applied to the value of `f`, run with
+// the context of `f` once `f` has completed. If `f` fails, the returned
+// Future fails with the same error and `fn` is not called.
+func Then[T, R any](f *Future[T], fn func(ctx context.Context, value T) (R, error)) *Future[R] {
+	return NewFuture(f.ctx, func(ctx context.Context) (R, error) {
+		<-f.done
+		if f.err != nil {
+			var zero R
+			return zero, f.err
+		}
+		return fn(ctx, f.value)
+	})
+}
+
+// WaitAll waits for all `futures` and returns their values in order. If
+// some fail, it returns the values with the errors joined, each annotated
+// with the index of its future, so their codes remain visible to
+// errors.HasCode. It returns ctx.Err() if `ctx` ends first.
+func WaitAll[T any](ctx context.Context, futures ...*Future[T]) ([]T, error) {
+	values := make([]T, len(futures))
+	var errs []error
+	for i, f := range futures {
+		select {
+		case <-f.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		values[i] = f.value
+		if f.err != nil {
+			errs = append(errs, fmt.Errorf("async: future %d: %w", i, f.err))
+		}
+	}
+	return values, errors.Join(errs...)
+}
+
+// WaitAny returns the index and value of the first of `futures` to succeed.
+// If all fail, it returns an index of -1 with their errors joined as by
+// WaitAll. It returns ctx.Err() if `ctx` ends first.
+func WaitAny[T any](ctx context.Context, futures ...*Future[T]) (int, T, error) {
+	var zero T
+	errs := make([]error, len(futures))
+	settled := settle(futures)
+	for range futures {
+		select {
+		case i := <-settled:
+			f := futures[i]
+			if f.err == nil {
+				return i, f.value, nil
+			}
+			errs[i] = fmt.Errorf("async: future %d: %w", i, f.err)
+		case <-ctx.Done():
+			return -1, zero, ctx.Err()
+		}
+	}
+	return -1, zero, errors.Join(errs...)
+}
+
+// Race returns the index and result of the first of `futures` to complete,
+// whether it succeeded or failed. It returns ctx.Err() if `ctx` ends first
+// or there are no futures.
+func Race[T any](ctx context.Context, futures ...*Future[T]) (int, T, error) {
+	var zero T
+	if len(futures) == 0 {
+		<-ctx.Done()
+		return -1, zero, ctx.Err()
+	}
+	select {
+	case i := <-settle(futures):
+		return i, futures[i].value, futures[i].err
+	case <-ctx.Done():
+		return -1, zero, ctx.Err()
+	}
+}
+
+// settle returns a channel receiving the index of each of `futures` as it
+// completes. The channel is buffered so that no goroutine is left blocked.
+func settle[T any](futures []*Future[T]) <-chan int {
+	settled := make(chan int, len(futures))
+	for i, f := range futures {
+		go func() {
+			<-f.done
+			settled <- i
+		}()
+	}
+	return settled
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package async
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/focela/aegis/pkg/errors"
+	"github.com/focela/aegis/pkg/exec"
+)
+
+// GroupOption configures a Group.
+type GroupOption func(*Group)
+
+// WithJoinErrors makes Wait return the errors of all the functions joined,
+// instead of the first one. The context of the group is then not cancelled
+// by an error, so that every function runs to completion.
+func WithJoinErrors() GroupOption {
+	return func(g *Group) {
+		g.joinAll = true
+	}
+}
+
+// Group runs functions in goroutines and waits for them, like errgroup: the
+// first error cancels the context of the group and is returned by Wait.
+// Panics are recovered into errors with code.CodeInternalPanic, so a
+// crashing function fails the group instead of the process.
+//
+// The zero Group is usable, without a context, and limits nothing.
+type Group struct {
+	// cancel cancels the context of the group, nil without one.
+	cancel context.CancelCauseFunc
+
+	// wg counts the running functions.
+	wg sync.WaitGroup
+
+	// sem bounds the running functions, nil for no limit.
+	sem chan struct{}
+
+	// joinAll joins all errors instead of keeping the first.
+	joinAll bool
+
+	// mu guards errs.
+	mu   sync.Mutex
+	errs []error
+}
+
+// NewGroup returns a Group and a context derived from `ctx`, cancelled when
+// a function fails, unless WithJoinErrors is given, or when Wait returns.
+func NewGroup(ctx context.Context, opts ...GroupOption) (*Group, context.Context) {
+	g := &Group{}
+	for _, opt := range opts {
+		opt(g)
+	}
+	ctx, g.cancel = context.WithCancelCause(ctx)
+	return g, ctx
+}
+
+// SetLimit limits the number of functions running at once to `n`, a
+// negative `n` removing the limit. It must not be called while functions
+// are running.
+func (g *Group) SetLimit(n int) {
+	if n < 0 {
+		g.sem = nil
+		return
+	}
+	if len(g.sem) != 0 {
+		panic(fmt.Errorf("async: SetLimit called with %d functions running", len(g.sem)))
+	}
+	g.sem = make(chan struct{}, n)
+}
+
+// Go runs `fn` in a new goroutine, first waiting for a slot if the number
+// of running functions is limited.
+func (g *Group) Go(fn func() error) {
+	if g.sem != nil {
+		g.sem <- struct{}{}
+	}
+	g.start(fn)
+}
+
+// TryGo runs `fn` in a new goroutine if the limit allows it at once,
+// reporting whether it did.
+func (g *Group) TryGo(fn func() error) bool {
+	if g.sem != nil {
+		select {
+		case g.sem <- struct{}{}:
+		default:
+			return false
+		}
+	}
+	g.start(fn)
+	return true
+}
+
+// Wait waits for all the functions to return, cancels the context of the
+// group and returns the first error, or all errors joined with
+// WithJoinErrors.
+func (g *Group) Wait() error {
+	g.wg.Wait()
+	if g.cancel != nil {
+		g.cancel(nil)
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.joinAll {
+		return errors.Join(g.errs...)
+	}
+	if len(g.errs) > 0 {
+		return g.errs[0]
+	}
+	return nil
+}
+
+// start runs `fn` in a new goroutine holding a slot of the limit.
+func (g *Group) start(fn func() error) {
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		if g.sem != nil {
+			defer func() { <-g.sem }()
+		}
+		if err := exec.Try(fn); err != nil {
+			g.fail(err)
+		}
+	}()
+}
+
+// fail records the error `err` of a function.
+func (g *Group) fail(err error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.errs = append(g.errs, err)
+	if g.cancel != nil && !g.joinAll && len(g.errs) == 1 {
+		g.cancel(err)
+	}
+}
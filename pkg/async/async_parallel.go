@@ -0,0 +1,137 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package async
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/focela/aegis/pkg/errors"
+	"github.com/focela/aegis/pkg/exec"
+)
+
+// parallelOptions holds the settings of Map and ForEach.
+type parallelOptions struct {
+	// unordered returns the results of Map in completion order.
+	unordered bool
+
+	// collect runs all items despite errors and joins them.
+	collect bool
+}
+
+// ParallelOption configures Map and ForEach.
+type ParallelOption func(*parallelOptions)
+
+// WithCompletionOrder returns the results of Map in the order the calls
+// complete, instead of the order of the items, without gaps for failures
+// in WithCollectErrors mode.
+func WithCompletionOrder() ParallelOption {
+	return func(o *parallelOptions) {
+		o.unordered = true
+	}
+}
+
+// WithCollectErrors processes all items even when some fail, and returns
+// their errors joined, each annotated with the index of its item. By
+// default, the first error cancels the context of the other calls, no
+// further item is started, and that error alone is returned.
+func WithCollectErrors() ParallelOption {
+	return func(o *parallelOptions) {
+		o.collect = true
+	}
+}
+
+// Map calls `fn` on each of `items` with at most `concurrency` calls at
+// once, all of them if `concurrency` is not positive, and returns the
+// results in the order of the items. A panic in `fn` is recovered into its
+// error. If `ctx` ends, no further item is started and ctx.Err() is
+// returned.
+func Map[T, R any](ctx context.Context, items []T, fn func(ctx context.Context, item T) (R, error), concurrency int, opts ...ParallelOption) ([]R, error) {
+	o := &parallelOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	var (
+		mu      sync.Mutex
+		results []R
+	)
+	if !o.unordered {
+		results = make([]R, len(items))
+	}
+	err := run(ctx, len(items), concurrency, o, func(ctx context.Context, i int) error {
+		r, err := fn(ctx, items[i])
+		switch {
+		case !o.unordered:
+			results[i] = r
+		case err == nil:
+			mu.Lock()
+			results = append(results, r)
+			mu.Unlock()
+		}
+		return err
+	})
+	if err != nil && !o.collect {
+		return nil, err
+	}
+	return results, err
+}
+
+// ForEach calls `fn` on each of `items` with at most `concurrency` calls at
+// once, as Map does.
+func ForEach[T any](ctx context.Context, items []T, fn func(ctx context.Context, item T) error, concurrency int, opts ...ParallelOption) error {
+	o := &parallelOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return run(ctx, len(items), concurrency, o, func(ctx context.Context, i int) error {
+		return fn(ctx, items[i])
+	})
+}
+
+// run calls `fn` on the indexes below `n` from at most `concurrency`
+// workers.
+func run(ctx context.Context, n, concurrency int, o *parallelOptions, fn func(ctx context.Context, i int) error) error {
+	if concurrency <= 0 || concurrency > n {
+		concurrency = n
+	}
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	var (
+		next atomic.Int64
+		mu   sync.Mutex
+		errs = make([]error, n)
+		wg   sync.WaitGroup
+	)
+	for range concurrency {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ctx.Err() == nil {
+				i := int(next.Add(1) - 1)
+				if i >= n {
+					return
+				}
+				err := exec.Try(func() error { return fn(ctx, i) })
+				switch {
+				case err == nil:
+				case o.collect:
+					mu.Lock()
+					errs[i] = fmt.Errorf("async: item %d: %w", i, err)
+					mu.Unlock()
+				default:
+					// The first cause wins
+					cancel(err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if !o.collect {
+		return context.Cause(ctx)
+	}
+	return errors.Join(append([]error{ctx.Err()}, errs...)...)
+}
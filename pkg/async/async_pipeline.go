@@ -0,0 +1,169 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package async
+
+import (
+	"context"
+	"iter"
+	"sync"
+)
+
+// PipelineOption configures a Pipeline.
+type PipelineOption func(*Pipeline)
+
+// WithBuffer gives the channels between stages a buffer of `n` values.
+// Without a buffer, a stage blocks until the next one takes its value, so
+// a slow stage throttles the ones before it.
+func WithBuffer(n int) PipelineOption {
+	return func(p *Pipeline) {
+		if n > 0 {
+			p.buffer = n
+		}
+	}
+}
+
+// Pipeline runs stages connected by channels, each with its own number of
+// workers:
+//
+//	p := async.NewPipeline(ctx)
+//	rows := async.Source(p, records)
+//	parsed := async.Stage(p, rows, 4, parse)
+//	async.Sink(p, parsed, 1, store)
+//	err := p.Wait()
+//
+// The first error or panic of a stage cancels the context of the pipeline,
+// which stops all stages, and is returned by Wait. The last stage must be a
+// Sink, or be drained by the caller, for the pipeline to complete.
+type Pipeline struct {
+	// group runs the workers of the stages.
+	group *Group
+
+	// ctx is the context of the stages.
+	ctx context.Context
+
+	// buffer is the capacity of the channels between stages.
+	buffer int
+}
+
+// NewPipeline returns a Pipeline whose stages run with a context derived
+// from `ctx`.
+func NewPipeline(ctx context.Context, opts ...PipelineOption) *Pipeline {
+	p := &Pipeline{}
+	for _, opt := range opts {
+		opt(p)
+	}
+	p.group, p.ctx = NewGroup(ctx)
+	return p
+}
+
+// Context returns the context of the stages, cancelled when a stage fails
+// or the pipeline completes.
+func (p *Pipeline) Context() context.Context {
+	return p.ctx
+}
+
+// Wait waits for all stages to return and returns the first error.
+func (p *Pipeline) Wait() error {
+	return p.group.Wait()
+}
+
+// Source feeds the values of `seq` into `p`, stopping early when the
+// pipeline is cancelled.
+func Source[T any](p *Pipeline, seq iter.Seq[T]) <-chan T {
+	out := make(chan T, p.buffer)
+	p.group.Go(func() error {
+		defer close(out)
+		for v := range seq {
+			select {
+			case out <- v:
+			case <-p.ctx.Done():
+				return p.ctx.Err()
+			}
+		}
+		return nil
+	})
+	return out
+}
+
+// Stage calls `fn` on the values of `in` with `concurrency` workers, at
+// least one, and sends its results to the returned channel. With several
+// workers, results are sent in completion order.
+func Stage[T, R any](p *Pipeline, in <-chan T, concurrency int, fn func(ctx context.Context, v T) (R, error)) <-chan R {
+	out := make(chan R, p.buffer)
+	workers(p, concurrency, func() error {
+		for v := range in {
+			r, err := fn(p.ctx, v)
+			if err != nil {
+				return err
+			}
+			select {
+			case out <- r:
+			case <-p.ctx.Done():
+				return p.ctx.Err()
+			}
+		}
+		return nil
+	}, func() { close(out) })
+	return out
+}
+
+// Sink calls `fn` on the values of `in` with `concurrency` workers, at
+// least one, ending the pipeline.
+func Sink[T any](p *Pipeline, in <-chan T, concurrency int, fn func(ctx context.Context, v T) error) {
+	workers(p, concurrency, func() error {
+		for v := range in {
+			if err := fn(p.ctx, v); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, nil)
+}
+
+// Merge sends the values of all of `ins` to one channel, in the order they
+// arrive, closed once all of `ins` are.
+func Merge[T any](p *Pipeline, ins ...<-chan T) <-chan T {
+	out := make(chan T, p.buffer)
+	var wg sync.WaitGroup
+	wg.Add(len(ins))
+	for _, in := range ins {
+		p.group.Go(func() error {
+			defer wg.Done()
+			for v := range in {
+				select {
+				case out <- v:
+				case <-p.ctx.Done():
+					return p.ctx.Err()
+				}
+			}
+			return nil
+		})
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// workers runs `fn` in `n` workers of `p`, at least one, then calls `done`
+// if not nil once all have returned.
+func workers(p *Pipeline, n int, fn func() error, done func()) {
+	n = max(n, 1)
+	var wg sync.WaitGroup
+	wg.Add(n)
+	for range n {
+		p.group.Go(func() error {
+			defer wg.Done()
+			return fn()
+		})
+	}
+	if done != nil {
+		go func() {
+			wg.Wait()
+			done()
+		}()
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package singleflight coalesces concurrent calls for the same key into one.
+//
+// When many requests miss a cache at once, each would otherwise query the
+// database for the same value. Do runs the function for the first caller of
+// a key and makes the callers arriving meanwhile wait for its result, so
+// the backend sees one query per key however many requests are waiting.
+// Panics of the function are recovered into errors with
+// code.CodeInternalPanic and returned to every caller.
+package singleflight
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/focela/aegis/pkg/exec"
+)
+
+// Option configures a Group.
+type Option func(*config)
+
+// config holds the options of a Group.
+type config struct {
+	forgetOnError bool
+}
+
+// WithForgetOnError makes the callers waiting on a failed call retry once,
+// in a new coalesced call, instead of sharing its error. It suits transient
+// failures, at the cost of at most one more call per failure.
+func WithForgetOnError() Option {
+	return func(c *config) {
+		c.forgetOnError = true
+	}
+}
+
+// Flight describes a call in flight.
+type Flight struct {
+	// Key is the key of the call.
+	Key string
+
+	// Waiters is the number of callers waiting for the call, besides the one
+	// running it.
+	Waiters int
+
+	// Started is the time the call started.
+	Started time.Time
+}
+
+// Group coalesces the calls of functions returning a T by key. The zero
+// Group is ready to use. It is safe for concurrent use.
+type Group[T any] struct {
+	config
+
+	// mu guards calls.
+	mu    sync.Mutex
+	calls map[string]*call[T]
+}
+
+// call is a call in flight or completed.
+type call[T any] struct {
+	// done is closed once val and err are set.
+	done chan struct{}
+
+	val T
+	err error
+
+	// waiters is the number of callers waiting, guarded by the lock of the
+	// group.
+	waiters int
+
+	// started is the time the call started.
+	started time.Time
+}
+
+// New returns a Group configured by `opts`.
+func New[T any](opts ...Option) *Group[T] {
+	g := &Group[T]{}
+	for _, opt := range opts {
+		opt(&g.config)
+	}
+	return g
+}
+
+// Do runs `fn` and returns its results, unless a call for `key` is already
+// in flight, in which case it waits for that call and returns its results.
+// `shared` reports whether the results were given to several callers.
+func (g *Group[T]) Do(key string, fn func() (T, error)) (v T, shared bool, err error) {
+	retried := false
+	for {
+		g.mu.Lock()
+		if g.calls == nil {
+			g.calls = make(map[string]*call[T])
+		}
+		if c, ok := g.calls[key]; ok {
+			c.waiters++
+			g.mu.Unlock()
+			<-c.done
+			if c.err != nil && g.forgetOnError && !retried {
+				retried = true
+				continue
+			}
+			return c.val, true, c.err
+		}
+		c := &call[T]{done: make(chan struct{}), started: time.Now()}
+		g.calls[key] = c
+		g.mu.Unlock()
+		return g.run(key, c, fn)
+	}
+}
+
+// Forget makes the next call for `key` run its function instead of waiting
+// for the call in flight, whose callers still get its results.
+func (g *Group[T]) Forget(key string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.calls, key)
+}
+
+// InFlight returns the call in flight for `key`, reporting whether there is
+// one.
+func (g *Group[T]) InFlight(key string) (Flight, bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	c, ok := g.calls[key]
+	if !ok {
+		return Flight{}, false
+	}
+	return Flight{Key: key, Waiters: c.waiters, Started: c.started}, true
+}
+
+// Flights returns the calls in flight sorted by key.
+func (g *Group[T]) Flights() []Flight {
+	g.mu.Lock()
+	flights := make([]Flight, 0, len(g.calls))
+	for key, c := range g.calls {
+		flights = append(flights, Flight{Key: key, Waiters: c.waiters, Started: c.started})
+	}
+	g.mu.Unlock()
+	sort.Slice(flights, func(i, j int) bool {
+		return flights[i].Key < flights[j].Key
+	})
+	return flights
+}
+
+// run runs `fn` for the call `c` of `key` and releases its waiters.
+func (g *Group[T]) run(key string, c *call[T], fn func() (T, error)) (T, bool, error) {
+	c.err = exec.Try(func() error {
+		var err error
+		c.val, err = fn()
+		return err
+	})
+	g.mu.Lock()
+	if g.calls[key] == c {
+		delete(g.calls, key)
+	}
+	shared := c.waiters > 0
+	g.mu.Unlock()
+	close(c.done)
+	return c.val, shared, c.err
+}
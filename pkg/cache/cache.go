@@ -0,0 +1,203 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package cache provides a cache with per-entry expiry over pluggable
+// storage.
+//
+// A Cache stores values of any type under string keys, each with its own
+// time-to-live, in an Adapter. The default adapter, Memory, keeps entries in
+// the process and removes the expired ones lazily on access and with a
+// janitor goroutine, whose runs are jittered so that caches created together
+// do not purge in lockstep. It may be bounded in number of entries and in
+// total cost, such as the memory of large decoded objects, evicting the
+// least recently or least frequently used entries, with a callback telling
+// why each entry left. Values are returned as *gvar.Var, converted to
+// the type a caller needs, and a miss returns a nil Var, which converts to
+// zero values.
+//
+// GetOrSetFuncLock computes missing values with a single call per key
+// however many callers miss it at once, and can serve a stale value while
+// refreshing it in the background. A Tiered adapter puts a local tier in
+// front of a remote one shared by instances, writing through or back to it,
+// with hooks to fan out invalidations to the other instances.
+//
+// The package-level functions use a default in-memory Cache, shared by the
+// subsystems that need a cache without owning one.
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/focela/aegis/pkg/async/singleflight"
+	"github.com/focela/aegis/pkg/container/gvar"
+)
+
+// Adapter stores the entries of a Cache. A `ttl` not positive means no
+// expiry. Implementations must be safe for concurrent use.
+type Adapter interface {
+	// Set stores `value` under `key` for `ttl`.
+	Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error
+
+	// SetIfNotExist stores `value` under `key` for `ttl` unless a live entry
+	// exists, reporting whether it did.
+	SetIfNotExist(ctx context.Context, key string, value interface{}, ttl time.Duration) (bool, error)
+
+	// Get returns the value of `key`, reporting whether a live entry exists.
+	Get(ctx context.Context, key string) (value interface{}, found bool, err error)
+
+	// Contains reports whether a live entry exists for `key`.
+	Contains(ctx context.Context, key string) (bool, error)
+
+	// Remove deletes the entries of `keys`.
+	Remove(ctx context.Context, keys ...string) error
+
+	// Size returns the number of live entries.
+	Size(ctx context.Context) (int, error)
+
+	// Clear deletes all entries.
+	Clear(ctx context.Context) error
+
+	// Close releases the resources of the adapter.
+	Close(ctx context.Context) error
+}
+
+// TTLAdapter is implemented by adapters that can report the time left
+// before an entry expires.
+type TTLAdapter interface {
+	Adapter
+
+	// GetWithTTL is like Get, also returning the time left before the entry
+	// expires, zero if it does not.
+	GetWithTTL(ctx context.Context, key string) (value interface{}, ttl time.Duration, found bool, err error)
+}
+
+// Cache is a cache over an Adapter. It is safe for concurrent use.
+type Cache struct {
+	// adapter stores the entries.
+	adapter Adapter
+
+	// flight coalesces the calls of GetOrSetFuncLock by key.
+	flight singleflight.Group[interface{}]
+}
+
+// defaultCache is the Cache of the package-level functions.
+var defaultCache = sync.OnceValue(func() *Cache {
+	return New()
+})
+
+// New creates and returns a Cache over a Memory adapter configured by
+// `opts`.
+func New(opts ...MemoryOption) *Cache {
+	return NewWithAdapter(NewMemory(opts...))
+}
+
+// NewWithAdapter creates and returns a Cache over `adapter`.
+func NewWithAdapter(adapter Adapter) *Cache {
+	return &Cache{adapter: adapter}
+}
+
+// Adapter returns the adapter of the cache.
+func (c *Cache) Adapter() Adapter {
+	return c.adapter
+}
+
+// Set stores `value` under `key`, expiring after `ttl`, never if not
+// positive.
+func (c *Cache) Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	return c.adapter.Set(ctx, key, value, ttl)
+}
+
+// Get returns the value of `key`, or nil if there is no live entry.
+func (c *Cache) Get(ctx context.Context, key string) (*gvar.Var, error) {
+	value, found, err := c.adapter.Get(ctx, key)
+	if err != nil || !found {
+		return nil, err
+	}
+	value, _ = c.unwrap(value)
+	return gvar.New(value), nil
+}
+
+// GetOrSet returns the value of `key`, first storing `value` for `ttl` if
+// there is no live entry. Concurrent calls all return the value stored by
+// the first one.
+func (c *Cache) GetOrSet(ctx context.Context, key string, value interface{}, ttl time.Duration) (*gvar.Var, error) {
+	for {
+		v, found, err := c.adapter.Get(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			v, _ = c.unwrap(v)
+			return gvar.New(v), nil
+		}
+		ok, err := c.adapter.SetIfNotExist(ctx, key, value, ttl)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return gvar.New(value), nil
+		}
+		// Another caller stored a value in between, which may already be
+		// expired; read it again
+	}
+}
+
+// Contains reports whether a live entry exists for `key`.
+func (c *Cache) Contains(ctx context.Context, key string) (bool, error) {
+	return c.adapter.Contains(ctx, key)
+}
+
+// Remove deletes the entries of `keys`.
+func (c *Cache) Remove(ctx context.Context, keys ...string) error {
+	return c.adapter.Remove(ctx, keys...)
+}
+
+// Size returns the number of live entries.
+func (c *Cache) Size(ctx context.Context) (int, error) {
+	return c.adapter.Size(ctx)
+}
+
+// Clear deletes all entries.
+func (c *Cache) Clear(ctx context.Context) error {
+	return c.adapter.Clear(ctx)
+}
+
+// Close releases the adapter, such as the janitor of a Memory adapter. The
+// cache must not be used afterwards.
+func (c *Cache) Close(ctx context.Context) error {
+	return c.adapter.Close(ctx)
+}
+
+// Set stores `value` under `key` in the default cache.
+func Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	return defaultCache().Set(ctx, key, value, ttl)
+}
+
+// Get returns the value of `key` in the default cache, or nil.
+func Get(ctx context.Context, key string) (*gvar.Var, error) {
+	return defaultCache().Get(ctx, key)
+}
+
+// GetOrSet returns the value of `key` in the default cache, first storing
+// `value` if there is no live entry.
+func GetOrSet(ctx context.Context, key string, value interface{}, ttl time.Duration) (*gvar.Var, error) {
+	return defaultCache().GetOrSet(ctx, key, value, ttl)
+}
+
+// Contains reports whether the default cache holds a live entry for `key`.
+func Contains(ctx context.Context, key string) (bool, error) {
+	return defaultCache().Contains(ctx, key)
+}
+
+// Remove deletes the entries of `keys` from the default cache.
+func Remove(ctx context.Context, keys ...string) error {
+	return defaultCache().Remove(ctx, keys...)
+}
+
+// Size returns the number of live entries of the default cache.
+func Size(ctx context.Context) (int, error) {
+	return defaultCache().Size(ctx)
+}
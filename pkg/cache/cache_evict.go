@@ -0,0 +1,133 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package cache
+
+import (
+	"reflect"
+
+	"github.com/focela/aegis/pkg/container/lru"
+)
+
+// Policy selects the entries a bounded Memory adapter evicts.
+type Policy int
+
+// Eviction policies.
+const (
+	// PolicyLRU evicts the least recently used entry.
+	PolicyLRU Policy = iota
+
+	// PolicyLFU evicts the least frequently used entry.
+	PolicyLFU
+)
+
+// EvictReason tells an eviction callback why an entry left the cache.
+type EvictReason int
+
+// Eviction reasons.
+const (
+	// EvictExpired means the entry expired.
+	EvictExpired EvictReason = iota
+
+	// EvictRemoved means the entry was removed by Remove or Clear.
+	EvictRemoved
+
+	// EvictMaxEntries means the entry was evicted to stay within the maximum
+	// number of entries.
+	EvictMaxEntries
+
+	// EvictMaxCost means the entry was evicted to stay within the maximum
+	// total cost.
+	EvictMaxCost
+)
+
+// String returns the name of the reason.
+func (r EvictReason) String() string {
+	switch r {
+	case EvictExpired:
+		return "expired"
+	case EvictRemoved:
+		return "removed"
+	case EvictMaxEntries:
+		return "max-entries"
+	case EvictMaxCost:
+		return "max-cost"
+	default:
+		return "unknown"
+	}
+}
+
+// CostFunc returns the cost of an entry, such as its size in memory.
+type CostFunc func(key string, value interface{}) int64
+
+// WithMaxEntries bounds the number of entries to `n`, evicting entries in
+// the order of the policy when exceeded. Zero means no bound.
+func WithMaxEntries(n int) MemoryOption {
+	return func(m *Memory) {
+		m.maxEntries = max(n, 0)
+	}
+}
+
+// WithMaxCost bounds the total cost of the entries to `maxCost`, evicting
+// entries in the order of the policy when exceeded. `cost` computes the cost
+// of an entry; if nil, the cost is an estimate of the size of the key and
+// value in bytes, which is exact for strings and byte slices only. Zero
+// means no bound.
+func WithMaxCost(maxCost int64, cost CostFunc) MemoryOption {
+	return func(m *Memory) {
+		m.maxCost = max(maxCost, 0)
+		m.costFunc = cost
+	}
+}
+
+// WithPolicy sets the eviction policy of a bounded adapter, PolicyLRU by
+// default.
+func WithPolicy(policy Policy) MemoryOption {
+	return func(m *Memory) {
+		m.policy = policy
+	}
+}
+
+// WithOnEvict sets a callback invoked after an entry leaves the adapter for
+// any reason but being replaced. It runs outside the lock of the adapter
+// and may call back into it.
+func WithOnEvict(f func(key string, value interface{}, reason EvictReason)) MemoryOption {
+	return func(m *Memory) {
+		m.onEvict = f
+	}
+}
+
+// evicted is an entry removed under the lock whose callback is pending.
+type evicted struct {
+	key    string
+	value  interface{}
+	reason EvictReason
+}
+
+// newPolicy returns the tracker of `policy`.
+func newPolicy(policy Policy) lru.EvictionPolicy[string] {
+	if policy == PolicyLFU {
+		return lru.NewLFUPolicy[string]()
+	}
+	return lru.NewLRUPolicy[string]()
+}
+
+// estimateCost estimates the size of `key` and `value` in bytes.
+func estimateCost(key string, value interface{}) int64 {
+	n := int64(len(key))
+	switch v := value.(type) {
+	case nil:
+	case string:
+		n += int64(len(v))
+	case []byte:
+		n += int64(len(v))
+	default:
+		rv := reflect.ValueOf(v)
+		n += int64(rv.Type().Size())
+		if rv.Kind() == reflect.Pointer && !rv.IsNil() {
+			n += int64(rv.Type().Elem().Size())
+		}
+	}
+	return max(n, 1)
+}
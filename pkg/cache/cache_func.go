@@ -0,0 +1,144 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/focela/aegis/pkg/clock"
+	"github.com/focela/aegis/pkg/container/gvar"
+)
+
+// Func computes the value of a missing entry, such as by querying a
+// database.
+type Func func(ctx context.Context) (interface{}, error)
+
+// FuncOption configures GetOrSetFuncLock.
+type FuncOption func(*funcOptions)
+
+// clocked is implemented by adapters measuring expiry on a clock, such as
+// Memory, whose time stale windows then follow.
+type clocked interface {
+	Clock() clock.Clock
+}
+
+// funcOptions holds the settings of GetOrSetFuncLock.
+type funcOptions struct {
+	// stale is how long an expired value is served while refreshed.
+	stale time.Duration
+}
+
+// WithStaleWhileRevalidate keeps serving a value for up to `window` after
+// its TTL, while a single background call refreshes it, so readers never
+// wait for a value that was present. A failed refresh keeps the stale value,
+// and the next read tries again.
+//
+// The value is stored wrapped with the end of its TTL, so the adapter must
+// keep values in the process, as Memory does; values serialized by a remote
+// adapter, including through a Tiered one, come back unwrapped and are
+// never seen as stale.
+func WithStaleWhileRevalidate(window time.Duration) FuncOption {
+	return func(o *funcOptions) {
+		o.stale = max(window, 0)
+	}
+}
+
+// staleEntry is a value stored with a stale window, kept by the adapter
+// until the end of the window.
+type staleEntry struct {
+	value      interface{}
+	freshUntil time.Time
+}
+
+// GetOrSetFuncLock returns the value of `key`, first storing the result of
+// `fn` for `ttl` if there is no live entry. Concurrent misses of the same
+// key make a single call to `fn`, whose result or error they all return,
+// so a cold or expired key cannot stampede the backend. A nil result is
+// returned but not stored. The context of the first caller is passed to
+// `fn`.
+func (c *Cache) GetOrSetFuncLock(ctx context.Context, key string, fn Func, ttl time.Duration, opts ...FuncOption) (*gvar.Var, error) {
+	o := &funcOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	v, found, err := c.adapter.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		value, fresh := c.unwrap(v)
+		if !fresh {
+			c.revalidate(ctx, key, fn, ttl, o)
+		}
+		return gvar.New(value), nil
+	}
+	value, _, err := c.flight.Do(key, func() (interface{}, error) {
+		return c.load(ctx, key, fn, ttl, o)
+	})
+	if err != nil || value == nil {
+		return nil, err
+	}
+	return gvar.New(value), nil
+}
+
+// GetOrSetFuncLock returns the value of `key` in the default cache, first
+// storing the result of `fn` if there is no live entry.
+func GetOrSetFuncLock(ctx context.Context, key string, fn Func, ttl time.Duration, opts ...FuncOption) (*gvar.Var, error) {
+	return defaultCache().GetOrSetFuncLock(ctx, key, fn, ttl, opts...)
+}
+
+// load calls `fn` and stores its result under `key`, unless a fresh value
+// was stored meanwhile. It runs once per key at a time.
+func (c *Cache) load(ctx context.Context, key string, fn Func, ttl time.Duration, o *funcOptions) (interface{}, error) {
+	v, found, err := c.adapter.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if value, fresh := c.unwrap(v); found && fresh {
+		return value, nil
+	}
+	value, err := fn(ctx)
+	if err != nil || value == nil {
+		return nil, err
+	}
+	stored := value
+	if o.stale > 0 && ttl > 0 {
+		stored = &staleEntry{value: value, freshUntil: c.now().Add(ttl)}
+		ttl += o.stale
+	}
+	if err := c.adapter.Set(ctx, key, stored, ttl); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// revalidate refreshes the stale value of `key` in the background, unless
+// a call for it is already in flight.
+func (c *Cache) revalidate(ctx context.Context, key string, fn Func, ttl time.Duration, o *funcOptions) {
+	if _, ok := c.flight.InFlight(key); ok {
+		return
+	}
+	ctx = context.WithoutCancel(ctx)
+	go c.flight.Do(key, func() (interface{}, error) {
+		return c.load(ctx, key, fn, ttl, o)
+	})
+}
+
+// unwrap returns the value stored as `v`, reporting whether it is fresh.
+func (c *Cache) unwrap(v interface{}) (interface{}, bool) {
+	if e, ok := v.(*staleEntry); ok {
+		return e.value, c.now().Before(e.freshUntil)
+	}
+	return v, true
+}
+
+// now returns the time on the clock of the adapter, if it has one.
+func (c *Cache) now() time.Time {
+	if a, ok := c.adapter.(clocked); ok {
+		return a.Clock().Now()
+	}
+	return time.Now()
+}
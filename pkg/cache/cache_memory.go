@@ -0,0 +1,393 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package cache
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/focela/aegis/pkg/clock"
+	"github.com/focela/aegis/pkg/container/lru"
+	"github.com/focela/aegis/pkg/timeutil"
+)
+
+// Janitor defaults of Memory.
+const (
+	// DefaultJanitorInterval is the mean period of the janitor.
+	DefaultJanitorInterval = time.Minute
+
+	// janitorJitter is the deviation of the janitor period, as a fraction of
+	// it.
+	janitorJitter = 0.1
+)
+
+// MemoryOption configures a Memory adapter.
+type MemoryOption func(*Memory)
+
+// WithJanitorInterval sets the mean period of the janitor removing expired
+// entries, DefaultJanitorInterval by default. Zero disables the janitor,
+// leaving expired entries to be removed when accessed.
+func WithJanitorInterval(interval time.Duration) MemoryOption {
+	return func(m *Memory) {
+		m.janitorInterval = max(interval, 0)
+	}
+}
+
+// WithClock sets the clock expiry is measured on, clock.Real() by default.
+func WithClock(clk clock.Clock) MemoryOption {
+	return func(m *Memory) {
+		if clk != nil {
+			m.clock = clk
+		}
+	}
+}
+
+// item is a stored value with its expiry.
+type item struct {
+	value    interface{}
+	expireAt time.Time
+	cost     int64
+}
+
+// expired reports whether the item is expired at `now`.
+func (it *item) expired(now time.Time) bool {
+	return !it.expireAt.IsZero() && !now.Before(it.expireAt)
+}
+
+// Memory is an Adapter keeping entries in the process, optionally bounded
+// in number and total cost.
+type Memory struct {
+	// mu guards data, tracker and cost.
+	mu   sync.Mutex
+	data map[string]*item
+
+	// tracker orders the entries for eviction, nil when unbounded.
+	tracker lru.EvictionPolicy[string]
+
+	// cost is the total cost of the entries.
+	cost int64
+
+	// maxEntries and maxCost bound the entries, zero for no bound.
+	maxEntries int
+	maxCost    int64
+
+	// costFunc computes the cost of entries, nil for estimateCost.
+	costFunc CostFunc
+
+	// policy selects the tracker.
+	policy Policy
+
+	// onEvict is called for entries leaving the adapter.
+	onEvict func(key string, value interface{}, reason EvictReason)
+
+	// janitorInterval is the mean period of the janitor, zero for none.
+	janitorInterval time.Duration
+
+	// clock tells the time.
+	clock clock.Clock
+
+	// done stops the janitor, closed once by closeOnce.
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewMemory creates and returns a Memory adapter and starts its janitor,
+// stopped by Close.
+func NewMemory(opts ...MemoryOption) *Memory {
+	m := &Memory{
+		data:            make(map[string]*item),
+		janitorInterval: DefaultJanitorInterval,
+		clock:           clock.Real(),
+		done:            make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	if m.maxEntries > 0 || m.maxCost > 0 {
+		m.tracker = newPolicy(m.policy)
+	}
+	if m.janitorInterval > 0 {
+		go m.janitor()
+	}
+	return m
+}
+
+// Set implements Adapter. It fails if the cost of the entry alone exceeds
+// the maximum cost.
+func (m *Memory) Set(_ context.Context, key string, value interface{}, ttl time.Duration) error {
+	cost := m.costOf(key, value)
+	m.mu.Lock()
+	if m.maxCost > 0 && cost > m.maxCost {
+		pending := m.removeLocked(key, EvictRemoved, nil)
+		m.mu.Unlock()
+		m.notify(pending)
+		return fmt.Errorf("cache: cost %d of %q exceeds the maximum %d", cost, key, m.maxCost)
+	}
+	pending := m.storeLocked(key, &item{value: value, expireAt: m.expireAt(ttl), cost: cost})
+	m.mu.Unlock()
+	m.notify(pending)
+	return nil
+}
+
+// SetIfNotExist implements Adapter.
+func (m *Memory) SetIfNotExist(_ context.Context, key string, value interface{}, ttl time.Duration) (bool, error) {
+	cost := m.costOf(key, value)
+	m.mu.Lock()
+	if it, ok := m.data[key]; ok && !it.expired(m.clock.Now()) {
+		m.mu.Unlock()
+		return false, nil
+	}
+	if m.maxCost > 0 && cost > m.maxCost {
+		m.mu.Unlock()
+		return false, fmt.Errorf("cache: cost %d of %q exceeds the maximum %d", cost, key, m.maxCost)
+	}
+	pending := m.removeLocked(key, EvictExpired, nil)
+	pending = append(pending, m.storeLocked(key, &item{value: value, expireAt: m.expireAt(ttl), cost: cost})...)
+	m.mu.Unlock()
+	m.notify(pending)
+	return true, nil
+}
+
+// Get implements Adapter. An expired entry is removed.
+func (m *Memory) Get(ctx context.Context, key string) (interface{}, bool, error) {
+	value, _, found, err := m.GetWithTTL(ctx, key)
+	return value, found, err
+}
+
+// GetWithTTL implements TTLAdapter. An expired entry is removed.
+func (m *Memory) GetWithTTL(_ context.Context, key string) (interface{}, time.Duration, bool, error) {
+	m.mu.Lock()
+	it, ok := m.data[key]
+	if !ok {
+		m.mu.Unlock()
+		return nil, 0, false, nil
+	}
+	now := m.clock.Now()
+	if it.expired(now) {
+		pending := m.removeLocked(key, EvictExpired, nil)
+		m.mu.Unlock()
+		m.notify(pending)
+		return nil, 0, false, nil
+	}
+	if m.tracker != nil {
+		m.tracker.Access(key)
+	}
+	m.mu.Unlock()
+	var ttl time.Duration
+	if !it.expireAt.IsZero() {
+		ttl = it.expireAt.Sub(now)
+	}
+	return it.value, ttl, true, nil
+}
+
+// Contains implements Adapter. It does not count as a use of the entry.
+func (m *Memory) Contains(_ context.Context, key string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	it, ok := m.data[key]
+	return ok && !it.expired(m.clock.Now()), nil
+}
+
+// Remove implements Adapter.
+func (m *Memory) Remove(_ context.Context, keys ...string) error {
+	m.mu.Lock()
+	var pending []evicted
+	for _, key := range keys {
+		pending = m.removeLocked(key, EvictRemoved, pending)
+	}
+	m.mu.Unlock()
+	m.notify(pending)
+	return nil
+}
+
+// Size implements Adapter.
+func (m *Memory) Size(_ context.Context) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := m.clock.Now()
+	n := 0
+	for _, it := range m.data {
+		if !it.expired(now) {
+			n++
+		}
+	}
+	return n, nil
+}
+
+// Cost returns the total cost of the entries, including expired ones not
+// yet removed.
+func (m *Memory) Cost() int64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.cost
+}
+
+// Clock returns the clock expiry is measured on.
+func (m *Memory) Clock() clock.Clock {
+	return m.clock
+}
+
+// Clear implements Adapter. The eviction callback sees the entries as
+// removed.
+func (m *Memory) Clear(_ context.Context) error {
+	m.mu.Lock()
+	var pending []evicted
+	if m.onEvict != nil {
+		for key, it := range m.data {
+			pending = append(pending, evicted{key, it.value, EvictRemoved})
+		}
+	}
+	m.data = make(map[string]*item)
+	m.cost = 0
+	if m.tracker != nil {
+		m.tracker.Clear()
+	}
+	m.mu.Unlock()
+	m.notify(pending)
+	return nil
+}
+
+// Close implements Adapter, stopping the janitor. The entries remain
+// usable.
+func (m *Memory) Close(_ context.Context) error {
+	m.closeOnce.Do(func() {
+		close(m.done)
+	})
+	return nil
+}
+
+// Purge removes the expired entries and returns how many were removed.
+func (m *Memory) Purge() int {
+	m.mu.Lock()
+	now := m.clock.Now()
+	var pending []evicted
+	for key, it := range m.data {
+		if it.expired(now) {
+			pending = m.removeLocked(key, EvictExpired, pending)
+		}
+	}
+	m.mu.Unlock()
+	m.notify(pending)
+	return len(pending)
+}
+
+// storeLocked stores `it` under `key`, evicting entries to stay within the
+// bounds, and returns the evicted entries. Room is made before a new key is
+// tracked, so that an LFU policy cannot pick it, with the fewest uses, as
+// its own victim. It must be called with the lock held.
+func (m *Memory) storeLocked(key string, it *item) []evicted {
+	old, exists := m.data[key]
+	if exists {
+		delete(m.data, key)
+		m.cost -= old.cost
+		if m.tracker != nil {
+			m.tracker.Access(key)
+		}
+	}
+	var pending []evicted
+	for m.tracker != nil {
+		reason, over := m.overLocked(it.cost)
+		if !over {
+			break
+		}
+		victim, ok := m.tracker.Victim()
+		if !ok {
+			break
+		}
+		if victim == key {
+			// The updated entry is the least valuable one
+			m.tracker.Remove(key, true)
+			return append(pending, evicted{key, it.value, reason})
+		}
+		pending = m.evictLocked(victim, reason, true, pending)
+	}
+	m.data[key] = it
+	m.cost += it.cost
+	if m.tracker != nil && !exists {
+		m.tracker.Add(key)
+	}
+	return pending
+}
+
+// overLocked reports whether storing one more entry of `cost` would exceed
+// a bound, and which. It must be called with the lock held.
+func (m *Memory) overLocked(cost int64) (EvictReason, bool) {
+	switch {
+	case m.maxEntries > 0 && len(m.data) >= m.maxEntries:
+		return EvictMaxEntries, true
+	case m.maxCost > 0 && m.cost+cost > m.maxCost:
+		return EvictMaxCost, true
+	default:
+		return 0, false
+	}
+}
+
+// removeLocked removes the entry of `key`, if any, appending it to
+// `pending`. It must be called with the lock held.
+func (m *Memory) removeLocked(key string, reason EvictReason, pending []evicted) []evicted {
+	if _, ok := m.data[key]; !ok {
+		return pending
+	}
+	return m.evictLocked(key, reason, false, pending)
+}
+
+// evictLocked removes the existing entry of `key`, appending it to
+// `pending`; `victim` tells the tracker whether it chose the entry. It must
+// be called with the lock held.
+func (m *Memory) evictLocked(key string, reason EvictReason, victim bool, pending []evicted) []evicted {
+	it := m.data[key]
+	delete(m.data, key)
+	m.cost -= it.cost
+	if m.tracker != nil {
+		m.tracker.Remove(key, victim)
+	}
+	return append(pending, evicted{key, it.value, reason})
+}
+
+// notify invokes the eviction callback for `pending`, outside the lock.
+func (m *Memory) notify(pending []evicted) {
+	if m.onEvict == nil {
+		return
+	}
+	for _, e := range pending {
+		m.onEvict(e.key, e.value, e.reason)
+	}
+}
+
+// costOf returns the cost of an entry, zero when the cost is not bounded.
+func (m *Memory) costOf(key string, value interface{}) int64 {
+	switch {
+	case m.maxCost <= 0:
+		return 0
+	case m.costFunc != nil:
+		return max(m.costFunc(key, value), 1)
+	default:
+		return estimateCost(key, value)
+	}
+}
+
+// expireAt returns the expiry of an entry stored now for `ttl`.
+func (m *Memory) expireAt(ttl time.Duration) time.Time {
+	if ttl <= 0 {
+		return time.Time{}
+	}
+	return m.clock.Now().Add(ttl)
+}
+
+// janitor purges expired entries periodically until Close is called.
+func (m *Memory) janitor() {
+	ticker := timeutil.NewTTLTicker(m.janitorInterval, janitorJitter, timeutil.WithTickerClock(m.clock))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C():
+			m.Purge()
+		case <-m.done:
+			return
+		}
+	}
+}
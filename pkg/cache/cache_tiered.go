@@ -0,0 +1,379 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"maps"
+	"sync"
+	"time"
+)
+
+// Tiered defaults.
+const (
+	// DefaultLocalTTL caps the TTL of entries in the local tier.
+	DefaultLocalTTL = time.Minute
+
+	// DefaultFlushInterval is the period of write-back flushes.
+	DefaultFlushInterval = time.Second
+)
+
+// WritePolicy tells a Tiered adapter when writes reach the remote tier.
+type WritePolicy int
+
+// Write policies.
+const (
+	// WriteThrough writes to the remote tier before returning.
+	WriteThrough WritePolicy = iota
+
+	// WriteBack writes to the local tier and queues the write for the
+	// remote tier, flushed periodically.
+	WriteBack
+)
+
+// TieredOption configures a Tiered adapter.
+type TieredOption func(*Tiered)
+
+// WithWriteBack selects the WriteBack policy, flushing queued writes every
+// `interval`, DefaultFlushInterval if not positive. Later writes of a key
+// supersede queued ones, so a hot key is written once per flush.
+func WithWriteBack(interval time.Duration) TieredOption {
+	return func(t *Tiered) {
+		t.policy = WriteBack
+		if interval > 0 {
+			t.flushInterval = interval
+		}
+	}
+}
+
+// WithLocalTTL caps the TTL of entries in the local tier, DefaultLocalTTL
+// by default, which bounds how long an instance serves a value changed by
+// another one. Zero removes the cap, except for values read from a remote
+// tier that is not a TTLAdapter, which are kept for DefaultLocalTTL.
+func WithLocalTTL(ttl time.Duration) TieredOption {
+	return func(t *Tiered) {
+		t.localTTL = max(ttl, 0)
+	}
+}
+
+// WithRemoteTTL caps the TTL of entries in the remote tier. Zero, the
+// default, removes the cap.
+func WithRemoteTTL(ttl time.Duration) TieredOption {
+	return func(t *Tiered) {
+		t.remoteTTL = max(ttl, 0)
+	}
+}
+
+// WithOnInvalidate adds a hook called with the keys written or removed, or
+// nil keys after Clear, such as to publish them to the other instances,
+// which drop them from their local tier with Invalidate. It may be given
+// several times.
+func WithOnInvalidate(fn func(ctx context.Context, keys []string)) TieredOption {
+	return func(t *Tiered) {
+		t.onInvalidate = append(t.onInvalidate, fn)
+	}
+}
+
+// WithOnFlushError sets the handler of the errors of background write-back
+// flushes, which log them by default.
+func WithOnFlushError(fn func(err error)) TieredOption {
+	return func(t *Tiered) {
+		if fn != nil {
+			t.onFlushError = fn
+		}
+	}
+}
+
+// write is a write queued for the remote tier.
+type write struct {
+	value  interface{}
+	ttl    time.Duration
+	remove bool
+
+	// seq orders the writes, telling whether one was superseded.
+	seq uint64
+}
+
+// Tiered is an Adapter keeping entries in a remote tier shared by
+// instances, such as a Redis adapter, behind a local tier, typically a
+// Memory adapter, that serves repeated reads without a round trip.
+type Tiered struct {
+	// local and remote are the tiers.
+	local, remote Adapter
+
+	// policy tells when writes reach the remote tier.
+	policy WritePolicy
+
+	// flushInterval is the period of write-back flushes.
+	flushInterval time.Duration
+
+	// localTTL and remoteTTL cap the TTLs of the tiers, zero for no cap.
+	localTTL, remoteTTL time.Duration
+
+	// onInvalidate are called with the keys changed.
+	onInvalidate []func(ctx context.Context, keys []string)
+
+	// onFlushError handles the errors of background flushes.
+	onFlushError func(err error)
+
+	// mu guards pending, the writes queued in write-back mode, and seq, the
+	// sequence number of the last one.
+	mu      sync.Mutex
+	pending map[string]write
+	seq     uint64
+
+	// done stops the flusher, closed once by closeOnce, and stopped is
+	// closed when it returns.
+	done      chan struct{}
+	stopped   chan struct{}
+	closeOnce sync.Once
+}
+
+// NewTiered creates and returns a Tiered adapter over `local` and `remote`,
+// which it owns: Close closes them. In write-back mode, it starts a
+// flusher, stopped by Close.
+func NewTiered(local, remote Adapter, opts ...TieredOption) *Tiered {
+	t := &Tiered{
+		local:         local,
+		remote:        remote,
+		flushInterval: DefaultFlushInterval,
+		localTTL:      DefaultLocalTTL,
+		onFlushError: func(err error) {
+			log.Printf("cache: %v", err)
+		},
+		pending: make(map[string]write),
+		done:    make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(t)
+	}
+	if t.policy == WriteBack {
+		go t.flusher()
+	} else {
+		close(t.stopped)
+	}
+	return t
+}
+
+// Set implements Adapter.
+func (t *Tiered) Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	if t.policy == WriteBack {
+		t.queue(key, write{value: value, ttl: ttl})
+	} else if err := t.remote.Set(ctx, key, value, capTTL(ttl, t.remoteTTL)); err != nil {
+		return err
+	}
+	if err := t.local.Set(ctx, key, value, capTTL(ttl, t.localTTL)); err != nil {
+		return err
+	}
+	t.invalidated(ctx, []string{key})
+	return nil
+}
+
+// SetIfNotExist implements Adapter. In write-back mode, the check is not
+// atomic with respect to other instances.
+func (t *Tiered) SetIfNotExist(ctx context.Context, key string, value interface{}, ttl time.Duration) (bool, error) {
+	if t.policy == WriteBack {
+		if found, err := t.Contains(ctx, key); err != nil || found {
+			return false, err
+		}
+		return true, t.Set(ctx, key, value, ttl)
+	}
+	ok, err := t.remote.SetIfNotExist(ctx, key, value, capTTL(ttl, t.remoteTTL))
+	if err != nil || !ok {
+		return false, err
+	}
+	if err := t.local.Set(ctx, key, value, capTTL(ttl, t.localTTL)); err != nil {
+		return true, err
+	}
+	t.invalidated(ctx, []string{key})
+	return true, nil
+}
+
+// Get implements Adapter. A value found in the remote tier only is copied
+// to the local tier until it expires remotely, if the remote tier is a
+// TTLAdapter, and for at most the local TTL.
+func (t *Tiered) Get(ctx context.Context, key string) (interface{}, bool, error) {
+	if value, found, err := t.local.Get(ctx, key); err != nil || found {
+		return value, found, err
+	}
+	if w, ok := t.queued(key); ok {
+		return w.value, !w.remove, nil
+	}
+	value, ttl, found, err := t.getRemote(ctx, key)
+	if err != nil || !found {
+		return nil, false, err
+	}
+	if err := t.local.Set(ctx, key, value, ttl); err != nil {
+		return nil, false, err
+	}
+	return value, true, nil
+}
+
+// getRemote returns the value of `key` in the remote tier with the TTL of
+// its copy in the local tier.
+func (t *Tiered) getRemote(ctx context.Context, key string) (interface{}, time.Duration, bool, error) {
+	remote, ok := t.remote.(TTLAdapter)
+	if !ok {
+		// The remaining TTL is unknown, so the copy must expire anyway
+		value, found, err := t.remote.Get(ctx, key)
+		return value, capTTL(DefaultLocalTTL, t.localTTL), found, err
+	}
+	value, ttl, found, err := remote.GetWithTTL(ctx, key)
+	return value, capTTL(ttl, t.localTTL), found, err
+}
+
+// Contains implements Adapter.
+func (t *Tiered) Contains(ctx context.Context, key string) (bool, error) {
+	if found, err := t.local.Contains(ctx, key); err != nil || found {
+		return found, err
+	}
+	if w, ok := t.queued(key); ok {
+		return !w.remove, nil
+	}
+	return t.remote.Contains(ctx, key)
+}
+
+// Remove implements Adapter.
+func (t *Tiered) Remove(ctx context.Context, keys ...string) error {
+	if t.policy == WriteBack {
+		for _, key := range keys {
+			t.queue(key, write{remove: true})
+		}
+	} else if err := t.remote.Remove(ctx, keys...); err != nil {
+		return err
+	}
+	if err := t.local.Remove(ctx, keys...); err != nil {
+		return err
+	}
+	t.invalidated(ctx, keys)
+	return nil
+}
+
+// Size implements Adapter, returning the size of the remote tier, which
+// does not count writes not yet flushed.
+func (t *Tiered) Size(ctx context.Context) (int, error) {
+	return t.remote.Size(ctx)
+}
+
+// Clear implements Adapter, clearing both tiers and discarding the queued
+// writes.
+func (t *Tiered) Clear(ctx context.Context) error {
+	t.mu.Lock()
+	t.pending = make(map[string]write)
+	t.mu.Unlock()
+	if err := t.remote.Clear(ctx); err != nil {
+		return err
+	}
+	if err := t.local.Clear(ctx); err != nil {
+		return err
+	}
+	t.invalidated(ctx, nil)
+	return nil
+}
+
+// Close implements Adapter. It stops the flusher, flushes the queued writes
+// and closes both tiers.
+func (t *Tiered) Close(ctx context.Context) error {
+	t.closeOnce.Do(func() {
+		close(t.done)
+	})
+	<-t.stopped
+	return errors.Join(t.Flush(ctx), t.local.Close(ctx), t.remote.Close(ctx))
+}
+
+// Invalidate drops `keys` from the local tier only, as when another
+// instance reports them changed.
+func (t *Tiered) Invalidate(ctx context.Context, keys ...string) error {
+	return t.local.Remove(ctx, keys...)
+}
+
+// InvalidateAll clears the local tier only.
+func (t *Tiered) InvalidateAll(ctx context.Context) error {
+	return t.local.Clear(ctx)
+}
+
+// Flush writes the queued writes to the remote tier and returns their
+// errors joined. A write stays queued, and visible to Get, until it reaches
+// the remote tier or is superseded.
+func (t *Tiered) Flush(ctx context.Context) error {
+	t.mu.Lock()
+	writes := maps.Clone(t.pending)
+	t.mu.Unlock()
+
+	var errs []error
+	for key, w := range writes {
+		var err error
+		if w.remove {
+			err = t.remote.Remove(ctx, key)
+		} else {
+			err = t.remote.Set(ctx, key, w.value, capTTL(w.ttl, t.remoteTTL))
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("cache: flush %q: %w", key, err))
+			continue
+		}
+		t.mu.Lock()
+		if t.pending[key].seq == w.seq {
+			delete(t.pending, key)
+		}
+		t.mu.Unlock()
+	}
+	return errors.Join(errs...)
+}
+
+// queue queues `w` for `key`, superseding any queued write.
+func (t *Tiered) queue(key string, w write) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.seq++
+	w.seq = t.seq
+	t.pending[key] = w
+}
+
+// queued returns the write queued for `key`, reporting whether there is
+// one.
+func (t *Tiered) queued(key string) (write, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	w, ok := t.pending[key]
+	return w, ok
+}
+
+// invalidated calls the invalidation hooks with `keys`.
+func (t *Tiered) invalidated(ctx context.Context, keys []string) {
+	for _, fn := range t.onInvalidate {
+		fn(ctx, keys)
+	}
+}
+
+// flusher flushes the queued writes periodically until Close is called.
+func (t *Tiered) flusher() {
+	defer close(t.stopped)
+	ticker := time.NewTicker(t.flushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := t.Flush(context.Background()); err != nil {
+				t.onFlushError(err)
+			}
+		case <-t.done:
+			return
+		}
+	}
+}
+
+// capTTL returns `ttl` capped to `limit`, a zero `limit` meaning no cap and
+// a zero `ttl` no expiry.
+func capTTL(ttl, limit time.Duration) time.Duration {
+	if limit <= 0 || (ttl > 0 && ttl < limit) {
+		return ttl
+	}
+	return limit
+}
@@ -0,0 +1,321 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package cli builds command-line tools with nested subcommands.
+//
+// The flags of a command are the fields of a struct tagged with `flag`:
+//
+//	var opts struct {
+//		Port    int           `flag:"port" short:"p" usage:"port to listen on" default:"8080"`
+//		Timeout time.Duration `flag:"timeout" env:"APP_TIMEOUT" usage:"request timeout"`
+//		Tags    []string      `flag:"tag" usage:"tag to apply, repeatable"`
+//		Token   string        `flag:"token" required:"true" usage:"API token"`
+//	}
+//
+// Values are converted to the field types by pkg/conv; slice fields take
+// the flag repeatedly. A value comes from the command line, else from the
+// variable named by `env`, else from `default`. The flags of a command are
+// also accepted by its subcommands, before or after their names.
+//
+// Every command gets -h/--help, printing help generated from the command
+// tree, and the root command answers the hidden "__complete" command used
+// by the scripts of GenCompletion for shell completion.
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+)
+
+// ErrUsage is wrapped by the errors caused by invalid command lines, as
+// opposed to errors returned by Run.
+var ErrUsage = errors.New("cli: invalid usage")
+
+// Command is a command of a tool, possibly with subcommands.
+type Command struct {
+	// Name is the name of the command, the program name for the root.
+	Name string
+
+	// Aliases are alternative names of the command.
+	Aliases []string
+
+	// Short is the one-line description shown in command lists.
+	Short string
+
+	// Long is the description shown in the help of the command, Short if
+	// empty.
+	Long string
+
+	// Usage describes the positional arguments, such as "<src> <dst>".
+	Usage string
+
+	// Version is printed by --version when set on the root command.
+	Version string
+
+	// Flags points to the struct holding the flags of the command, or is
+	// nil if it has none.
+	Flags any
+
+	// Args validates the positional arguments before Run, see ExactArgs
+	// and the other validators.
+	Args ArgsFunc
+
+	// Complete returns the completion candidates of the positional argument
+	// starting with `prefix`, after the arguments `args`.
+	Complete func(args []string, prefix string) []string
+
+	// Run runs the command. A command without Run prints its help.
+	Run func(ctx *Context) error
+
+	// Hidden omits the command from help and completion.
+	Hidden bool
+
+	// parent is the command this one was added to.
+	parent *Command
+
+	// commands holds the subcommands in the order they were added.
+	commands []*Command
+
+	// flags holds the flags bound from Flags, once parsed.
+	flags *flagSet
+}
+
+// Context is passed to Run.
+type Context struct {
+	context.Context
+
+	// Command is the command being run.
+	Command *Command
+
+	// Args holds the positional arguments.
+	Args []string
+
+	// Stdout and Stderr are the outputs of the command.
+	Stdout io.Writer
+	Stderr io.Writer
+}
+
+// AddCommand adds `cmds` as subcommands.
+func (c *Command) AddCommand(cmds ...*Command) *Command {
+	for _, cmd := range cmds {
+		cmd.parent = c
+		c.commands = append(c.commands, cmd)
+	}
+	return c
+}
+
+// Commands returns the subcommands.
+func (c *Command) Commands() []*Command {
+	return slices.Clone(c.commands)
+}
+
+// Parent returns the command this one was added to, nil for the root.
+func (c *Command) Parent() *Command {
+	return c.parent
+}
+
+// Path returns the names of the command and its ancestors, from the root,
+// separated by spaces.
+func (c *Command) Path() string {
+	if c.parent == nil {
+		return c.Name
+	}
+	return c.parent.Path() + " " + c.Name
+}
+
+// Lookup returns the subcommand called `name` or having it as an alias,
+// or nil.
+func (c *Command) Lookup(name string) *Command {
+	for _, cmd := range c.commands {
+		if cmd.Name == name || slices.Contains(cmd.Aliases, name) {
+			return cmd
+		}
+	}
+	return nil
+}
+
+// Execute runs the command line `args`, os.Args[1:] if nil, writing to the
+// standard outputs.
+func (c *Command) Execute(args []string) error {
+	return c.ExecuteContext(context.Background(), args, os.Stdout, os.Stderr)
+}
+
+// ExecuteContext runs the command line `args`, os.Args[1:] if nil, with
+// `ctx` and the outputs `stdout` and `stderr`.
+//
+// It resolves the subcommand, binds the flags of it and its ancestors,
+// validates the arguments and calls Run. Errors of the command line wrap
+// ErrUsage.
+func (c *Command) ExecuteContext(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	if args == nil {
+		args = os.Args[1:]
+	}
+	if len(args) > 0 && args[0] == completeCommand {
+		c.complete(stdout, args[1:])
+		return nil
+	}
+
+	cmd, positional, err := c.parse(args)
+	if errors.Is(err, errHelp) {
+		return cmd.WriteHelp(stdout)
+	}
+	if errors.Is(err, errVersion) {
+		_, err = fmt.Fprintf(stdout, "%s %s\n", c.Name, c.Version)
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	if cmd.Run == nil {
+		if len(positional) > 0 {
+			return fmt.Errorf("%w: unknown command %q for %q", ErrUsage, positional[0], cmd.Path())
+		}
+		return cmd.WriteHelp(stdout)
+	}
+	if cmd.Args != nil {
+		if err := cmd.Args(cmd, positional); err != nil {
+			return fmt.Errorf("%w: %s: %w", ErrUsage, cmd.Path(), err)
+		}
+	}
+	return cmd.Run(&Context{Context: ctx, Command: cmd, Args: positional, Stdout: stdout, Stderr: stderr})
+}
+
+// Sentinels of the built-in flags, handled by ExecuteContext.
+var (
+	errHelp    = errors.New("cli: help requested")
+	errVersion = errors.New("cli: version requested")
+)
+
+// parse resolves the subcommand of `args` and sets the flags, returning
+// the command and its positional arguments.
+func (c *Command) parse(args []string) (*Command, []string, error) {
+	cmd := c
+	if err := cmd.bind(); err != nil {
+		return cmd, nil, err
+	}
+
+	var positional []string
+	seen := make(map[*flag]bool)
+	help := false
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--":
+			positional = append(positional, args[i+1:]...)
+			i = len(args)
+
+		case arg == "-h" || arg == "--help":
+			help = true
+
+		case arg == "--version" && c.Version != "" && cmd == c:
+			return cmd, nil, errVersion
+
+		case strings.HasPrefix(arg, "-") && arg != "-":
+			consumed, err := cmd.parseFlag(args[i:], seen)
+			if err != nil {
+				return cmd, nil, err
+			}
+			i += consumed - 1
+
+		default:
+			// Subcommands are resolved until the first positional argument
+			if len(positional) == 0 {
+				if sub := cmd.Lookup(arg); sub != nil {
+					cmd = sub
+					if err := cmd.bind(); err != nil {
+						return cmd, nil, err
+					}
+					continue
+				}
+			}
+			positional = append(positional, arg)
+		}
+	}
+	if help {
+		return cmd, nil, errHelp
+	}
+
+	for owner := cmd; owner != nil; owner = owner.parent {
+		if err := owner.flags.apply(seen); err != nil {
+			return cmd, nil, err
+		}
+	}
+	return cmd, positional, nil
+}
+
+// parseFlag parses the flag at the start of `args`, of `c` or one of its
+// ancestors, returning the number of arguments consumed.
+func (c *Command) parseFlag(args []string, seen map[*flag]bool) (int, error) {
+	arg := args[0]
+	long := strings.HasPrefix(arg, "--")
+	name, value, hasValue := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+
+	// Grouped short flags such as -vx, the last one possibly taking a value
+	if !long && len(name) > 1 && !hasValue {
+		for j, short := range name {
+			f := c.findFlag(string(short), false)
+			if f == nil {
+				return 0, fmt.Errorf("%w: unknown shorthand flag %q in %q", ErrUsage, short, arg)
+			}
+			if f.isBool() {
+				if err := f.set("true", seen); err != nil {
+					return 0, err
+				}
+				continue
+			}
+			if rest := name[j+1:]; rest != "" {
+				return 1, f.set(rest, seen)
+			}
+			if len(args) < 2 {
+				return 0, fmt.Errorf("%w: flag -%c needs a value", ErrUsage, short)
+			}
+			return 2, f.set(args[1], seen)
+		}
+		return 1, nil
+	}
+
+	f := c.findFlag(name, long)
+	if f == nil {
+		return 0, fmt.Errorf("%w: unknown flag %q for %q", ErrUsage, arg, c.Path())
+	}
+	switch {
+	case hasValue:
+		return 1, f.set(value, seen)
+	case f.isBool():
+		return 1, f.set("true", seen)
+	case len(args) < 2:
+		return 0, fmt.Errorf("%w: flag %s needs a value", ErrUsage, arg)
+	default:
+		return 2, f.set(args[1], seen)
+	}
+}
+
+// findFlag returns the flag of `c` or its ancestors with the long or short
+// `name`, or nil.
+func (c *Command) findFlag(name string, long bool) *flag {
+	for owner := c; owner != nil; owner = owner.parent {
+		if f := owner.flags.lookup(name, long); f != nil {
+			return f
+		}
+	}
+	return nil
+}
+
+// bind collects the flags of the command from its Flags struct, once.
+func (c *Command) bind() error {
+	if c.flags != nil {
+		return nil
+	}
+	fs, err := newFlagSet(c.Flags)
+	if err != nil {
+		return fmt.Errorf("cli: command %q: %w", c.Path(), err)
+	}
+	c.flags = fs
+	return nil
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package cli
+
+import (
+	"fmt"
+	"slices"
+)
+
+// ArgsFunc validates the positional arguments of a command.
+type ArgsFunc func(cmd *Command, args []string) error
+
+// NoArgs accepts no argument.
+func NoArgs(cmd *Command, args []string) error {
+	if len(args) > 0 {
+		return fmt.Errorf("unexpected argument %q", args[0])
+	}
+	return nil
+}
+
+// ExactArgs accepts exactly `n` arguments.
+func ExactArgs(n int) ArgsFunc {
+	return RangeArgs(n, n)
+}
+
+// MinArgs accepts at least `n` arguments.
+func MinArgs(n int) ArgsFunc {
+	return RangeArgs(n, -1)
+}
+
+// MaxArgs accepts at most `n` arguments.
+func MaxArgs(n int) ArgsFunc {
+	return RangeArgs(0, n)
+}
+
+// RangeArgs accepts between `min` and `max` arguments, `max` being
+// unbounded if negative.
+func RangeArgs(min, max int) ArgsFunc {
+	return func(cmd *Command, args []string) error {
+		switch {
+		case len(args) < min && min == max:
+			return fmt.Errorf("expected %s, got %d", plural(min), len(args))
+		case len(args) < min:
+			return fmt.Errorf("expected at least %s, got %d", plural(min), len(args))
+		case max >= 0 && len(args) > max && min == max:
+			return fmt.Errorf("expected %s, got %d", plural(max), len(args))
+		case max >= 0 && len(args) > max:
+			return fmt.Errorf("expected at most %s, got %d", plural(max), len(args))
+		}
+		return nil
+	}
+}
+
+// OneOf accepts only arguments among `valid`.
+func OneOf(valid ...string) ArgsFunc {
+	return func(cmd *Command, args []string) error {
+		for _, arg := range args {
+			if !slices.Contains(valid, arg) {
+				return fmt.Errorf("invalid argument %q, expected one of %v", arg, valid)
+			}
+		}
+		return nil
+	}
+}
+
+// All accepts the arguments accepted by all of `validators`.
+func All(validators ...ArgsFunc) ArgsFunc {
+	return func(cmd *Command, args []string) error {
+		for _, validate := range validators {
+			if err := validate(cmd, args); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// plural formats `n` arguments.
+func plural(n int) string {
+	if n == 1 {
+		return "1 argument"
+	}
+	return fmt.Sprintf("%d arguments", n)
+}
@@ -0,0 +1,230 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/focela/aegis/pkg/conv"
+)
+
+// flag is a flag bound to a struct field.
+type flag struct {
+	// name and short are the long and one-letter names.
+	name  string
+	short string
+
+	usage    string
+	def      string
+	env      string
+	required bool
+
+	// field is the bound field and typ its type.
+	field reflect.Value
+	typ   reflect.Type
+
+	// values holds the values of a slice flag given so far.
+	values []string
+}
+
+// flagSet holds the flags of a command.
+type flagSet struct {
+	flags []*flag
+}
+
+// durationType is the type of time.Duration, named in help.
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// newFlagSet collects the flags from the fields of the struct pointed to by
+// `v`, which may be nil.
+func newFlagSet(v any) (*flagSet, error) {
+	fs := &flagSet{}
+	if v == nil {
+		return fs, nil
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("flags must be a non-nil pointer to a struct, got %T", v)
+	}
+	rv = rv.Elem()
+	names := make(map[string]bool)
+	for _, sf := range reflect.VisibleFields(rv.Type()) {
+		name, ok := sf.Tag.Lookup("flag")
+		if !ok || name == "-" || !sf.IsExported() {
+			continue
+		}
+		f := &flag{
+			name:     name,
+			short:    sf.Tag.Get("short"),
+			usage:    sf.Tag.Get("usage"),
+			def:      sf.Tag.Get("default"),
+			env:      sf.Tag.Get("env"),
+			required: sf.Tag.Get("required") == "true",
+			field:    rv.FieldByIndex(sf.Index),
+			typ:      sf.Type,
+		}
+		if f.name == "" {
+			f.name = kebab(sf.Name)
+		}
+		if len([]rune(f.short)) > 1 {
+			return nil, fmt.Errorf("shorthand %q of flag --%s is not a single letter", f.short, f.name)
+		}
+		for _, n := range []string{"--" + f.name, "-" + f.short} {
+			if n != "-" && names[n] {
+				return nil, fmt.Errorf("duplicate flag %s", n)
+			}
+			names[n] = true
+		}
+		fs.flags = append(fs.flags, f)
+	}
+	return fs, nil
+}
+
+// lookup returns the flag with the long or short `name`, or nil.
+func (fs *flagSet) lookup(name string, long bool) *flag {
+	if fs == nil {
+		return nil
+	}
+	for _, f := range fs.flags {
+		if (long && f.name == name) || (!long && f.short == name) {
+			return f
+		}
+	}
+	return nil
+}
+
+// apply sets the flags absent from the command line from their variables
+// or defaults, and checks the required ones.
+func (fs *flagSet) apply(seen map[*flag]bool) error {
+	if fs == nil {
+		return nil
+	}
+	for _, f := range fs.flags {
+		if seen[f] {
+			continue
+		}
+		if f.env != "" {
+			if v, ok := os.LookupEnv(f.env); ok && v != "" {
+				if err := f.assign(v, f.isSlice()); err != nil {
+					return fmt.Errorf("%w (from $%s)", err, f.env)
+				}
+				continue
+			}
+		}
+		if f.required {
+			return fmt.Errorf("%w: required flag --%s is not set", ErrUsage, f.name)
+		}
+		if f.def != "" {
+			if err := f.assign(f.def, f.isSlice()); err != nil {
+				return fmt.Errorf("cli: invalid default of flag --%s: %w", f.name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// set sets the flag to `value` given on the command line. Slice flags
+// accumulate their values.
+func (f *flag) set(value string, seen map[*flag]bool) error {
+	if f.isSlice() {
+		if !seen[f] {
+			f.values = nil
+		}
+		f.values = append(f.values, value)
+		seen[f] = true
+		return f.assignValue(f.values)
+	}
+	seen[f] = true
+	return f.assign(value, false)
+}
+
+// assign converts `value` into the field, splitting it by commas first
+// for slice flags if `split` is set.
+func (f *flag) assign(value string, split bool) error {
+	if split {
+		parts := strings.Split(value, ",")
+		for i := range parts {
+			parts[i] = strings.TrimSpace(parts[i])
+		}
+		return f.assignValue(parts)
+	}
+	return f.assignValue(value)
+}
+
+// assignValue converts `value` into the field with conv, through a struct
+// holding a field of the same type.
+func (f *flag) assignValue(value any) error {
+	holder := reflect.New(reflect.StructOf([]reflect.StructField{{
+		Name: "V",
+		Type: f.typ,
+		Tag:  `conv:"v"`,
+	}}))
+	if err := conv.Struct(map[string]any{"v": value}, holder.Interface()); err != nil {
+		// Drop the mention of the holder field
+		if cause := errors.Unwrap(err); cause != nil {
+			err = cause
+		}
+		return fmt.Errorf("%w: invalid value %q for flag --%s: %w", ErrUsage, fmt.Sprint(value), f.name, err)
+	}
+	f.field.Set(holder.Elem().Field(0))
+	return nil
+}
+
+// isBool reports whether the flag takes no value.
+func (f *flag) isBool() bool {
+	return f.typ.Kind() == reflect.Bool
+}
+
+// isSlice reports whether the flag may be repeated.
+func (f *flag) isSlice() bool {
+	return f.typ.Kind() == reflect.Slice && f.typ.Elem().Kind() != reflect.Uint8
+}
+
+// typeName returns the name of the value type shown in help.
+func (f *flag) typeName() string {
+	t := f.typ
+	suffix := ""
+	if f.isSlice() {
+		t, suffix = t.Elem(), "s"
+	}
+	switch {
+	case t == durationType:
+		return "duration" + suffix
+	case t.Kind() == reflect.Bool:
+		return ""
+	case t.Kind() == reflect.String:
+		return "string" + suffix
+	case t.Kind() >= reflect.Int && t.Kind() <= reflect.Uint64:
+		return "int" + suffix
+	case t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64:
+		return "float" + suffix
+	}
+	return "value" + suffix
+}
+
+// kebab converts the Go name `s` to kebab case, such as "dry-run" for
+// DryRun.
+func kebab(s string) string {
+	var b strings.Builder
+	runes := []rune(s)
+	for i, r := range runes {
+		lower := r >= 'A' && r <= 'Z'
+		if lower {
+			prevLower := i > 0 && !(runes[i-1] >= 'A' && runes[i-1] <= 'Z')
+			nextLower := i+1 < len(runes) && runes[i+1] >= 'a' && runes[i+1] <= 'z'
+			if i > 0 && (prevLower || nextLower) {
+				b.WriteByte('-')
+			}
+			r += 'a' - 'A'
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
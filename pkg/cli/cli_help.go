@@ -0,0 +1,236 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// completeCommand is the hidden command printing completion candidates.
+const completeCommand = "__complete"
+
+// WriteHelp writes the help of the command to `w`.
+func (c *Command) WriteHelp(w io.Writer) error {
+	if err := c.bind(); err != nil {
+		return err
+	}
+	var b strings.Builder
+	if desc := c.description(); desc != "" {
+		b.WriteString(desc + "\n\n")
+	}
+
+	b.WriteString("Usage:\n")
+	if c.Run != nil {
+		line := "  " + c.Path()
+		if len(c.allFlags()) > 0 {
+			line += " [flags]"
+		}
+		if c.Usage != "" {
+			line += " " + c.Usage
+		}
+		b.WriteString(line + "\n")
+	}
+	if len(c.visibleCommands()) > 0 {
+		b.WriteString("  " + c.Path() + " <command> [flags]\n")
+	}
+	if len(c.Aliases) > 0 {
+		b.WriteString("\nAliases:\n  " + strings.Join(append([]string{c.Name}, c.Aliases...), ", ") + "\n")
+	}
+
+	if cmds := c.visibleCommands(); len(cmds) > 0 {
+		b.WriteString("\nCommands:\n")
+		tw := tabwriter.NewWriter(&b, 0, 4, 3, ' ', 0)
+		for _, cmd := range cmds {
+			fmt.Fprintf(tw, "  %s\t%s\n", cmd.Name, cmd.Short)
+		}
+		tw.Flush()
+	}
+
+	b.WriteString("\nFlags:\n")
+	writeFlags(&b, c.flags.flags, c)
+	var global []*flag
+	for owner := c.parent; owner != nil; owner = owner.parent {
+		if owner.bind() == nil {
+			global = append(global, owner.flags.flags...)
+		}
+	}
+	if len(global) > 0 {
+		b.WriteString("\nGlobal Flags:\n")
+		writeFlags(&b, global, nil)
+	}
+
+	if len(c.visibleCommands()) > 0 {
+		fmt.Fprintf(&b, "\nUse \"%s <command> --help\" for more information about a command.\n", c.Path())
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// GenCompletion writes to `w` the completion script of the root command
+// for `shell`, one of "bash", "zsh" and "fish". The script calls the
+// program, named after the root command, to get the candidates.
+func (c *Command) GenCompletion(w io.Writer, shell string) error {
+	root := c
+	for root.parent != nil {
+		root = root.parent
+	}
+	name := root.Name
+	fn := "_" + strings.NewReplacer("-", "_", ".", "_").Replace(name) + "_complete"
+	var script string
+	switch shell {
+	case "bash":
+		script = fmt.Sprintf(`# bash completion for %[1]s
+%[2]s() {
+	local IFS=$'\n'
+	COMPREPLY=($(%[1]s %[3]s "${COMP_WORDS[@]:1:COMP_CWORD}" 2>/dev/null))
+}
+complete -o default -F %[2]s %[1]s
+`, name, fn, completeCommand)
+	case "zsh":
+		script = fmt.Sprintf(`#compdef %[1]s
+%[2]s() {
+	local -a candidates
+	candidates=("${(@f)$(%[1]s %[3]s "${words[@]:1:$((CURRENT-1))}" 2>/dev/null)}")
+	compadd -a candidates
+}
+compdef %[2]s %[1]s
+`, name, fn, completeCommand)
+	case "fish":
+		script = fmt.Sprintf(`# fish completion for %[1]s
+complete -c %[1]s -f -a '(%[1]s %[2]s (commandline -opc)[2..-1] (commandline -ct))'
+`, name, completeCommand)
+	default:
+		return fmt.Errorf("cli: unsupported shell %q", shell)
+	}
+	_, err := io.WriteString(w, script)
+	return err
+}
+
+// complete writes the candidates completing the last of `words`, one per
+// line.
+func (c *Command) complete(w io.Writer, words []string) {
+	if len(words) == 0 {
+		words = []string{""}
+	}
+	prefix, words := words[len(words)-1], words[:len(words)-1]
+
+	// Follow the subcommands, skipping flags and their values
+	cmd := c
+	var args []string
+	for i := 0; i < len(words); i++ {
+		word := words[i]
+		if strings.HasPrefix(word, "-") {
+			if cmd.bind() == nil && !strings.Contains(word, "=") {
+				long := strings.HasPrefix(word, "--")
+				if f := cmd.findFlag(strings.TrimLeft(word, "-"), long); f != nil && !f.isBool() {
+					i++
+				}
+			}
+			continue
+		}
+		if sub := cmd.Lookup(word); sub != nil && len(args) == 0 {
+			cmd = sub
+			continue
+		}
+		args = append(args, word)
+	}
+
+	var candidates []string
+	switch {
+	case strings.HasPrefix(prefix, "-"):
+		if cmd.bind() != nil {
+			return
+		}
+		for owner := cmd; owner != nil; owner = owner.parent {
+			if owner.bind() != nil {
+				continue
+			}
+			for _, f := range owner.flags.flags {
+				candidates = append(candidates, "--"+f.name)
+			}
+		}
+		candidates = append(candidates, "--help")
+	default:
+		if len(args) == 0 {
+			for _, sub := range cmd.visibleCommands() {
+				candidates = append(candidates, sub.Name)
+			}
+		}
+		if cmd.Complete != nil {
+			candidates = append(candidates, cmd.Complete(args, prefix)...)
+		}
+	}
+	for _, candidate := range candidates {
+		if strings.HasPrefix(candidate, prefix) {
+			fmt.Fprintln(w, candidate)
+		}
+	}
+}
+
+// description returns the long description, or the short one.
+func (c *Command) description() string {
+	if c.Long != "" {
+		return strings.TrimSpace(c.Long)
+	}
+	return c.Short
+}
+
+// visibleCommands returns the subcommands that are not hidden.
+func (c *Command) visibleCommands() []*Command {
+	var cmds []*Command
+	for _, cmd := range c.commands {
+		if !cmd.Hidden {
+			cmds = append(cmds, cmd)
+		}
+	}
+	return cmds
+}
+
+// allFlags returns the flags of the command and its ancestors.
+func (c *Command) allFlags() []*flag {
+	var flags []*flag
+	for owner := c; owner != nil; owner = owner.parent {
+		if owner.bind() == nil {
+			flags = append(flags, owner.flags.flags...)
+		}
+	}
+	return flags
+}
+
+// writeFlags writes a table of `flags`, followed by the built-in flags of
+// `cmd` if not nil.
+func writeFlags(w io.Writer, flags []*flag, cmd *Command) {
+	tw := tabwriter.NewWriter(w, 0, 4, 3, ' ', 0)
+	for _, f := range flags {
+		names := "    --" + f.name
+		if f.short != "" {
+			names = "-" + f.short + ", --" + f.name
+		}
+		if t := f.typeName(); t != "" {
+			names += " " + t
+		}
+		usage := f.usage
+		if f.def != "" {
+			usage += fmt.Sprintf(" (default %s)", f.def)
+		}
+		if f.env != "" {
+			usage += " [$" + f.env + "]"
+		}
+		if f.required {
+			usage += " (required)"
+		}
+		fmt.Fprintf(tw, "  %s\t%s\n", names, strings.TrimSpace(usage))
+	}
+	if cmd != nil {
+		fmt.Fprintf(tw, "  %s\t%s\n", "-h, --help", "show help for "+cmd.Name)
+		if cmd.parent == nil && cmd.Version != "" {
+			fmt.Fprintf(tw, "  %s\t%s\n", "    --version", "print the version")
+		}
+	}
+	tw.Flush()
+}
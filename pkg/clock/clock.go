@@ -0,0 +1,132 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package clock abstracts the passage of time so time-based logic can be
+// tested without sleeping.
+//
+// Code that reads the time or waits takes a Clock, Real() in production.
+// Tests pass a Fake instead, whose time only moves when Advance or Set is
+// called, firing the timers, tickers and sleeps that come due, in order.
+package clock
+
+import "time"
+
+// Clock tells the time and creates timers.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// Since returns the time elapsed since `t`.
+	Since(t time.Time) time.Duration
+
+	// Until returns the duration until `t`.
+	Until(t time.Time) time.Duration
+
+	// Sleep pauses the calling goroutine for `d`.
+	Sleep(d time.Duration)
+
+	// After returns a channel receiving the time once `d` has elapsed.
+	After(d time.Duration) <-chan time.Time
+
+	// NewTimer creates a Timer sending the time on its channel after `d`.
+	NewTimer(d time.Duration) Timer
+
+	// AfterFunc creates a Timer calling `f` in its own goroutine after `d`.
+	AfterFunc(d time.Duration, f func()) Timer
+
+	// NewTicker creates a Ticker sending the time on its channel every `d`.
+	NewTicker(d time.Duration) Ticker
+}
+
+// Timer is a single event, like time.Timer.
+type Timer interface {
+	// C returns the channel of the timer, nil for a timer of AfterFunc.
+	C() <-chan time.Time
+
+	// Stop prevents the timer from firing, reporting whether it was active.
+	Stop() bool
+
+	// Reset makes the timer fire after `d`, reporting whether it was active.
+	Reset(d time.Duration) bool
+}
+
+// Ticker is a periodic event, like time.Ticker.
+type Ticker interface {
+	// C returns the channel of the ticker.
+	C() <-chan time.Time
+
+	// Stop turns the ticker off.
+	Stop()
+
+	// Reset changes the period of the ticker to `d`.
+	Reset(d time.Duration)
+}
+
+// realClock is the Clock of the system.
+type realClock struct{}
+
+// realTimer adapts time.Timer.
+type realTimer struct {
+	*time.Timer
+}
+
+// realTicker adapts time.Ticker.
+type realTicker struct {
+	*time.Ticker
+}
+
+// Real returns the Clock of the system, backed by package time.
+func Real() Clock {
+	return realClock{}
+}
+
+// Now implements Clock.
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+// Since implements Clock.
+func (realClock) Since(t time.Time) time.Duration {
+	return time.Since(t)
+}
+
+// Until implements Clock.
+func (realClock) Until(t time.Time) time.Duration {
+	return time.Until(t)
+}
+
+// Sleep implements Clock.
+func (realClock) Sleep(d time.Duration) {
+	time.Sleep(d)
+}
+
+// After implements Clock.
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// NewTimer implements Clock.
+func (realClock) NewTimer(d time.Duration) Timer {
+	return realTimer{time.NewTimer(d)}
+}
+
+// AfterFunc implements Clock.
+func (realClock) AfterFunc(d time.Duration, f func()) Timer {
+	return realTimer{time.AfterFunc(d, f)}
+}
+
+// NewTicker implements Clock.
+func (realClock) NewTicker(d time.Duration) Ticker {
+	return realTicker{time.NewTicker(d)}
+}
+
+// C implements Timer.
+func (t realTimer) C() <-chan time.Time {
+	return t.Timer.C
+}
+
+// C implements Ticker.
+func (t realTicker) C() <-chan time.Time {
+	return t.Ticker.C
+}
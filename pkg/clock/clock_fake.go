@@ -0,0 +1,255 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+// Fake is a Clock whose time is set by the test. It is safe for concurrent
+// use.
+type Fake struct {
+	// mu guards the fields below it.
+	mu sync.Mutex
+
+	// cond signals changes of waiters.
+	cond *sync.Cond
+
+	// now is the current time.
+	now time.Time
+
+	// waiters holds the active timers and tickers.
+	waiters []*fakeWaiter
+}
+
+// fakeWaiter is a timer or ticker of a Fake.
+type fakeWaiter struct {
+	clock *Fake
+
+	// at is the next firing time.
+	at time.Time
+
+	// period is the period of a ticker, zero for a timer.
+	period time.Duration
+
+	// ch receives the firing times, nil for AfterFunc.
+	ch chan time.Time
+
+	// fn is called when an AfterFunc timer fires.
+	fn func()
+
+	// active reports whether the waiter is registered.
+	active bool
+}
+
+// NewFake returns a Fake set to `now`, or to a fixed date if `now` is the
+// zero time.
+func NewFake(now ...time.Time) *Fake {
+	f := &Fake{now: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)}
+	if len(now) > 0 && !now[0].IsZero() {
+		f.now = now[0]
+	}
+	f.cond = sync.NewCond(&f.mu)
+	return f
+}
+
+// Now implements Clock.
+func (f *Fake) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+// Since implements Clock.
+func (f *Fake) Since(t time.Time) time.Duration {
+	return f.Now().Sub(t)
+}
+
+// Until implements Clock.
+func (f *Fake) Until(t time.Time) time.Duration {
+	return t.Sub(f.Now())
+}
+
+// Sleep implements Clock. It returns once the time has been advanced by `d`.
+func (f *Fake) Sleep(d time.Duration) {
+	<-f.After(d)
+}
+
+// After implements Clock.
+func (f *Fake) After(d time.Duration) <-chan time.Time {
+	return f.NewTimer(d).C()
+}
+
+// NewTimer implements Clock.
+func (f *Fake) NewTimer(d time.Duration) Timer {
+	return f.add(&fakeWaiter{clock: f, ch: make(chan time.Time, 1)}, d)
+}
+
+// AfterFunc implements Clock. The Fake calls `f` synchronously while
+// advancing, so its effects are visible when Advance returns.
+func (f *Fake) AfterFunc(d time.Duration, fn func()) Timer {
+	return f.add(&fakeWaiter{clock: f, fn: fn}, d)
+}
+
+// NewTicker implements Clock. It panics if `d` is not positive.
+func (f *Fake) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		panic("clock: non-positive ticker period")
+	}
+	return fakeTicker{f.add(&fakeWaiter{clock: f, ch: make(chan time.Time, 1), period: d}, d)}
+}
+
+// Advance moves the time forward by `d`, firing the waiters coming due in
+// order of their times.
+func (f *Fake) Advance(d time.Duration) {
+	f.Set(f.Now().Add(d))
+}
+
+// Set moves the time to `t`, firing the waiters coming due in order of their
+// times. Setting a time in the past fires nothing.
+func (f *Fake) Set(t time.Time) {
+	for {
+		f.mu.Lock()
+		w := f.next(t)
+		if w == nil {
+			if t.After(f.now) {
+				f.now = t
+			}
+			f.mu.Unlock()
+			return
+		}
+		if w.at.After(f.now) {
+			f.now = w.at
+		}
+		now := f.now
+		if w.period == 0 {
+			f.remove(w)
+		}
+
+		// Sending under the lock orders the ticks of a ticker
+		if w.fn == nil {
+			select {
+			case w.ch <- now:
+				w.at = w.at.Add(w.period)
+			default:
+				// Like the real ones, slow receivers miss ticks, so the
+				// ticks due until `t` are dropped at once
+				if w.period > 0 {
+					w.at = w.at.Add((t.Sub(w.at)/w.period + 1) * w.period)
+				}
+			}
+		}
+		f.mu.Unlock()
+
+		if w.fn != nil {
+			w.fn()
+		}
+	}
+}
+
+// Waiters returns the number of active timers and tickers.
+func (f *Fake) Waiters() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.waiters)
+}
+
+// BlockUntil waits until at least `n` timers and tickers are active, so a
+// test can advance the time once the code under test is waiting.
+func (f *Fake) BlockUntil(n int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for len(f.waiters) < n {
+		f.cond.Wait()
+	}
+}
+
+// add registers `w` to fire after `d`.
+func (f *Fake) add(w *fakeWaiter, d time.Duration) *fakeWaiter {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	w.at = f.now.Add(d)
+	w.active = true
+	f.waiters = append(f.waiters, w)
+	f.cond.Broadcast()
+	return w
+}
+
+// next returns the earliest waiter due at `t`, or nil. It must be called
+// with the lock held.
+func (f *Fake) next(t time.Time) *fakeWaiter {
+	var first *fakeWaiter
+	for _, w := range f.waiters {
+		if !w.at.After(t) && (first == nil || w.at.Before(first.at)) {
+			first = w
+		}
+	}
+	return first
+}
+
+// remove unregisters `w`, reporting whether it was active. It must be
+// called with the lock held.
+func (f *Fake) remove(w *fakeWaiter) bool {
+	if !w.active {
+		return false
+	}
+	w.active = false
+	for i, other := range f.waiters {
+		if other == w {
+			f.waiters = append(f.waiters[:i], f.waiters[i+1:]...)
+			break
+		}
+	}
+	f.cond.Broadcast()
+	return true
+}
+
+// C implements Timer and Ticker.
+func (w *fakeWaiter) C() <-chan time.Time {
+	return w.ch
+}
+
+// Stop implements Timer.
+func (w *fakeWaiter) Stop() bool {
+	w.clock.mu.Lock()
+	defer w.clock.mu.Unlock()
+	return w.clock.remove(w)
+}
+
+// Reset implements Timer.
+func (w *fakeWaiter) Reset(d time.Duration) bool {
+	f := w.clock
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	active := f.remove(w)
+	w.at = f.now.Add(d)
+	w.active = true
+	f.waiters = append(f.waiters, w)
+	f.cond.Broadcast()
+	return active
+}
+
+// fakeTicker adapts a fakeWaiter to Ticker, whose Stop and Reset return
+// nothing.
+type fakeTicker struct {
+	*fakeWaiter
+}
+
+// Stop implements Ticker.
+func (t fakeTicker) Stop() {
+	t.fakeWaiter.Stop()
+}
+
+// Reset implements Ticker. It panics if `d` is not positive.
+func (t fakeTicker) Reset(d time.Duration) {
+	if d <= 0 {
+		panic("clock: non-positive ticker period")
+	}
+	t.clock.mu.Lock()
+	t.period = d
+	t.clock.mu.Unlock()
+	t.fakeWaiter.Reset(d)
+}
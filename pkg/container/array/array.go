@@ -0,0 +1,326 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package array provides a generic dynamic array with optional concurrent
+// safety.
+//
+// Like the cmap container, an Array performs no locking unless created in
+// safe mode, while keeping the same API in both modes.
+package array
+
+import (
+	"encoding/json"
+	"fmt"
+	"iter"
+	"math/rand/v2"
+	"slices"
+
+	"github.com/focela/aegis/internal/concurrency/lock"
+)
+
+// Array is a generic slice guarded by a read/write lock.
+type Array[T comparable] struct {
+	// mu guards data; it performs no locking in unsafe mode.
+	mu lock.RWMutex
+
+	// data holds the values.
+	data []T
+}
+
+// New creates and returns an empty Array.
+//
+// Parameters:
+//   - safe: Optional boolean enabling concurrent safety. If not provided or
+//     false, the array performs no locking.
+//
+// Returns:
+//   - A pointer to a newly created Array
+func New[T comparable](safe ...bool) *Array[T] {
+	return &Array[T]{
+		mu: lock.CreateRWMutex(safe...),
+	}
+}
+
+// NewFrom creates and returns an Array holding `data`.
+// The given slice is used directly as the underlying storage, so callers
+// should not modify it afterwards.
+func NewFrom[T comparable](data []T, safe ...bool) *Array[T] {
+	return &Array[T]{
+		mu:   lock.CreateRWMutex(safe...),
+		data: data,
+	}
+}
+
+// IsSafe reports whether the array is in concurrent-safe mode.
+func (a *Array[T]) IsSafe() bool {
+	return a.mu.IsSafe()
+}
+
+// Get returns the value at `index`.
+func (a *Array[T]) Get(index int) (value T, found bool) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	if index < 0 || index >= len(a.data) {
+		return
+	}
+	return a.data[index], true
+}
+
+// Set replaces the value at `index`. It reports false if `index` is out of range.
+func (a *Array[T]) Set(index int, value T) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if index < 0 || index >= len(a.data) {
+		return false
+	}
+	a.data[index] = value
+	return true
+}
+
+// Append adds `values` at the end.
+func (a *Array[T]) Append(values ...T) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.data = append(a.data, values...)
+}
+
+// Prepend adds `values` at the beginning, keeping their order.
+func (a *Array[T]) Prepend(values ...T) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.data = slices.Insert(a.data, 0, values...)
+}
+
+// InsertAt inserts `values` before the value at `index`; an index equal to
+// Len appends. It reports false if `index` is out of range.
+func (a *Array[T]) InsertAt(index int, values ...T) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if index < 0 || index > len(a.data) {
+		return false
+	}
+	a.data = slices.Insert(a.data, index, values...)
+	return true
+}
+
+// RemoveAt deletes and returns the value at `index`.
+func (a *Array[T]) RemoveAt(index int) (value T, found bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if index < 0 || index >= len(a.data) {
+		return
+	}
+	value = a.data[index]
+	a.data = slices.Delete(a.data, index, index+1)
+	return value, true
+}
+
+// RemoveValue deletes the first occurrence of `value` and reports whether it
+// was found.
+func (a *Array[T]) RemoveValue(value T) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	i := slices.Index(a.data, value)
+	if i < 0 {
+		return false
+	}
+	a.data = slices.Delete(a.data, i, i+1)
+	return true
+}
+
+// PopFront removes and returns the first value.
+func (a *Array[T]) PopFront() (value T, found bool) {
+	return a.RemoveAt(0)
+}
+
+// PopBack removes and returns the last value.
+func (a *Array[T]) PopBack() (value T, found bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if len(a.data) == 0 {
+		return
+	}
+	last := len(a.data) - 1
+	value = a.data[last]
+	a.data = slices.Delete(a.data, last, last+1)
+	return value, true
+}
+
+// Search returns the index of the first occurrence of `value`, or -1.
+func (a *Array[T]) Search(value T) int {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return slices.Index(a.data, value)
+}
+
+// Contains reports whether `value` is present.
+func (a *Array[T]) Contains(value T) bool {
+	return a.Search(value) >= 0
+}
+
+// Len returns the number of values.
+func (a *Array[T]) Len() int {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return len(a.data)
+}
+
+// IsEmpty reports whether the array has no values.
+func (a *Array[T]) IsEmpty() bool {
+	return a.Len() == 0
+}
+
+// Clear removes all values.
+func (a *Array[T]) Clear() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.data = nil
+}
+
+// Slice returns a copy of the values.
+func (a *Array[T]) Slice() []T {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return slices.Clone(a.data)
+}
+
+// Chunk splits the values into copies of at most `size` values each. It
+// returns nil if `size` is not positive.
+func (a *Array[T]) Chunk(size int) [][]T {
+	if size <= 0 {
+		return nil
+	}
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	chunks := make([][]T, 0, (len(a.data)+size-1)/size)
+	for chunk := range slices.Chunk(a.data, size) {
+		chunks = append(chunks, slices.Clone(chunk))
+	}
+	return chunks
+}
+
+// Unique removes duplicate values in place, keeping the first occurrence of each.
+func (a *Array[T]) Unique() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	seen := make(map[T]struct{}, len(a.data))
+	unique := a.data[:0]
+	for _, v := range a.data {
+		if _, ok := seen[v]; !ok {
+			seen[v] = struct{}{}
+			unique = append(unique, v)
+		}
+	}
+	clear(a.data[len(unique):])
+	a.data = unique
+}
+
+// Shuffle randomizes the order of the values in place.
+func (a *Array[T]) Shuffle() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	rand.Shuffle(len(a.data), func(i, j int) {
+		a.data[i], a.data[j] = a.data[j], a.data[i]
+	})
+}
+
+// Reverse reverses the order of the values in place.
+func (a *Array[T]) Reverse() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	slices.Reverse(a.data)
+}
+
+// SortFunc sorts the values in place with `cmp`, keeping equal values in
+// their original order.
+func (a *Array[T]) SortFunc(cmp func(x, y T) int) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	slices.SortStableFunc(a.data, cmp)
+}
+
+// Iterate calls `f` for each value in order until `f` returns false.
+//
+// The read lock is held for the whole iteration, so `f` must not modify the
+// array. No copy of the values is made.
+func (a *Array[T]) Iterate(f func(index int, value T) bool) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	for i, v := range a.data {
+		if !f(i, v) {
+			return
+		}
+	}
+}
+
+// IterateDesc is like Iterate, in reverse order.
+func (a *Array[T]) IterateDesc(f func(index int, value T) bool) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	for i := len(a.data) - 1; i >= 0; i-- {
+		if !f(i, a.data[i]) {
+			return
+		}
+	}
+}
+
+// All returns an iterator over the indexes and values, for use with
+// range-over-func loops.
+func (a *Array[T]) All() iter.Seq2[int, T] {
+	return a.Iterate
+}
+
+// Backward returns an iterator over the indexes and values in reverse order.
+func (a *Array[T]) Backward() iter.Seq2[int, T] {
+	return a.IterateDesc
+}
+
+// LockFunc calls `f` with the underlying slice while holding the write lock,
+// for batch modifications without copying. `f` must not retain the slice.
+func (a *Array[T]) LockFunc(f func(data []T)) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	f(a.data)
+}
+
+// RLockFunc calls `f` with the underlying slice while holding the read lock,
+// for reading without copying. `f` must neither modify nor retain the slice.
+func (a *Array[T]) RLockFunc(f func(data []T)) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	f(a.data)
+}
+
+// Clone returns a copy of the array in the same safety mode.
+func (a *Array[T]) Clone() *Array[T] {
+	return NewFrom(a.Slice(), a.mu.IsSafe())
+}
+
+// String returns the array formatted like a native Go slice.
+func (a *Array[T]) String() string {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return fmt.Sprint(a.data)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (a *Array[T]) MarshalJSON() ([]byte, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	if a.data == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(a.data)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// Decoded values are appended, and the array keeps its safety mode.
+func (a *Array[T]) UnmarshalJSON(b []byte) error {
+	var data []T
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+	a.Append(data...)
+	return nil
+}
@@ -0,0 +1,252 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package bimap provides a bidirectional map.
+//
+// A Map keeps a one-to-one relation between keys and values and indexes it in
+// both directions, so both ID-to-name and name-to-ID lookups are constant
+// time. Every key maps to exactly one value and every value to exactly one
+// key; both indices are always updated together.
+package bimap
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/focela/aegis/internal/concurrency/lock"
+)
+
+// Map is a bidirectional map guarded by a read/write lock.
+type Map[K comparable, V comparable] struct {
+	// mu guards both indices; it performs no locking in unsafe mode.
+	mu lock.RWMutex
+
+	// forward maps keys to values.
+	forward map[K]V
+
+	// inverse maps values to keys.
+	inverse map[V]K
+}
+
+// New creates and returns an empty Map.
+// The map performs no locking unless `safe` is given as true.
+func New[K comparable, V comparable](safe ...bool) *Map[K, V] {
+	return &Map[K, V]{
+		mu:      lock.CreateRWMutex(safe...),
+		forward: make(map[K]V),
+		inverse: make(map[V]K),
+	}
+}
+
+// NewFrom creates and returns a Map holding the pairs of `data`. When several
+// keys of `data` share a value, only one of them, unspecified, is kept.
+func NewFrom[K comparable, V comparable](data map[K]V, safe ...bool) *Map[K, V] {
+	m := New[K, V](safe...)
+	for k, v := range data {
+		m.set(k, v)
+	}
+	return m
+}
+
+// Set associates `key` and `value`. Any existing pair holding either of them
+// is removed first, so the relation stays one-to-one.
+func (m *Map[K, V]) Set(key K, value V) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.set(key, value)
+}
+
+// SetIfAbsent associates `key` and `value` only if neither is present yet.
+// It returns true if the pair was stored.
+func (m *Map[K, V]) SetIfAbsent(key K, value V) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.forward[key]; ok {
+		return false
+	}
+	if _, ok := m.inverse[value]; ok {
+		return false
+	}
+	m.forward[key] = value
+	m.inverse[value] = key
+	return true
+}
+
+// Get returns the value associated with `key`.
+func (m *Map[K, V]) Get(key K) (value V, found bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	value, found = m.forward[key]
+	return
+}
+
+// GetKey returns the key associated with `value`.
+func (m *Map[K, V]) GetKey(value V) (key K, found bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	key, found = m.inverse[value]
+	return
+}
+
+// Remove deletes the pair holding `key` and returns its value.
+func (m *Map[K, V]) Remove(key K) (value V, found bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if value, found = m.forward[key]; found {
+		delete(m.forward, key)
+		delete(m.inverse, value)
+	}
+	return
+}
+
+// RemoveValue deletes the pair holding `value` and returns its key.
+func (m *Map[K, V]) RemoveValue(value V) (key K, found bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if key, found = m.inverse[value]; found {
+		delete(m.inverse, value)
+		delete(m.forward, key)
+	}
+	return
+}
+
+// Contains reports whether `key` is present.
+func (m *Map[K, V]) Contains(key K) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	_, ok := m.forward[key]
+	return ok
+}
+
+// ContainsValue reports whether `value` is present.
+func (m *Map[K, V]) ContainsValue(value V) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	_, ok := m.inverse[value]
+	return ok
+}
+
+// Size returns the number of pairs.
+func (m *Map[K, V]) Size() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.forward)
+}
+
+// IsEmpty reports whether the map has no pairs.
+func (m *Map[K, V]) IsEmpty() bool {
+	return m.Size() == 0
+}
+
+// Clear removes all pairs.
+func (m *Map[K, V]) Clear() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.forward = make(map[K]V)
+	m.inverse = make(map[V]K)
+}
+
+// Keys returns the keys in unspecified order.
+func (m *Map[K, V]) Keys() []K {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	keys := make([]K, 0, len(m.forward))
+	for k := range m.forward {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// Values returns the values in unspecified order.
+func (m *Map[K, V]) Values() []V {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	values := make([]V, 0, len(m.inverse))
+	for v := range m.inverse {
+		values = append(values, v)
+	}
+	return values
+}
+
+// Range calls `f` for each pair until `f` returns false.
+//
+// The read lock is held for the whole iteration, so `f` must not modify the map.
+func (m *Map[K, V]) Range(f func(key K, value V) bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for k, v := range m.forward {
+		if !f(k, v) {
+			return
+		}
+	}
+}
+
+// Map returns a copy of the forward index as a native map.
+func (m *Map[K, V]) Map() map[K]V {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	data := make(map[K]V, len(m.forward))
+	for k, v := range m.forward {
+		data[k] = v
+	}
+	return data
+}
+
+// Inverse returns a copy of the inverse index as a native map.
+func (m *Map[K, V]) Inverse() map[V]K {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	data := make(map[V]K, len(m.inverse))
+	for v, k := range m.inverse {
+		data[v] = k
+	}
+	return data
+}
+
+// Clone returns a copy of the map in the same safety mode.
+func (m *Map[K, V]) Clone() *Map[K, V] {
+	return NewFrom(m.Map(), m.mu.IsSafe())
+}
+
+// String returns the forward index formatted like a native Go map.
+func (m *Map[K, V]) String() string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return fmt.Sprint(m.forward)
+}
+
+// MarshalJSON implements the json.Marshaler interface, encoding the forward index.
+func (m *Map[K, V]) MarshalJSON() ([]byte, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return json.Marshal(m.forward)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. Decoded pairs are
+// merged into the map, which must have been created with New or NewFrom.
+func (m *Map[K, V]) UnmarshalJSON(b []byte) error {
+	var data map[K]V
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for k, v := range data {
+		m.set(k, v)
+	}
+	return nil
+}
+
+// set stores the pair, dropping conflicting pairs. The caller must hold the
+// write lock.
+func (m *Map[K, V]) set(key K, value V) {
+	if old, ok := m.forward[key]; ok {
+		delete(m.inverse, old)
+	}
+	if old, ok := m.inverse[value]; ok {
+		delete(m.forward, old)
+	}
+	m.forward[key] = value
+	m.inverse[value] = key
+}
@@ -0,0 +1,329 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package bitset provides a growable set of non-negative integers stored as
+// bits.
+//
+// A BitSet takes one bit per possible member, which makes it a compact
+// representation for feature flags and dense ID sets. It grows as bits are
+// set and serializes to a compact binary form.
+package bitset
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"math/bits"
+	"slices"
+	"strings"
+
+	"github.com/focela/aegis/internal/concurrency/lock"
+	"github.com/focela/aegis/pkg/encoding/binary"
+)
+
+// wordSize is the number of bits per storage word.
+const wordSize = 64
+
+// ErrInvalidData is returned when decoding malformed binary data.
+var ErrInvalidData = errors.New("bitset: invalid binary data")
+
+// BitSet is a set of non-negative integers.
+// The zero value is an empty, unsafe BitSet ready to use.
+type BitSet struct {
+	// mu guards the words; it performs no locking in unsafe mode.
+	mu lock.RWMutex
+
+	// words holds the bits, bit i being bit i%64 of words[i/64].
+	words []uint64
+}
+
+// New creates and returns an empty BitSet with room for `size` bits. It grows
+// beyond that as needed.
+//
+// Parameters:
+//   - size: The number of bits to preallocate
+//   - safe: Optional boolean enabling concurrent safety
+//
+// Returns:
+//   - A pointer to a newly created BitSet
+func New(size uint, safe ...bool) *BitSet {
+	return &BitSet{
+		mu:    lock.CreateRWMutex(safe...),
+		words: make([]uint64, 0, wordsFor(size)),
+	}
+}
+
+// NewFrom creates and returns a BitSet holding `indexes`.
+func NewFrom(indexes []uint, safe ...bool) *BitSet {
+	b := New(0, safe...)
+	b.Set(indexes...)
+	return b
+}
+
+// Set adds `indexes` to the set.
+func (b *BitSet) Set(indexes ...uint) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, i := range indexes {
+		b.grow(i/wordSize + 1)
+		b.words[i/wordSize] |= 1 << (i % wordSize)
+	}
+}
+
+// Clear removes `indexes` from the set.
+func (b *BitSet) Clear(indexes ...uint) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, i := range indexes {
+		if w := i / wordSize; w < uint(len(b.words)) {
+			b.words[w] &^= 1 << (i % wordSize)
+		}
+	}
+}
+
+// Flip toggles `index` and reports whether it is set afterwards.
+func (b *BitSet) Flip(index uint) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.grow(index/wordSize + 1)
+	b.words[index/wordSize] ^= 1 << (index % wordSize)
+	return b.test(index)
+}
+
+// Test reports whether `index` is in the set.
+func (b *BitSet) Test(index uint) bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.test(index)
+}
+
+// Count returns the number of set bits.
+func (b *BitSet) Count() int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	n := 0
+	for _, w := range b.words {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+// IsEmpty reports whether no bit is set.
+func (b *BitSet) IsEmpty() bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for _, w := range b.words {
+		if w != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Reset removes all bits from the set.
+func (b *BitSet) Reset() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.words = b.words[:0]
+}
+
+// NextSet returns the smallest set index greater than or equal to `from`.
+func (b *BitSet) NextSet(from uint) (index uint, found bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.nextSet(from)
+}
+
+// Iterate calls `f` for each set index in ascending order until `f` returns false.
+//
+// The read lock is held for the whole iteration, so `f` must not modify the set.
+func (b *BitSet) Iterate(f func(index uint) bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for i, ok := b.nextSet(0); ok; i, ok = b.nextSet(i + 1) {
+		if !f(i) {
+			return
+		}
+	}
+}
+
+// All returns an iterator over the set indexes in ascending order,
+// for use with range-over-func loops.
+func (b *BitSet) All() iter.Seq[uint] {
+	return b.Iterate
+}
+
+// Slice returns the set indexes in ascending order.
+func (b *BitSet) Slice() []uint {
+	indexes := make([]uint, 0, b.Count())
+	b.Iterate(func(index uint) bool {
+		indexes = append(indexes, index)
+		return true
+	})
+	return indexes
+}
+
+// And returns a new set holding the bits set in both `b` and `other`.
+// The result has the same safety mode as `b`.
+func (b *BitSet) And(other *BitSet) *BitSet {
+	return b.combine(other, func(x, y uint64) uint64 { return x & y })
+}
+
+// Or returns a new set holding the bits set in `b` or `other`.
+// The result has the same safety mode as `b`.
+func (b *BitSet) Or(other *BitSet) *BitSet {
+	return b.combine(other, func(x, y uint64) uint64 { return x | y })
+}
+
+// Xor returns a new set holding the bits set in exactly one of `b` and `other`.
+// The result has the same safety mode as `b`.
+func (b *BitSet) Xor(other *BitSet) *BitSet {
+	return b.combine(other, func(x, y uint64) uint64 { return x ^ y })
+}
+
+// AndNot returns a new set holding the bits set in `b` but not in `other`.
+// The result has the same safety mode as `b`.
+func (b *BitSet) AndNot(other *BitSet) *BitSet {
+	return b.combine(other, func(x, y uint64) uint64 { return x &^ y })
+}
+
+// Equal reports whether `b` and `other` hold the same bits.
+func (b *BitSet) Equal(other *BitSet) bool {
+	x, y := trim(b.snapshot()), trim(other.snapshot())
+	return slices.Equal(x, y)
+}
+
+// Clone returns a copy of the set with the same safety mode.
+func (b *BitSet) Clone() *BitSet {
+	return &BitSet{
+		mu:    lock.CreateRWMutex(b.mu.IsSafe()),
+		words: b.snapshot(),
+	}
+}
+
+// String returns the set indexes in ascending order, e.g. "{1 3 5}".
+func (b *BitSet) String() string {
+	var s strings.Builder
+	s.WriteByte('{')
+	first := true
+	b.Iterate(func(index uint) bool {
+		if !first {
+			s.WriteByte(' ')
+		}
+		first = false
+		fmt.Fprint(&s, index)
+		return true
+	})
+	s.WriteByte('}')
+	return s.String()
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+//
+// The encoding is the number of 64-bit words as a varint, followed by the
+// words in little-endian order. Trailing zero words are omitted.
+func (b *BitSet) MarshalBinary() ([]byte, error) {
+	words := trim(b.snapshot())
+	data := binary.AppendUvarint(make([]byte, 0, 1+len(words)*8), uint64(len(words)))
+	for _, w := range words {
+		data = binary.AppendUint64(data, w)
+	}
+	return data, nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface,
+// replacing the content of the set.
+func (b *BitSet) UnmarshalBinary(data []byte) error {
+	n, read, err := binary.DecodeUvarint(data)
+	if err != nil {
+		return ErrInvalidData
+	}
+	data = data[read:]
+	// Compare before multiplying so that a huge count cannot overflow
+	if n > uint64(len(data))/8 || uint64(len(data)) != n*8 {
+		return ErrInvalidData
+	}
+	words := make([]uint64, n)
+	for i := range words {
+		words[i] = binary.DecodeToUint64(data[i*8:])
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.words = words
+	return nil
+}
+
+// test reports whether `index` is set. The caller must hold the lock.
+func (b *BitSet) test(index uint) bool {
+	w := index / wordSize
+	return w < uint(len(b.words)) && b.words[w]&(1<<(index%wordSize)) != 0
+}
+
+// nextSet returns the smallest set index not below `from`. The caller must
+// hold the lock.
+func (b *BitSet) nextSet(from uint) (uint, bool) {
+	w := from / wordSize
+	if w >= uint(len(b.words)) {
+		return 0, false
+	}
+	// Mask out the bits below `from` in its word
+	if word := b.words[w] >> (from % wordSize); word != 0 {
+		return from + uint(bits.TrailingZeros64(word)), true
+	}
+	for w++; w < uint(len(b.words)); w++ {
+		if b.words[w] != 0 {
+			return w*wordSize + uint(bits.TrailingZeros64(b.words[w])), true
+		}
+	}
+	return 0, false
+}
+
+// grow extends the words to at least `n`. The caller must hold the write lock.
+func (b *BitSet) grow(n uint) {
+	if n > uint(len(b.words)) {
+		b.words = append(b.words, make([]uint64, n-uint(len(b.words)))...)
+	}
+}
+
+// snapshot returns a copy of the words.
+func (b *BitSet) snapshot() []uint64 {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return slices.Clone(b.words)
+}
+
+// combine returns a new set whose words are op(b, other), word by word,
+// missing words counting as zero.
+func (b *BitSet) combine(other *BitSet, op func(x, y uint64) uint64) *BitSet {
+	x, y := b.snapshot(), other.snapshot()
+	words := make([]uint64, max(len(x), len(y)))
+	for i := range words {
+		var wx, wy uint64
+		if i < len(x) {
+			wx = x[i]
+		}
+		if i < len(y) {
+			wy = y[i]
+		}
+		words[i] = op(wx, wy)
+	}
+	return &BitSet{
+		mu:    lock.CreateRWMutex(b.mu.IsSafe()),
+		words: trim(words),
+	}
+}
+
+// trim drops the trailing zero words of `words`.
+func trim(words []uint64) []uint64 {
+	n := len(words)
+	for n > 0 && words[n-1] == 0 {
+		n--
+	}
+	return words[:n]
+}
+
+// wordsFor returns the number of words needed for `size` bits.
+func wordsFor(size uint) uint {
+	return (size + wordSize - 1) / wordSize
+}
@@ -0,0 +1,270 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package cmap provides a generic map container with optional concurrent
+// safety.
+//
+// Like the other containers, a Map performs no locking unless created in
+// safe mode. Unsafe mode suits maps that are built once and then only read,
+// or that are owned by a single goroutine, while keeping the same API as the
+// concurrent version.
+package cmap
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/focela/aegis/internal/concurrency/lock"
+)
+
+// Map is a generic hash map guarded by a read/write lock.
+// The zero value is not ready for use; create maps with New or NewFrom.
+type Map[K comparable, V any] struct {
+	// mu guards data; it performs no locking in unsafe mode.
+	mu lock.RWMutex
+
+	// data holds the entries.
+	data map[K]V
+}
+
+// New creates and returns an empty Map.
+//
+// Parameters:
+//   - safe: Optional boolean enabling concurrent safety. If not provided or
+//     false, the map performs no locking.
+//
+// Returns:
+//   - A pointer to a newly created Map
+func New[K comparable, V any](safe ...bool) *Map[K, V] {
+	return &Map[K, V]{
+		mu:   lock.CreateRWMutex(safe...),
+		data: make(map[K]V),
+	}
+}
+
+// NewFrom creates and returns a Map holding the entries of `data`.
+// The given map is used directly as the underlying storage, so callers should
+// not modify it afterwards.
+func NewFrom[K comparable, V any](data map[K]V, safe ...bool) *Map[K, V] {
+	if data == nil {
+		data = make(map[K]V)
+	}
+	return &Map[K, V]{
+		mu:   lock.CreateRWMutex(safe...),
+		data: data,
+	}
+}
+
+// IsSafe reports whether the map is in concurrent-safe mode.
+func (m *Map[K, V]) IsSafe() bool {
+	return m.mu.IsSafe()
+}
+
+// Get returns the value stored under `key` and whether it was found.
+func (m *Map[K, V]) Get(key K) (value V, found bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	value, found = m.data[key]
+	return
+}
+
+// Set stores `value` under `key`, replacing any existing value.
+func (m *Map[K, V]) Set(key K, value V) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.data == nil {
+		m.data = make(map[K]V)
+	}
+	m.data[key] = value
+}
+
+// Sets stores all entries of `data`.
+func (m *Map[K, V]) Sets(data map[K]V) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.data == nil {
+		m.data = make(map[K]V, len(data))
+	}
+	for k, v := range data {
+		m.data[k] = v
+	}
+}
+
+// GetOrSet returns the value stored under `key`. If the key is absent, it
+// stores `value` and returns it.
+func (m *Map[K, V]) GetOrSet(key K, value V) V {
+	if v, ok := m.Get(key); ok {
+		return v
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if v, ok := m.data[key]; ok {
+		return v
+	}
+	if m.data == nil {
+		m.data = make(map[K]V)
+	}
+	m.data[key] = value
+	return value
+}
+
+// GetOrSetFunc returns the value stored under `key`. If the key is absent, it
+// stores and returns the result of `f`.
+//
+// `f` is called while the write lock is held, so at most one value is ever
+// created per key; it must not access the map itself.
+func (m *Map[K, V]) GetOrSetFunc(key K, f func() V) V {
+	if v, ok := m.Get(key); ok {
+		return v
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if v, ok := m.data[key]; ok {
+		return v
+	}
+	if m.data == nil {
+		m.data = make(map[K]V)
+	}
+	value := f()
+	m.data[key] = value
+	return value
+}
+
+// SetIfAbsent stores `value` under `key` only if the key is absent.
+// It returns true if the value was stored.
+func (m *Map[K, V]) SetIfAbsent(key K, value V) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.data[key]; ok {
+		return false
+	}
+	if m.data == nil {
+		m.data = make(map[K]V)
+	}
+	m.data[key] = value
+	return true
+}
+
+// Remove deletes `key` and returns the value it held, if any.
+func (m *Map[K, V]) Remove(key K) (value V, found bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if value, found = m.data[key]; found {
+		delete(m.data, key)
+	}
+	return
+}
+
+// Removes deletes all given keys.
+func (m *Map[K, V]) Removes(keys ...K) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, key := range keys {
+		delete(m.data, key)
+	}
+}
+
+// Contains reports whether `key` is present.
+func (m *Map[K, V]) Contains(key K) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	_, ok := m.data[key]
+	return ok
+}
+
+// Size returns the number of entries.
+func (m *Map[K, V]) Size() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.data)
+}
+
+// IsEmpty reports whether the map has no entries.
+func (m *Map[K, V]) IsEmpty() bool {
+	return m.Size() == 0
+}
+
+// Clear removes all entries.
+func (m *Map[K, V]) Clear() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.data = make(map[K]V)
+}
+
+// Keys returns the keys in unspecified order.
+func (m *Map[K, V]) Keys() []K {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	keys := make([]K, 0, len(m.data))
+	for k := range m.data {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// Values returns the values in unspecified order.
+func (m *Map[K, V]) Values() []V {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	values := make([]V, 0, len(m.data))
+	for _, v := range m.data {
+		values = append(values, v)
+	}
+	return values
+}
+
+// Range calls `f` for each entry until `f` returns false.
+//
+// The read lock is held for the whole iteration, so `f` must not modify the
+// map; collect keys first, or iterate over a Clone, when modification is needed.
+func (m *Map[K, V]) Range(f func(key K, value V) bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for k, v := range m.data {
+		if !f(k, v) {
+			return
+		}
+	}
+}
+
+// Map returns a shallow copy of the underlying data as a native map.
+func (m *Map[K, V]) Map() map[K]V {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	data := make(map[K]V, len(m.data))
+	for k, v := range m.data {
+		data[k] = v
+	}
+	return data
+}
+
+// Clone returns a shallow copy of the map in the same safety mode.
+func (m *Map[K, V]) Clone() *Map[K, V] {
+	return NewFrom(m.Map(), m.mu.IsSafe())
+}
+
+// String returns the map formatted like a native Go map.
+func (m *Map[K, V]) String() string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return fmt.Sprint(m.data)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (m *Map[K, V]) MarshalJSON() ([]byte, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return json.Marshal(m.data)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// Decoded entries are merged into the map, which keeps its safety mode.
+func (m *Map[K, V]) UnmarshalJSON(b []byte) error {
+	var data map[K]V
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+	m.Sets(data)
+	return nil
+}
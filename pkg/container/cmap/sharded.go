@@ -0,0 +1,253 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package cmap
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/maphash"
+
+	"github.com/focela/aegis/pkg/encoding/hash"
+)
+
+// DefaultShards is the number of shards used when none is given.
+const DefaultShards = 32
+
+// Sharded is a concurrent map that spreads its keys across several
+// independently locked shards. It has the same API as Map and suits workloads
+// where contention on a single lock becomes the bottleneck.
+type Sharded[K comparable, V any] struct {
+	// shards holds the entries; its length is a power of two.
+	shards []*Map[K, V]
+
+	// hasher maps keys to shards.
+	hasher func(key K) uint64
+}
+
+// NewSharded creates and returns an empty Sharded map with at least `shards`
+// shards, rounded up to a power of two. Zero or less means DefaultShards.
+//
+// String and integer keys are hashed with the deterministic functions of
+// pkg/encoding/hash; other key types use a per-process seeded hash.
+func NewSharded[K comparable, V any](shards int) *Sharded[K, V] {
+	return NewShardedFunc[K, V](shards, defaultHasher[K]())
+}
+
+// NewShardedFunc is like NewSharded, but keys are distributed with `hasher`.
+func NewShardedFunc[K comparable, V any](shards int, hasher func(key K) uint64) *Sharded[K, V] {
+	if shards <= 0 {
+		shards = DefaultShards
+	}
+	n := 1
+	for n < shards {
+		n <<= 1
+	}
+	m := &Sharded[K, V]{
+		shards: make([]*Map[K, V], n),
+		hasher: hasher,
+	}
+	for i := range m.shards {
+		m.shards[i] = New[K, V](true)
+	}
+	return m
+}
+
+// defaultHasher returns the hash function used by NewSharded for keys of type K.
+func defaultHasher[K comparable]() func(key K) uint64 {
+	seed := maphash.MakeSeed()
+	return func(key K) uint64 {
+		switch k := any(key).(type) {
+		case string:
+			return hash.FNV1aString64(k)
+		case int:
+			return hash.Mix64(uint64(k))
+		case int32:
+			return hash.Mix64(uint64(k))
+		case int64:
+			return hash.Mix64(uint64(k))
+		case uint:
+			return hash.Mix64(uint64(k))
+		case uint32:
+			return hash.Mix64(uint64(k))
+		case uint64:
+			return hash.Mix64(k)
+		default:
+			return maphash.Comparable(seed, key)
+		}
+	}
+}
+
+// shard returns the shard holding `key`.
+func (m *Sharded[K, V]) shard(key K) *Map[K, V] {
+	return m.shards[m.hasher(key)&uint64(len(m.shards)-1)]
+}
+
+// IsSafe reports whether the map is in concurrent-safe mode, which is always
+// the case for a Sharded map.
+func (m *Sharded[K, V]) IsSafe() bool {
+	return true
+}
+
+// Get returns the value stored under `key` and whether it was found.
+func (m *Sharded[K, V]) Get(key K) (value V, found bool) {
+	return m.shard(key).Get(key)
+}
+
+// Set stores `value` under `key`, replacing any existing value.
+func (m *Sharded[K, V]) Set(key K, value V) {
+	m.shard(key).Set(key, value)
+}
+
+// Sets stores all entries of `data`.
+func (m *Sharded[K, V]) Sets(data map[K]V) {
+	for k, v := range data {
+		m.shard(k).Set(k, v)
+	}
+}
+
+// GetOrSet returns the value stored under `key`. If the key is absent, it
+// stores `value` and returns it.
+func (m *Sharded[K, V]) GetOrSet(key K, value V) V {
+	return m.shard(key).GetOrSet(key, value)
+}
+
+// GetOrSetFunc returns the value stored under `key`. If the key is absent, it
+// stores and returns the result of `f`.
+//
+// `f` is called while the lock of the key's shard is held, so at most one
+// value is ever created per key; it must not access the map itself.
+func (m *Sharded[K, V]) GetOrSetFunc(key K, f func() V) V {
+	return m.shard(key).GetOrSetFunc(key, f)
+}
+
+// SetIfAbsent stores `value` under `key` only if the key is absent.
+// It returns true if the value was stored.
+func (m *Sharded[K, V]) SetIfAbsent(key K, value V) bool {
+	return m.shard(key).SetIfAbsent(key, value)
+}
+
+// Remove deletes `key` and returns the value it held, if any.
+func (m *Sharded[K, V]) Remove(key K) (value V, found bool) {
+	return m.shard(key).Remove(key)
+}
+
+// Removes deletes all `keys`.
+func (m *Sharded[K, V]) Removes(keys ...K) {
+	for _, k := range keys {
+		m.shard(k).Remove(k)
+	}
+}
+
+// Contains reports whether `key` is present.
+func (m *Sharded[K, V]) Contains(key K) bool {
+	return m.shard(key).Contains(key)
+}
+
+// Size returns the number of entries. Shards are counted one after another,
+// so the result is only a snapshot under concurrent writes.
+func (m *Sharded[K, V]) Size() int {
+	size := 0
+	for _, s := range m.shards {
+		size += s.Size()
+	}
+	return size
+}
+
+// IsEmpty reports whether the map has no entries.
+func (m *Sharded[K, V]) IsEmpty() bool {
+	for _, s := range m.shards {
+		if !s.IsEmpty() {
+			return false
+		}
+	}
+	return true
+}
+
+// Clear removes all entries.
+func (m *Sharded[K, V]) Clear() {
+	for _, s := range m.shards {
+		s.Clear()
+	}
+}
+
+// Keys returns the keys in unspecified order.
+func (m *Sharded[K, V]) Keys() []K {
+	keys := make([]K, 0, m.Size())
+	for _, s := range m.shards {
+		keys = append(keys, s.Keys()...)
+	}
+	return keys
+}
+
+// Values returns the values in unspecified order.
+func (m *Sharded[K, V]) Values() []V {
+	values := make([]V, 0, m.Size())
+	for _, s := range m.shards {
+		values = append(values, s.Values()...)
+	}
+	return values
+}
+
+// Range calls `f` for each entry until `f` returns false.
+//
+// Shards are visited one at a time with their read lock held, so `f` must not
+// modify the map.
+func (m *Sharded[K, V]) Range(f func(key K, value V) bool) {
+	for _, s := range m.shards {
+		proceed := true
+		s.Range(func(key K, value V) bool {
+			proceed = f(key, value)
+			return proceed
+		})
+		if !proceed {
+			return
+		}
+	}
+}
+
+// Map returns a shallow copy of the entries as a native map.
+func (m *Sharded[K, V]) Map() map[K]V {
+	data := make(map[K]V, m.Size())
+	m.Range(func(key K, value V) bool {
+		data[key] = value
+		return true
+	})
+	return data
+}
+
+// Clone returns a shallow copy of the map with the same number of shards and
+// hash function.
+func (m *Sharded[K, V]) Clone() *Sharded[K, V] {
+	clone := &Sharded[K, V]{
+		shards: make([]*Map[K, V], len(m.shards)),
+		hasher: m.hasher,
+	}
+	for i, s := range m.shards {
+		clone.shards[i] = s.Clone()
+	}
+	return clone
+}
+
+// String returns the map formatted like a native Go map.
+func (m *Sharded[K, V]) String() string {
+	return fmt.Sprint(m.Map())
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (m *Sharded[K, V]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.Map())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// Decoded entries are merged into the map, which must have been created
+// with NewSharded or NewShardedFunc.
+func (m *Sharded[K, V]) UnmarshalJSON(b []byte) error {
+	var data map[K]V
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+	m.Sets(data)
+	return nil
+}
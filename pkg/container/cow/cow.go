@@ -0,0 +1,167 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package cow provides a copy-on-write slice for read-heavy workloads.
+//
+// Readers load an immutable snapshot without any locking, while writers copy
+// the current slice, modify the copy and publish it atomically. Writes cost a
+// full copy, so the container suits data that is read on every request but
+// rarely updated, such as routing tables and subscriber lists.
+package cow
+
+import (
+	"encoding/json"
+	"iter"
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+// Slice is a copy-on-write slice, safe for concurrent use.
+// The zero value is an empty Slice ready to use.
+type Slice[T any] struct {
+	// mu serializes writers; readers never take it.
+	mu sync.Mutex
+
+	// data points to the current snapshot, nil when empty.
+	data atomic.Pointer[[]T]
+}
+
+// New creates and returns a Slice holding a copy of `values`.
+func New[T any](values ...T) *Slice[T] {
+	s := &Slice[T]{}
+	if len(values) > 0 {
+		s.store(slices.Clone(values))
+	}
+	return s
+}
+
+// Load returns the current snapshot. It never blocks.
+//
+// The snapshot is shared with other readers and must not be modified; later
+// writes to the Slice do not affect it.
+func (s *Slice[T]) Load() []T {
+	if p := s.data.Load(); p != nil {
+		return *p
+	}
+	return nil
+}
+
+// Len returns the number of values in the current snapshot.
+func (s *Slice[T]) Len() int {
+	return len(s.Load())
+}
+
+// Get returns the value at `index` in the current snapshot.
+func (s *Slice[T]) Get(index int) (value T, found bool) {
+	data := s.Load()
+	if index < 0 || index >= len(data) {
+		return
+	}
+	return data[index], true
+}
+
+// All returns an iterator over the indexes and values of the current
+// snapshot, for use with range-over-func loops.
+func (s *Slice[T]) All() iter.Seq2[int, T] {
+	return slices.All(s.Load())
+}
+
+// Store replaces the content with a copy of `values`.
+func (s *Slice[T]) Store(values []T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.store(slices.Clone(values))
+}
+
+// Append adds `values` at the end.
+func (s *Slice[T]) Append(values ...T) {
+	s.Update(func(data []T) []T {
+		return append(data, values...)
+	})
+}
+
+// Set replaces the value at `index`. It reports false if `index` is out of range.
+func (s *Slice[T]) Set(index int, value T) (ok bool) {
+	s.Update(func(data []T) []T {
+		if ok = index >= 0 && index < len(data); ok {
+			data[index] = value
+		}
+		return data
+	})
+	return ok
+}
+
+// RemoveAt deletes the value at `index`. It reports false if `index` is out
+// of range.
+func (s *Slice[T]) RemoveAt(index int) (ok bool) {
+	s.Update(func(data []T) []T {
+		if ok = index >= 0 && index < len(data); ok {
+			data = slices.Delete(data, index, index+1)
+		}
+		return data
+	})
+	return ok
+}
+
+// RemoveFunc deletes the values for which `f` returns true and returns how
+// many were deleted.
+func (s *Slice[T]) RemoveFunc(f func(value T) bool) (removed int) {
+	s.Update(func(data []T) []T {
+		n := len(data)
+		data = slices.DeleteFunc(data, f)
+		removed = n - len(data)
+		return data
+	})
+	return removed
+}
+
+// Update publishes the result of `f`, which receives a private copy of the
+// current values that it may modify freely. Writers are serialized, so `f`
+// sees every earlier update; it must not call back into the Slice.
+func (s *Slice[T]) Update(f func(data []T) []T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.store(f(slices.Clone(s.Load())))
+}
+
+// Clear removes all values.
+func (s *Slice[T]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data.Store(nil)
+}
+
+// MarshalJSON implements the json.Marshaler interface, encoding the current
+// snapshot.
+func (s *Slice[T]) MarshalJSON() ([]byte, error) {
+	data := s.Load()
+	if data == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(data)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, replacing the content.
+func (s *Slice[T]) UnmarshalJSON(b []byte) error {
+	var data []T
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.store(data)
+	return nil
+}
+
+// store publishes `data`. The caller must hold s.mu.
+func (s *Slice[T]) store(data []T) {
+	if len(data) == 0 {
+		s.data.Store(nil)
+		return
+	}
+	// Drop spare capacity so that appending to a snapshot always copies it
+	data = slices.Clip(data)
+	s.data.Store(&data)
+}
@@ -0,0 +1,203 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package gvar provides Var, a holder for a loosely typed value.
+//
+// A Var wraps any value, typically one read from configuration or decoded
+// JSON, and converts it lazily to the type a caller needs through the lenient
+// conversions of pkg/conv.
+package gvar
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	"github.com/focela/aegis/internal/concurrency/lock"
+	"github.com/focela/aegis/internal/core/deepcopy"
+	"github.com/focela/aegis/internal/utils/empty"
+	"github.com/focela/aegis/pkg/conv"
+)
+
+// Var holds a value of any type.
+// The zero value holds nil, performs no locking and is ready to use.
+type Var struct {
+	// mu guards value; it performs no locking in unsafe mode.
+	mu lock.RWMutex
+
+	// value is the wrapped value.
+	value interface{}
+}
+
+// New creates and returns a Var holding `value`.
+//
+// Parameters:
+//   - value: The initial value
+//   - safe: Optional boolean enabling concurrent safety for Set
+//
+// Returns:
+//   - A pointer to a newly created Var
+func New(value interface{}, safe ...bool) *Var {
+	return &Var{
+		mu:    lock.CreateRWMutex(safe...),
+		value: value,
+	}
+}
+
+// Set replaces the value and returns the previous one.
+func (v *Var) Set(value interface{}) (old interface{}) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	old, v.value = v.value, value
+	return old
+}
+
+// Val returns the wrapped value.
+func (v *Var) Val() interface{} {
+	if v == nil {
+		return nil
+	}
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	return v.value
+}
+
+// Interface is an alias of Val.
+func (v *Var) Interface() interface{} {
+	return v.Val()
+}
+
+// IsNil reports whether the value is nil, including typed nil pointers.
+func (v *Var) IsNil() bool {
+	return empty.IsNil(v.Val())
+}
+
+// IsEmpty reports whether the value is empty: nil, zero, or an empty string,
+// slice or map.
+func (v *Var) IsEmpty() bool {
+	return empty.IsEmpty(v.Val())
+}
+
+// String converts the value to string.
+func (v *Var) String() string {
+	return conv.String(v.Val())
+}
+
+// Bytes converts the value to []byte.
+func (v *Var) Bytes() []byte {
+	return conv.Bytes(v.Val())
+}
+
+// Bool converts the value to bool.
+func (v *Var) Bool() bool {
+	return conv.Bool(v.Val())
+}
+
+// Int converts the value to int.
+func (v *Var) Int() int {
+	return conv.Int(v.Val())
+}
+
+// Int64 converts the value to int64.
+func (v *Var) Int64() int64 {
+	return conv.Int64(v.Val())
+}
+
+// Uint converts the value to uint.
+func (v *Var) Uint() uint {
+	return conv.Uint(v.Val())
+}
+
+// Uint64 converts the value to uint64.
+func (v *Var) Uint64() uint64 {
+	return conv.Uint64(v.Val())
+}
+
+// Float64 converts the value to float64.
+func (v *Var) Float64() float64 {
+	return conv.Float64(v.Val())
+}
+
+// Time converts the value to time.Time.
+func (v *Var) Time(opts ...conv.Option) time.Time {
+	return conv.Time(v.Val(), opts...)
+}
+
+// Duration converts the value to time.Duration.
+func (v *Var) Duration() time.Duration {
+	return conv.Duration(v.Val())
+}
+
+// Interfaces converts the value to []interface{}.
+func (v *Var) Interfaces() []interface{} {
+	return conv.Interfaces(v.Val())
+}
+
+// Strings converts the value to []string.
+func (v *Var) Strings() []string {
+	return conv.Strings(v.Val())
+}
+
+// Ints converts the value to []int.
+func (v *Var) Ints() []int {
+	return conv.Ints(v.Val())
+}
+
+// Float64s converts the value to []float64.
+func (v *Var) Float64s() []float64 {
+	return conv.Float64s(v.Val())
+}
+
+// Map converts the value to map[string]interface{}.
+func (v *Var) Map() map[string]interface{} {
+	return conv.Map(v.Val())
+}
+
+// Vars converts the value to a slice of Var, one per element.
+func (v *Var) Vars() []*Var {
+	values := v.Interfaces()
+	if values == nil {
+		return nil
+	}
+	vars := make([]*Var, len(values))
+	for i, value := range values {
+		vars[i] = New(value)
+	}
+	return vars
+}
+
+// Struct converts the value, a map, struct or JSON object, into the struct
+// pointed to by `pointer`.
+func (v *Var) Struct(pointer interface{}, opts ...conv.Option) error {
+	return conv.Struct(v.Val(), pointer, opts...)
+}
+
+// Clone returns a Var holding a deep copy of the value, in the same safety mode.
+func (v *Var) Clone() *Var {
+	return New(deepcopy.Copy(v.Val()), v.mu.IsSafe())
+}
+
+// MarshalJSON implements the json.Marshaler interface, encoding the value itself.
+func (v *Var) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.Val())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. Numbers are kept
+// as json.Number so that large integers survive the round trip.
+func (v *Var) UnmarshalJSON(b []byte) error {
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return err
+	}
+	v.Set(value)
+	return nil
+}
+
+// To converts the value of `v` to T, reporting values that cannot be
+// represented as T.
+func To[T any](v *Var, opts ...conv.Option) (T, error) {
+	return conv.To[T](v.Val(), opts...)
+}
@@ -0,0 +1,525 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package list provides a generic doubly linked list with optional
+// concurrent safety.
+//
+// The implementation follows the standard library's container/list: a sentinel
+// root element turns the list into a ring, so insertion and removal never need
+// to special-case the ends.
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"iter"
+	"strings"
+	"unsafe"
+
+	"github.com/focela/aegis/internal/concurrency/lock"
+)
+
+// Element is an element of a List.
+type Element[T any] struct {
+	// Value is the value stored in the element.
+	Value T
+
+	// next and prev link the element into its list's ring.
+	next, prev *Element[T]
+
+	// list is the list the element belongs to, nil once removed.
+	list *List[T]
+}
+
+// Next returns the next list element or nil.
+// It is not synchronized; in concurrent-safe lists prefer the iteration methods.
+func (e *Element[T]) Next() *Element[T] {
+	if p := e.next; e.list != nil && p != &e.list.root {
+		return p
+	}
+	return nil
+}
+
+// Prev returns the previous list element or nil.
+// It is not synchronized; in concurrent-safe lists prefer the iteration methods.
+func (e *Element[T]) Prev() *Element[T] {
+	if p := e.prev; e.list != nil && p != &e.list.root {
+		return p
+	}
+	return nil
+}
+
+// List is a doubly linked list.
+// The zero value is an empty list without concurrent safety, ready to use.
+type List[T any] struct {
+	// mu guards the list; it performs no locking in unsafe mode.
+	mu lock.RWMutex
+
+	// root is the sentinel element; root.next is the front, root.prev the back.
+	root Element[T]
+
+	// len is the number of elements, excluding the sentinel.
+	len int
+}
+
+// New creates and returns an empty list.
+//
+// Parameters:
+//   - safe: Optional boolean enabling concurrent safety. If not provided or
+//     false, the list performs no locking.
+//
+// Returns:
+//   - A pointer to a newly created List
+func New[T any](safe ...bool) *List[T] {
+	l := &List[T]{mu: lock.CreateRWMutex(safe...)}
+	l.init()
+	return l
+}
+
+// NewFrom creates and returns a list holding `values` in order.
+func NewFrom[T any](values []T, safe ...bool) *List[T] {
+	l := New[T](safe...)
+	for _, v := range values {
+		l.insertValue(v, l.root.prev)
+	}
+	return l
+}
+
+// init resets the list to empty.
+func (l *List[T]) init() {
+	l.root.next = &l.root
+	l.root.prev = &l.root
+	l.len = 0
+}
+
+// lazyInit initializes a zero List value.
+func (l *List[T]) lazyInit() {
+	if l.root.next == nil {
+		l.init()
+	}
+}
+
+// Len returns the number of elements.
+func (l *List[T]) Len() int {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.len
+}
+
+// Front returns the first element or nil.
+func (l *List[T]) Front() *Element[T] {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	if l.len == 0 {
+		return nil
+	}
+	return l.root.next
+}
+
+// Back returns the last element or nil.
+func (l *List[T]) Back() *Element[T] {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	if l.len == 0 {
+		return nil
+	}
+	return l.root.prev
+}
+
+// FrontValue returns the first value and whether the list was non-empty.
+func (l *List[T]) FrontValue() (value T, found bool) {
+	if e := l.Front(); e != nil {
+		return e.Value, true
+	}
+	return
+}
+
+// BackValue returns the last value and whether the list was non-empty.
+func (l *List[T]) BackValue() (value T, found bool) {
+	if e := l.Back(); e != nil {
+		return e.Value, true
+	}
+	return
+}
+
+// PushFront inserts `v` at the front and returns its element.
+func (l *List[T]) PushFront(v T) *Element[T] {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.lazyInit()
+	return l.insertValue(v, &l.root)
+}
+
+// PushBack inserts `v` at the back and returns its element.
+func (l *List[T]) PushBack(v T) *Element[T] {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.lazyInit()
+	return l.insertValue(v, l.root.prev)
+}
+
+// PushFronts inserts `values` at the front, so that the last value ends up first.
+func (l *List[T]) PushFronts(values ...T) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.lazyInit()
+	for _, v := range values {
+		l.insertValue(v, &l.root)
+	}
+}
+
+// PushBacks inserts `values` at the back in order.
+func (l *List[T]) PushBacks(values ...T) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.lazyInit()
+	for _, v := range values {
+		l.insertValue(v, l.root.prev)
+	}
+}
+
+// PopFront removes and returns the first value.
+func (l *List[T]) PopFront() (value T, found bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.len == 0 {
+		return
+	}
+	e := l.root.next
+	l.remove(e)
+	return e.Value, true
+}
+
+// PopBack removes and returns the last value.
+func (l *List[T]) PopBack() (value T, found bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.len == 0 {
+		return
+	}
+	e := l.root.prev
+	l.remove(e)
+	return e.Value, true
+}
+
+// InsertBefore inserts `v` immediately before `mark` and returns its element.
+// If `mark` is not an element of the list, the list is not modified and nil
+// is returned.
+func (l *List[T]) InsertBefore(v T, mark *Element[T]) *Element[T] {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if mark.list != l {
+		return nil
+	}
+	return l.insertValue(v, mark.prev)
+}
+
+// InsertAfter inserts `v` immediately after `mark` and returns its element.
+// If `mark` is not an element of the list, the list is not modified and nil
+// is returned.
+func (l *List[T]) InsertAfter(v T, mark *Element[T]) *Element[T] {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if mark.list != l {
+		return nil
+	}
+	return l.insertValue(v, mark)
+}
+
+// Remove removes `e` from the list if it is an element of it, and returns its value.
+func (l *List[T]) Remove(e *Element[T]) T {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if e.list == l {
+		l.remove(e)
+	}
+	return e.Value
+}
+
+// RemoveFunc removes all elements whose value satisfies `f` and returns the
+// number of removed elements.
+func (l *List[T]) RemoveFunc(f func(v T) bool) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	removed := 0
+	for e := l.root.next; e != nil && e != &l.root; {
+		next := e.next
+		if f(e.Value) {
+			l.remove(e)
+			removed++
+		}
+		e = next
+	}
+	return removed
+}
+
+// MoveToFront moves `e` to the front of the list.
+func (l *List[T]) MoveToFront(e *Element[T]) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if e.list != l || l.root.next == e {
+		return
+	}
+	l.move(e, &l.root)
+}
+
+// MoveToBack moves `e` to the back of the list.
+func (l *List[T]) MoveToBack(e *Element[T]) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if e.list != l || l.root.prev == e {
+		return
+	}
+	l.move(e, l.root.prev)
+}
+
+// MoveBefore moves `e` to its new position before `mark`.
+func (l *List[T]) MoveBefore(e, mark *Element[T]) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if e.list != l || e == mark || mark.list != l {
+		return
+	}
+	l.move(e, mark.prev)
+}
+
+// MoveAfter moves `e` to its new position after `mark`.
+func (l *List[T]) MoveAfter(e, mark *Element[T]) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if e.list != l || e == mark || mark.list != l {
+		return
+	}
+	l.move(e, mark)
+}
+
+// PushBackList inserts a copy of the values of `other` at the back of the list.
+// The lists may be the same.
+func (l *List[T]) PushBackList(other *List[T]) {
+	values := other.Values()
+	l.PushBacks(values...)
+}
+
+// PushFrontList inserts a copy of the values of `other` at the front of the
+// list, keeping their order. The lists may be the same.
+func (l *List[T]) PushFrontList(other *List[T]) {
+	values := other.Values()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.lazyInit()
+	for i := len(values) - 1; i >= 0; i-- {
+		l.insertValue(values[i], &l.root)
+	}
+}
+
+// SpliceBack moves all elements of `other` to the back of the list, leaving
+// `other` empty. Elements keep their identity, so references to them stay valid.
+func (l *List[T]) SpliceBack(other *List[T]) {
+	if l == other {
+		return
+	}
+	elements := other.detachAll()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.lazyInit()
+	for _, e := range elements {
+		l.insert(e, l.root.prev)
+	}
+}
+
+// SpliceFront moves all elements of `other` to the front of the list, keeping
+// their order and leaving `other` empty.
+func (l *List[T]) SpliceFront(other *List[T]) {
+	if l == other {
+		return
+	}
+	elements := other.detachAll()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.lazyInit()
+	at := &l.root
+	for _, e := range elements {
+		at = l.insert(e, at)
+	}
+}
+
+// SpliceAfter moves all elements of `other` immediately after `mark`, keeping
+// their order and leaving `other` empty. Nothing happens if `mark` is not an
+// element of the list.
+func (l *List[T]) SpliceAfter(mark *Element[T], other *List[T]) {
+	if l == other {
+		return
+	}
+	// Both lists are locked at once, so `mark` cannot be removed between the
+	// check and the insertion, in address order, so that splices in opposite
+	// directions cannot deadlock
+	first, second := l, other
+	if uintptr(unsafe.Pointer(first)) > uintptr(unsafe.Pointer(second)) {
+		first, second = second, first
+	}
+	first.mu.Lock()
+	defer first.mu.Unlock()
+	second.mu.Lock()
+	defer second.mu.Unlock()
+	if mark.list != l {
+		return
+	}
+	at := mark
+	for _, e := range other.detachAllLocked() {
+		at = l.insert(e, at)
+	}
+}
+
+// Clear removes all elements.
+func (l *List[T]) Clear() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for e := l.root.next; e != nil && e != &l.root; {
+		next := e.next
+		e.next, e.prev, e.list = nil, nil, nil
+		e = next
+	}
+	l.init()
+}
+
+// Values returns the values from front to back.
+func (l *List[T]) Values() []T {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	values := make([]T, 0, l.len)
+	for e := l.root.next; e != nil && e != &l.root; e = e.next {
+		values = append(values, e.Value)
+	}
+	return values
+}
+
+// IterAsc calls `f` for each value from front to back until `f` returns false.
+//
+// The read lock is held for the whole iteration, so `f` must not modify the list.
+func (l *List[T]) IterAsc(f func(v T) bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	for e := l.root.next; e != nil && e != &l.root; e = e.next {
+		if !f(e.Value) {
+			return
+		}
+	}
+}
+
+// IterDesc calls `f` for each value from back to front until `f` returns false.
+//
+// The read lock is held for the whole iteration, so `f` must not modify the list.
+func (l *List[T]) IterDesc(f func(v T) bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	for e := l.root.prev; e != nil && e != &l.root; e = e.prev {
+		if !f(e.Value) {
+			return
+		}
+	}
+}
+
+// All returns an iterator over the values from front to back,
+// for use with range-over-func loops.
+func (l *List[T]) All() iter.Seq[T] {
+	return l.IterAsc
+}
+
+// Backward returns an iterator over the values from back to front.
+func (l *List[T]) Backward() iter.Seq[T] {
+	return l.IterDesc
+}
+
+// String returns the values formatted like a Go slice.
+func (l *List[T]) String() string {
+	var b strings.Builder
+	b.WriteByte('[')
+	first := true
+	l.IterAsc(func(v T) bool {
+		if !first {
+			b.WriteByte(' ')
+		}
+		first = false
+		fmt.Fprint(&b, v)
+		return true
+	})
+	b.WriteByte(']')
+	return b.String()
+}
+
+// MarshalJSON implements the json.Marshaler interface, encoding the list as an array.
+func (l *List[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(l.Values())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, appending the items
+// of a JSON array to the list.
+func (l *List[T]) UnmarshalJSON(b []byte) error {
+	var values []T
+	if err := json.Unmarshal(b, &values); err != nil {
+		return err
+	}
+	l.PushBacks(values...)
+	return nil
+}
+
+// insert links `e` after `at` and returns `e`.
+func (l *List[T]) insert(e, at *Element[T]) *Element[T] {
+	e.prev = at
+	e.next = at.next
+	e.prev.next = e
+	e.next.prev = e
+	e.list = l
+	l.len++
+	return e
+}
+
+// insertValue wraps `v` in a new element and links it after `at`.
+func (l *List[T]) insertValue(v T, at *Element[T]) *Element[T] {
+	return l.insert(&Element[T]{Value: v}, at)
+}
+
+// remove unlinks `e` from the list.
+func (l *List[T]) remove(e *Element[T]) {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+	e.next = nil
+	e.prev = nil
+	e.list = nil
+	l.len--
+}
+
+// move relinks `e` after `at`.
+func (l *List[T]) move(e, at *Element[T]) {
+	if e == at {
+		return
+	}
+	e.prev.next = e.next
+	e.next.prev = e.prev
+
+	e.prev = at
+	e.next = at.next
+	e.prev.next = e
+	e.next.prev = e
+}
+
+// detachAll unlinks and returns all elements in order, leaving the list empty.
+func (l *List[T]) detachAll() []*Element[T] {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.detachAllLocked()
+}
+
+// detachAllLocked is detachAll for a caller holding the write lock.
+func (l *List[T]) detachAllLocked() []*Element[T] {
+	elements := make([]*Element[T], 0, l.len)
+	for e := l.root.next; e != nil && e != &l.root; {
+		next := e.next
+		e.next, e.prev, e.list = nil, nil, nil
+		elements = append(elements, e)
+		e = next
+	}
+	l.init()
+	return elements
+}
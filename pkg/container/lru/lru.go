@@ -0,0 +1,431 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package lru provides a size-bounded cache that evicts the least recently
+// used entries.
+//
+// Each entry has a cost, 1 by default, and the cache keeps the total cost
+// within its capacity. Entries may also expire after a TTL. The cache is safe
+// for concurrent use and is small enough to serve either standalone or as the
+// in-memory tier of a higher-level cache.
+//
+// The eviction order is pluggable through EvictionPolicy: besides the default
+// LRU policy, NewLFUPolicy and NewARCPolicy can be selected with WithPolicy
+// without changing any call site.
+package lru
+
+import (
+	"sync"
+	"time"
+
+	"github.com/focela/aegis/pkg/clock"
+)
+
+// EvictReason tells an eviction callback why an entry left the cache.
+type EvictReason int
+
+// Eviction reasons.
+const (
+	// EvictCapacity means the entry was evicted to stay within capacity.
+	EvictCapacity EvictReason = iota
+
+	// EvictExpired means the entry's TTL elapsed.
+	EvictExpired
+
+	// EvictRemoved means the entry was removed explicitly, or by Clear.
+	EvictRemoved
+)
+
+// String returns the name of the reason.
+func (r EvictReason) String() string {
+	switch r {
+	case EvictCapacity:
+		return "capacity"
+	case EvictExpired:
+		return "expired"
+	case EvictRemoved:
+		return "removed"
+	default:
+		return "unknown"
+	}
+}
+
+// Stats holds cache counters.
+type Stats struct {
+	// Hits is the number of lookups that found a live entry.
+	Hits uint64
+
+	// Misses is the number of lookups that found no entry or an expired one.
+	Misses uint64
+
+	// Evictions is the number of entries evicted for capacity or expiry.
+	Evictions uint64
+
+	// Len is the current number of entries.
+	Len int
+
+	// Cost is the current total cost of all entries.
+	Cost int64
+}
+
+// HitRatio returns Hits / (Hits + Misses), or 0 before any lookup.
+func (s Stats) HitRatio() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
+// entry is a cached key/value pair.
+type entry[K comparable, V any] struct {
+	key      K
+	value    V
+	cost     int64
+	expireAt time.Time
+}
+
+// expired reports whether the entry is expired at `now`.
+func (e *entry[K, V]) expired(now time.Time) bool {
+	return !e.expireAt.IsZero() && !now.Before(e.expireAt)
+}
+
+// Option configures a Cache.
+type Option[K comparable, V any] func(*Cache[K, V])
+
+// WithTTL sets the default time-to-live of entries. Zero means no expiry.
+func WithTTL[K comparable, V any](ttl time.Duration) Option[K, V] {
+	return func(c *Cache[K, V]) {
+		c.ttl = ttl
+	}
+}
+
+// WithCost sets the function computing the cost of an entry. Costs below 1
+// are treated as 1. Without it, every entry costs 1 and the capacity is a
+// maximum number of entries.
+func WithCost[K comparable, V any](f func(key K, value V) int64) Option[K, V] {
+	return func(c *Cache[K, V]) {
+		c.costFunc = f
+	}
+}
+
+// WithOnEvict sets a callback invoked after an entry leaves the cache for any
+// reason. It runs outside the cache lock and may safely call back into the cache.
+func WithOnEvict[K comparable, V any](f func(key K, value V, reason EvictReason)) Option[K, V] {
+	return func(c *Cache[K, V]) {
+		c.onEvict = f
+	}
+}
+
+// WithClock sets the clock expiry is measured on, clock.Real() by default.
+func WithClock[K comparable, V any](clk clock.Clock) Option[K, V] {
+	return func(c *Cache[K, V]) {
+		if clk != nil {
+			c.clock = clk
+		}
+	}
+}
+
+// Cache is a concurrent-safe cache bounded by the total cost of its entries.
+// Entries are evicted in the order chosen by its EvictionPolicy, LRU by default.
+type Cache[K comparable, V any] struct {
+	// mu guards all fields below.
+	mu sync.Mutex
+
+	// items indexes the entries by key.
+	items map[K]*entry[K, V]
+
+	// policy chooses the entries to evict.
+	policy EvictionPolicy[K]
+
+	// capacity is the maximum total cost.
+	capacity int64
+
+	// cost is the current total cost.
+	cost int64
+
+	// ttl is the default time-to-live, zero for none.
+	ttl time.Duration
+
+	// costFunc computes entry costs, nil for a cost of 1.
+	costFunc func(key K, value V) int64
+
+	// onEvict is called for entries leaving the cache.
+	onEvict func(key K, value V, reason EvictReason)
+
+	// clock tells the time.
+	clock clock.Clock
+
+	// hits, misses and evictions are the statistics counters.
+	hits, misses, evictions uint64
+}
+
+// evicted is an entry removed under the lock whose callback is still pending.
+type evicted[K comparable, V any] struct {
+	entry  *entry[K, V]
+	reason EvictReason
+}
+
+// New creates and returns a Cache whose entries cost at most `capacity` in
+// total. It panics if `capacity` is not positive.
+func New[K comparable, V any](capacity int64, opts ...Option[K, V]) *Cache[K, V] {
+	if capacity <= 0 {
+		panic("lru: capacity must be positive")
+	}
+	c := &Cache[K, V]{
+		items:    make(map[K]*entry[K, V]),
+		capacity: capacity,
+		clock:    clock.Real(),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.policy == nil {
+		c.policy = NewLRUPolicy[K]()
+	}
+	return c
+}
+
+// Set stores `value` under `key` with the default TTL, evicting entries as
+// needed to stay within capacity.
+//
+// An entry whose cost exceeds the capacity is not stored; it reports false.
+func (c *Cache[K, V]) Set(key K, value V) bool {
+	return c.SetWithTTL(key, value, c.ttl)
+}
+
+// SetWithTTL is like Set but with a specific time-to-live. Zero means no expiry.
+func (c *Cache[K, V]) SetWithTTL(key K, value V, ttl time.Duration) bool {
+	cost := int64(1)
+	if c.costFunc != nil {
+		if cost = c.costFunc(key, value); cost < 1 {
+			cost = 1
+		}
+	}
+
+	c.mu.Lock()
+	var pending []evicted[K, V]
+	if cost > c.capacity {
+		// Never fits; drop any previous value so the cache isn't stale
+		if e, ok := c.items[key]; ok {
+			c.removeEntry(e, false)
+			pending = append(pending, evicted[K, V]{e, EvictRemoved})
+		}
+		c.mu.Unlock()
+		c.notify(pending)
+		return false
+	}
+
+	var expireAt time.Time
+	if ttl > 0 {
+		expireAt = c.clock.Now().Add(ttl)
+	}
+	if e, ok := c.items[key]; ok {
+		c.cost += cost - e.cost
+		e.value, e.cost, e.expireAt = value, cost, expireAt
+		c.policy.Access(key)
+		pending = c.evictLocked(pending, 0)
+	} else {
+		// Room is made before the key is tracked, so that a policy such as
+		// LFU cannot pick the newcomer as its own victim
+		pending = c.evictLocked(pending, cost)
+		c.items[key] = &entry[K, V]{key: key, value: value, cost: cost, expireAt: expireAt}
+		c.cost += cost
+		c.policy.Add(key)
+	}
+	c.mu.Unlock()
+
+	c.notify(pending)
+	return true
+}
+
+// Get returns the value stored under `key` and records the access with the
+// eviction policy.
+// Expired entries are removed and reported as misses.
+func (c *Cache[K, V]) Get(key K) (value V, found bool) {
+	c.mu.Lock()
+	e, ok := c.items[key]
+	if !ok {
+		c.misses++
+		c.mu.Unlock()
+		return
+	}
+	if e.expired(c.clock.Now()) {
+		c.misses++
+		c.evictions++
+		c.removeEntry(e, false)
+		c.mu.Unlock()
+		c.notify([]evicted[K, V]{{e, EvictExpired}})
+		return
+	}
+	c.hits++
+	c.policy.Access(key)
+	value = e.value
+	c.mu.Unlock()
+	return value, true
+}
+
+// Peek returns the value stored under `key` without recording an access or
+// updating the statistics.
+func (c *Cache[K, V]) Peek(key K) (value V, found bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.items[key]; ok && !e.expired(c.clock.Now()) {
+		return e.value, true
+	}
+	return
+}
+
+// Contains reports whether a live entry exists for `key`, without recording
+// an access or updating the statistics.
+func (c *Cache[K, V]) Contains(key K) bool {
+	_, ok := c.Peek(key)
+	return ok
+}
+
+// Remove deletes `key` and reports whether it was present.
+func (c *Cache[K, V]) Remove(key K) bool {
+	c.mu.Lock()
+	e, ok := c.items[key]
+	if ok {
+		c.removeEntry(e, false)
+	}
+	c.mu.Unlock()
+	if ok {
+		c.notify([]evicted[K, V]{{e, EvictRemoved}})
+	}
+	return ok
+}
+
+// Len returns the number of entries, including expired ones not yet purged.
+func (c *Cache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.items)
+}
+
+// Cost returns the total cost of all entries.
+func (c *Cache[K, V]) Cost() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cost
+}
+
+// Capacity returns the maximum total cost.
+func (c *Cache[K, V]) Capacity() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.capacity
+}
+
+// Resize changes the capacity, evicting entries as needed, and returns the
+// number of evicted entries. It panics if `capacity` is not positive.
+func (c *Cache[K, V]) Resize(capacity int64) int {
+	if capacity <= 0 {
+		panic("lru: capacity must be positive")
+	}
+	c.mu.Lock()
+	c.capacity = capacity
+	pending := c.evictLocked(nil, 0)
+	c.mu.Unlock()
+	c.notify(pending)
+	return len(pending)
+}
+
+// Keys returns the keys in retention order: the next entry to be evicted is
+// last. For the default policy this is from most to least recently used.
+func (c *Cache[K, V]) Keys() []K {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.policy.Keys()
+}
+
+// PurgeExpired removes all expired entries and returns how many were removed.
+func (c *Cache[K, V]) PurgeExpired() int {
+	c.mu.Lock()
+	now := c.clock.Now()
+	var pending []evicted[K, V]
+	for _, e := range c.items {
+		if e.expired(now) {
+			c.removeEntry(e, false)
+			c.evictions++
+			pending = append(pending, evicted[K, V]{e, EvictExpired})
+		}
+	}
+	c.mu.Unlock()
+	c.notify(pending)
+	return len(pending)
+}
+
+// Clear removes all entries. The eviction callback sees them as removed.
+func (c *Cache[K, V]) Clear() {
+	c.mu.Lock()
+	var pending []evicted[K, V]
+	if c.onEvict != nil {
+		pending = make([]evicted[K, V], 0, len(c.items))
+		for _, e := range c.items {
+			pending = append(pending, evicted[K, V]{e, EvictRemoved})
+		}
+	}
+	c.items = make(map[K]*entry[K, V])
+	c.policy.Clear()
+	c.cost = 0
+	c.mu.Unlock()
+	c.notify(pending)
+}
+
+// Stats returns a snapshot of the cache counters.
+func (c *Cache[K, V]) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return Stats{
+		Hits:      c.hits,
+		Misses:    c.misses,
+		Evictions: c.evictions,
+		Len:       len(c.items),
+		Cost:      c.cost,
+	}
+}
+
+// ResetStats zeroes the hit, miss and eviction counters.
+func (c *Cache[K, V]) ResetStats() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.hits, c.misses, c.evictions = 0, 0, 0
+}
+
+// evictLocked evicts the policy's victims until the total cost, plus the
+// `extra` cost about to be added, fits the capacity, appending them to
+// `pending`. The caller must hold c.mu.
+func (c *Cache[K, V]) evictLocked(pending []evicted[K, V], extra int64) []evicted[K, V] {
+	for c.cost+extra > c.capacity {
+		key, ok := c.policy.Victim()
+		if !ok {
+			break
+		}
+		e := c.items[key]
+		c.removeEntry(e, true)
+		c.evictions++
+		pending = append(pending, evicted[K, V]{e, EvictCapacity})
+	}
+	return pending
+}
+
+// removeEntry unlinks `e` from the cache; `evicted` tells the policy whether
+// it chose the entry as a victim. The caller must hold c.mu.
+func (c *Cache[K, V]) removeEntry(e *entry[K, V], evicted bool) {
+	c.policy.Remove(e.key, evicted)
+	delete(c.items, e.key)
+	c.cost -= e.cost
+}
+
+// notify invokes the eviction callback for `pending`, outside the lock.
+func (c *Cache[K, V]) notify(pending []evicted[K, V]) {
+	if c.onEvict == nil {
+		return
+	}
+	for _, p := range pending {
+		c.onEvict(p.entry.key, p.entry.value, p.reason)
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package lru
+
+import (
+	"github.com/focela/aegis/pkg/container/list"
+)
+
+// EvictionPolicy decides which entry a Cache evicts when it is over capacity.
+//
+// The cache reports every insertion, hit and removal to the policy and asks it
+// for a victim when room is needed. Methods are called with the cache lock
+// held, so implementations need no synchronization of their own, but an
+// instance must not be shared between caches.
+type EvictionPolicy[K comparable] interface {
+	// Add records that `key` was inserted.
+	Add(key K)
+
+	// Access records a hit on, or an update of, the existing `key`.
+	Access(key K)
+
+	// Remove forgets `key`. `evicted` is true when the key is removed because
+	// the policy chose it as a victim, which adaptive policies learn from.
+	Remove(key K, evicted bool)
+
+	// Victim returns the key to evict next, without forgetting it.
+	Victim() (key K, found bool)
+
+	// Keys returns the tracked keys, from the most valuable to the next victim.
+	Keys() []K
+
+	// Clear forgets all keys.
+	Clear()
+}
+
+// WithPolicy sets the eviction policy of the cache. The default is NewLRUPolicy.
+func WithPolicy[K comparable, V any](policy EvictionPolicy[K]) Option[K, V] {
+	return func(c *Cache[K, V]) {
+		c.policy = policy
+	}
+}
+
+// lruPolicy evicts the least recently used key.
+type lruPolicy[K comparable] struct {
+	// order holds the keys from most to least recently used.
+	order *list.List[K]
+
+	// elements indexes the list elements by key.
+	elements map[K]*list.Element[K]
+}
+
+// NewLRUPolicy returns a policy evicting the least recently used key.
+func NewLRUPolicy[K comparable]() EvictionPolicy[K] {
+	return &lruPolicy[K]{
+		order:    list.New[K](),
+		elements: make(map[K]*list.Element[K]),
+	}
+}
+
+// Add implements EvictionPolicy.
+func (p *lruPolicy[K]) Add(key K) {
+	if e, ok := p.elements[key]; ok {
+		p.order.MoveToFront(e)
+		return
+	}
+	p.elements[key] = p.order.PushFront(key)
+}
+
+// Access implements EvictionPolicy.
+func (p *lruPolicy[K]) Access(key K) {
+	if e, ok := p.elements[key]; ok {
+		p.order.MoveToFront(e)
+	}
+}
+
+// Remove implements EvictionPolicy.
+func (p *lruPolicy[K]) Remove(key K, _ bool) {
+	if e, ok := p.elements[key]; ok {
+		p.order.Remove(e)
+		delete(p.elements, key)
+	}
+}
+
+// Victim implements EvictionPolicy.
+func (p *lruPolicy[K]) Victim() (key K, found bool) {
+	return p.order.BackValue()
+}
+
+// Keys implements EvictionPolicy.
+func (p *lruPolicy[K]) Keys() []K {
+	return p.order.Values()
+}
+
+// Clear implements EvictionPolicy.
+func (p *lruPolicy[K]) Clear() {
+	p.order.Clear()
+	p.elements = make(map[K]*list.Element[K])
+}
@@ -0,0 +1,167 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package lru
+
+import (
+	"github.com/focela/aegis/pkg/container/list"
+)
+
+// arcList is an LRU-ordered list of keys with an index for constant-time removal.
+type arcList[K comparable] struct {
+	order    *list.List[K]
+	elements map[K]*list.Element[K]
+}
+
+// newARCList returns an empty arcList.
+func newARCList[K comparable]() *arcList[K] {
+	return &arcList[K]{
+		order:    list.New[K](),
+		elements: make(map[K]*list.Element[K]),
+	}
+}
+
+// pushFront inserts `key` as the most recently used.
+func (l *arcList[K]) pushFront(key K) {
+	l.elements[key] = l.order.PushFront(key)
+}
+
+// remove deletes `key` and reports whether it was present.
+func (l *arcList[K]) remove(key K) bool {
+	e, ok := l.elements[key]
+	if ok {
+		l.order.Remove(e)
+		delete(l.elements, key)
+	}
+	return ok
+}
+
+// contains reports whether `key` is present.
+func (l *arcList[K]) contains(key K) bool {
+	_, ok := l.elements[key]
+	return ok
+}
+
+// removeBack deletes the least recently used key.
+func (l *arcList[K]) removeBack() {
+	if key, ok := l.order.PopBack(); ok {
+		delete(l.elements, key)
+	}
+}
+
+// len returns the number of keys.
+func (l *arcList[K]) len() int {
+	return len(l.elements)
+}
+
+// clear removes all keys.
+func (l *arcList[K]) clear() {
+	l.order.Clear()
+	l.elements = make(map[K]*list.Element[K])
+}
+
+// arcPolicy implements Adaptive Replacement Cache (Megiddo & Modha).
+//
+// Live keys are split between t1, holding keys seen once recently, and t2,
+// holding keys seen at least twice. The ghost lists b1 and b2 remember keys
+// recently evicted from t1 and t2. A hit in a ghost list shifts the target
+// size `p` of t1, so the policy adapts between favoring recency and frequency.
+type arcPolicy[K comparable] struct {
+	t1, t2, b1, b2 *arcList[K]
+
+	// size is the expected number of live entries, bounding the ghost lists.
+	size int
+
+	// p is the target size of t1.
+	p int
+}
+
+// NewARCPolicy returns an adaptive policy balancing recency and frequency.
+// `size` is the expected number of entries held by the cache, which bounds the
+// history of evicted keys the policy learns from; for a cache without a cost
+// function, pass its capacity.
+func NewARCPolicy[K comparable](size int) EvictionPolicy[K] {
+	if size < 1 {
+		size = 1
+	}
+	return &arcPolicy[K]{
+		t1:   newARCList[K](),
+		t2:   newARCList[K](),
+		b1:   newARCList[K](),
+		b2:   newARCList[K](),
+		size: size,
+	}
+}
+
+// Add implements EvictionPolicy.
+func (p *arcPolicy[K]) Add(key K) {
+	switch {
+	case p.t1.contains(key) || p.t2.contains(key):
+		p.Access(key)
+
+	case p.b1.remove(key):
+		// Recently evicted from t1: favor recency
+		p.p = min(p.size, p.p+max(p.b2.len()/max(p.b1.len(), 1), 1))
+		p.t2.pushFront(key)
+
+	case p.b2.remove(key):
+		// Recently evicted from t2: favor frequency
+		p.p = max(0, p.p-max(p.b1.len()/max(p.b2.len(), 1), 1))
+		p.t2.pushFront(key)
+
+	default:
+		p.t1.pushFront(key)
+	}
+}
+
+// Access implements EvictionPolicy.
+func (p *arcPolicy[K]) Access(key K) {
+	if p.t1.remove(key) || p.t2.remove(key) {
+		p.t2.pushFront(key)
+	}
+}
+
+// Remove implements EvictionPolicy.
+func (p *arcPolicy[K]) Remove(key K, evicted bool) {
+	switch {
+	case p.t1.remove(key):
+		if evicted {
+			p.b1.pushFront(key)
+		}
+	case p.t2.remove(key):
+		if evicted {
+			p.b2.pushFront(key)
+		}
+	}
+
+	// Bound the ghost history
+	for p.b1.len() > p.size {
+		p.b1.removeBack()
+	}
+	for p.b2.len() > p.size {
+		p.b2.removeBack()
+	}
+}
+
+// Victim implements EvictionPolicy.
+func (p *arcPolicy[K]) Victim() (key K, found bool) {
+	if p.t1.len() > 0 && (p.t1.len() > p.p || p.t2.len() == 0) {
+		return p.t1.order.BackValue()
+	}
+	return p.t2.order.BackValue()
+}
+
+// Keys implements EvictionPolicy.
+func (p *arcPolicy[K]) Keys() []K {
+	return append(p.t2.order.Values(), p.t1.order.Values()...)
+}
+
+// Clear implements EvictionPolicy.
+func (p *arcPolicy[K]) Clear() {
+	p.t1.clear()
+	p.t2.clear()
+	p.b1.clear()
+	p.b2.clear()
+	p.p = 0
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package lru
+
+import (
+	"sort"
+
+	"github.com/focela/aegis/pkg/container/list"
+)
+
+// lfuItem tracks the access frequency of a key.
+type lfuItem[K comparable] struct {
+	freq    int
+	element *list.Element[K]
+}
+
+// lfuPolicy evicts the least frequently used key, breaking ties by recency.
+//
+// Keys are grouped into one list per frequency, so Add, Access and Victim run
+// in constant time.
+type lfuPolicy[K comparable] struct {
+	// items indexes the tracked keys.
+	items map[K]*lfuItem[K]
+
+	// buckets holds, per frequency, the keys from most to least recently used.
+	buckets map[int]*list.List[K]
+
+	// minFreq is the lowest frequency with a non-empty bucket.
+	minFreq int
+}
+
+// NewLFUPolicy returns a policy evicting the least frequently used key.
+// Among keys with the same frequency, the least recently used goes first.
+func NewLFUPolicy[K comparable]() EvictionPolicy[K] {
+	return &lfuPolicy[K]{
+		items:   make(map[K]*lfuItem[K]),
+		buckets: make(map[int]*list.List[K]),
+	}
+}
+
+// Add implements EvictionPolicy.
+func (p *lfuPolicy[K]) Add(key K) {
+	if _, ok := p.items[key]; ok {
+		p.Access(key)
+		return
+	}
+	p.items[key] = &lfuItem[K]{freq: 1, element: p.bucket(1).PushFront(key)}
+	p.minFreq = 1
+}
+
+// Access implements EvictionPolicy.
+func (p *lfuPolicy[K]) Access(key K) {
+	item, ok := p.items[key]
+	if !ok {
+		return
+	}
+	p.unlink(item)
+	item.freq++
+	item.element = p.bucket(item.freq).PushFront(key)
+	if p.minFreq == item.freq-1 && p.buckets[p.minFreq] == nil {
+		p.minFreq = item.freq
+	}
+}
+
+// Remove implements EvictionPolicy.
+func (p *lfuPolicy[K]) Remove(key K, _ bool) {
+	item, ok := p.items[key]
+	if !ok {
+		return
+	}
+	p.unlink(item)
+	delete(p.items, key)
+	if p.buckets[p.minFreq] == nil {
+		p.recomputeMinFreq()
+	}
+}
+
+// Victim implements EvictionPolicy.
+func (p *lfuPolicy[K]) Victim() (key K, found bool) {
+	if b := p.buckets[p.minFreq]; b != nil {
+		return b.BackValue()
+	}
+	return
+}
+
+// Keys implements EvictionPolicy.
+func (p *lfuPolicy[K]) Keys() []K {
+	freqs := make([]int, 0, len(p.buckets))
+	for freq := range p.buckets {
+		freqs = append(freqs, freq)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(freqs)))
+	keys := make([]K, 0, len(p.items))
+	for _, freq := range freqs {
+		keys = append(keys, p.buckets[freq].Values()...)
+	}
+	return keys
+}
+
+// Clear implements EvictionPolicy.
+func (p *lfuPolicy[K]) Clear() {
+	p.items = make(map[K]*lfuItem[K])
+	p.buckets = make(map[int]*list.List[K])
+	p.minFreq = 0
+}
+
+// bucket returns the list for `freq`, creating it if needed.
+func (p *lfuPolicy[K]) bucket(freq int) *list.List[K] {
+	b, ok := p.buckets[freq]
+	if !ok {
+		b = list.New[K]()
+		p.buckets[freq] = b
+	}
+	return b
+}
+
+// unlink removes `item` from its bucket, dropping the bucket once empty.
+func (p *lfuPolicy[K]) unlink(item *lfuItem[K]) {
+	b := p.buckets[item.freq]
+	b.Remove(item.element)
+	if b.Len() == 0 {
+		delete(p.buckets, item.freq)
+	}
+}
+
+// recomputeMinFreq finds the lowest frequency after an arbitrary removal.
+func (p *lfuPolicy[K]) recomputeMinFreq() {
+	p.minFreq = 0
+	for freq := range p.buckets {
+		if p.minFreq == 0 || freq < p.minFreq {
+			p.minFreq = freq
+		}
+	}
+}
@@ -0,0 +1,286 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package multimap provides a map associating each key with multiple values.
+//
+// Keys are iterated in the order they were first added and the values of a
+// key in the order they were appended, so output built from a Map, such as
+// headers or query strings, is deterministic.
+package multimap
+
+import (
+	"encoding/json"
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+
+	"github.com/focela/aegis/internal/concurrency/lock"
+	"github.com/focela/aegis/pkg/container/list"
+)
+
+// entry holds the values of a key.
+type entry[K comparable, V comparable] struct {
+	// values holds the values in insertion order; it is never empty.
+	values []V
+
+	// element is the key's position in the key order.
+	element *list.Element[K]
+}
+
+// Map is a multimap guarded by a read/write lock.
+type Map[K comparable, V comparable] struct {
+	// mu guards the map; it performs no locking in unsafe mode.
+	mu lock.RWMutex
+
+	// data indexes the entries by key.
+	data map[K]*entry[K, V]
+
+	// order holds the keys in insertion order.
+	order *list.List[K]
+
+	// count is the total number of values.
+	count int
+}
+
+// New creates and returns an empty Map.
+// The map performs no locking unless `safe` is given as true.
+func New[K comparable, V comparable](safe ...bool) *Map[K, V] {
+	return &Map[K, V]{
+		mu:    lock.CreateRWMutex(safe...),
+		data:  make(map[K]*entry[K, V]),
+		order: list.New[K](),
+	}
+}
+
+// Append adds `values` to those of `key`.
+func (m *Map[K, V]) Append(key K, values ...V) {
+	if len(values) == 0 {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.append(key, values)
+}
+
+// Set replaces the values of `key` with `values`. Without values the key is
+// removed. A key that already exists keeps its position in the key order.
+func (m *Map[K, V]) Set(key K, values ...V) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if e, ok := m.data[key]; ok {
+		if len(values) == 0 {
+			m.remove(key, e)
+			return
+		}
+		m.count += len(values) - len(e.values)
+		e.values = slices.Clone(values)
+		return
+	}
+	if len(values) > 0 {
+		m.append(key, values)
+	}
+}
+
+// Get returns the first value of `key`.
+func (m *Map[K, V]) Get(key K) (value V, found bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if e, ok := m.data[key]; ok {
+		return e.values[0], true
+	}
+	return
+}
+
+// GetAll returns a copy of the values of `key`, or nil if it is absent.
+func (m *Map[K, V]) GetAll(key K) []V {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if e, ok := m.data[key]; ok {
+		return slices.Clone(e.values)
+	}
+	return nil
+}
+
+// Remove deletes `key` and returns its values.
+func (m *Map[K, V]) Remove(key K) []V {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e, ok := m.data[key]
+	if !ok {
+		return nil
+	}
+	m.remove(key, e)
+	return e.values
+}
+
+// RemoveValue deletes the first occurrence of `value` from the values of
+// `key` and reports whether it was found. The key is removed with its last value.
+func (m *Map[K, V]) RemoveValue(key K, value V) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e, ok := m.data[key]
+	if !ok {
+		return false
+	}
+	i := slices.Index(e.values, value)
+	if i < 0 {
+		return false
+	}
+	if len(e.values) == 1 {
+		m.remove(key, e)
+		return true
+	}
+	e.values = slices.Delete(e.values, i, i+1)
+	m.count--
+	return true
+}
+
+// Contains reports whether `key` has any value.
+func (m *Map[K, V]) Contains(key K) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	_, ok := m.data[key]
+	return ok
+}
+
+// ContainsValue reports whether `value` is among the values of `key`.
+func (m *Map[K, V]) ContainsValue(key K, value V) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	e, ok := m.data[key]
+	return ok && slices.Contains(e.values, value)
+}
+
+// Len returns the number of keys.
+func (m *Map[K, V]) Len() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.data)
+}
+
+// Count returns the total number of values across all keys.
+func (m *Map[K, V]) Count() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.count
+}
+
+// IsEmpty reports whether the map has no keys.
+func (m *Map[K, V]) IsEmpty() bool {
+	return m.Len() == 0
+}
+
+// Clear removes all keys.
+func (m *Map[K, V]) Clear() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.data = make(map[K]*entry[K, V])
+	m.order.Clear()
+	m.count = 0
+}
+
+// Keys returns the keys in insertion order.
+func (m *Map[K, V]) Keys() []K {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.order.Values()
+}
+
+// Range calls `f` for each key and its values, in insertion order, until `f`
+// returns false. The values slice must not be modified or retained.
+//
+// The read lock is held for the whole iteration, so `f` must not modify the map.
+func (m *Map[K, V]) Range(f func(key K, values []V) bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	m.order.IterAsc(func(key K) bool {
+		return f(key, m.data[key].values)
+	})
+}
+
+// All returns an iterator over every key/value pair, in insertion order,
+// for use with range-over-func loops.
+func (m *Map[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.Range(func(key K, values []V) bool {
+			for _, v := range values {
+				if !yield(key, v) {
+					return false
+				}
+			}
+			return true
+		})
+	}
+}
+
+// Map returns a copy of the content as a native map.
+func (m *Map[K, V]) Map() map[K][]V {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	data := make(map[K][]V, len(m.data))
+	for k, e := range m.data {
+		data[k] = slices.Clone(e.values)
+	}
+	return data
+}
+
+// String returns the content in insertion order, formatted like a Go map of
+// slices.
+func (m *Map[K, V]) String() string {
+	var b strings.Builder
+	b.WriteString("map[")
+	first := true
+	m.Range(func(key K, values []V) bool {
+		if !first {
+			b.WriteByte(' ')
+		}
+		first = false
+		fmt.Fprintf(&b, "%v:%v", key, values)
+		return true
+	})
+	b.WriteByte(']')
+	return b.String()
+}
+
+// MarshalJSON implements the json.Marshaler interface, encoding the map as an
+// object of arrays.
+func (m *Map[K, V]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.Map())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, appending the
+// decoded values. The map must have been created with New.
+func (m *Map[K, V]) UnmarshalJSON(b []byte) error {
+	var data map[K][]V
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for k, values := range data {
+		if len(values) > 0 {
+			m.append(k, values)
+		}
+	}
+	return nil
+}
+
+// append adds non-empty `values` to `key`. The caller must hold the write lock.
+func (m *Map[K, V]) append(key K, values []V) {
+	e, ok := m.data[key]
+	if !ok {
+		e = &entry[K, V]{element: m.order.PushBack(key)}
+		m.data[key] = e
+	}
+	e.values = append(e.values, values...)
+	m.count += len(values)
+}
+
+// remove deletes `key` and its entry `e`. The caller must hold the write lock.
+func (m *Map[K, V]) remove(key K, e *entry[K, V]) {
+	m.order.Remove(e.element)
+	delete(m.data, key)
+	m.count -= len(e.values)
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package pool provides a typed object pool for reusing buffers, decoders and
+// other scratch values.
+//
+// By default a Pool is a thin typed layer over sync.Pool, whose idle objects
+// may be dropped at any garbage collection. A bounded Pool instead retains up
+// to a fixed number of idle objects and discards the surplus, which gives
+// predictable memory use for expensive objects.
+package pool
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Stats holds pool counters.
+type Stats struct {
+	// Gets is the number of objects handed out.
+	Gets uint64
+
+	// Puts is the number of objects returned.
+	Puts uint64
+
+	// News is the number of objects created because none was idle.
+	News uint64
+
+	// Drops is the number of returned objects discarded because the pool was
+	// full.
+	Drops uint64
+}
+
+// Option configures a Pool.
+type Option[T any] func(*Pool[T])
+
+// WithReset sets a callback that clears an object when it is returned, so
+// that the next Get receives it in a clean state.
+func WithReset[T any](reset func(T)) Option[T] {
+	return func(p *Pool[T]) {
+		p.reset = reset
+	}
+}
+
+// WithMaxIdle bounds the pool to `n` idle objects. Idle objects are then kept
+// across garbage collections, and objects returned to a full pool are dropped.
+func WithMaxIdle[T any](n int) Option[T] {
+	return func(p *Pool[T]) {
+		if n > 0 {
+			p.idle = make(chan T, n)
+		}
+	}
+}
+
+// Pool is a set of reusable objects of type T, safe for concurrent use.
+type Pool[T any] struct {
+	// newFunc creates an object when none is idle.
+	newFunc func() T
+
+	// reset clears an object on return, nil for none.
+	reset func(T)
+
+	// pool stores the idle objects of an unbounded pool.
+	pool sync.Pool
+
+	// idle stores the idle objects of a bounded pool, nil when unbounded.
+	idle chan T
+
+	// gets, puts, news and drops are the statistics counters.
+	gets, puts, news, drops atomic.Uint64
+}
+
+// New creates and returns a Pool creating objects with `newFunc`.
+// It panics if `newFunc` is nil.
+func New[T any](newFunc func() T, opts ...Option[T]) *Pool[T] {
+	if newFunc == nil {
+		panic("pool: newFunc must not be nil")
+	}
+	p := &Pool[T]{newFunc: newFunc}
+	for _, opt := range opts {
+		opt(p)
+	}
+	p.pool.New = func() interface{} {
+		return p.create()
+	}
+	return p
+}
+
+// Get returns an idle object, or a new one if none is idle.
+func (p *Pool[T]) Get() T {
+	p.gets.Add(1)
+	if p.idle == nil {
+		return p.pool.Get().(T)
+	}
+	select {
+	case v := <-p.idle:
+		return v
+	default:
+		return p.create()
+	}
+}
+
+// Put resets `v` and returns it to the pool. The caller must not use `v`
+// afterwards.
+func (p *Pool[T]) Put(v T) {
+	p.puts.Add(1)
+	if p.reset != nil {
+		p.reset(v)
+	}
+	if p.idle == nil {
+		p.pool.Put(v)
+		return
+	}
+	select {
+	case p.idle <- v:
+	default:
+		p.drops.Add(1)
+	}
+}
+
+// Idle returns the number of idle objects of a bounded pool. It is always
+// zero for an unbounded pool, whose idle objects are not observable.
+func (p *Pool[T]) Idle() int {
+	return len(p.idle)
+}
+
+// Stats returns a snapshot of the pool counters.
+func (p *Pool[T]) Stats() Stats {
+	return Stats{
+		Gets:  p.gets.Load(),
+		Puts:  p.puts.Load(),
+		News:  p.news.Load(),
+		Drops: p.drops.Load(),
+	}
+}
+
+// create makes a new object and counts it.
+func (p *Pool[T]) create() T {
+	p.news.Add(1)
+	return p.newFunc()
+}
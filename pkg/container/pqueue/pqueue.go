@@ -0,0 +1,244 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package pqueue provides a generic priority queue backed by a binary heap.
+//
+// The order of values is defined by a user-supplied less function. Push returns
+// an Item handle that can later be used to change the value's priority or to
+// remove it, which is what schedulers and timer managers need.
+package pqueue
+
+import (
+	"github.com/focela/aegis/internal/concurrency/lock"
+)
+
+// Less reports whether `a` must be popped before `b`.
+type Less[T any] func(a, b T) bool
+
+// Item is a handle to a value in a Queue.
+type Item[T any] struct {
+	// value is the queued value.
+	value T
+
+	// index is the position in the heap, or -1 once the item left the queue.
+	index int
+
+	// seq is the insertion sequence number used for stable ordering.
+	seq uint64
+}
+
+// Value returns the value held by the item.
+func (it *Item[T]) Value() T {
+	return it.value
+}
+
+// Queue is a priority queue. The value for which `less` holds against all
+// others is at the front.
+type Queue[T any] struct {
+	// mu guards the queue; it performs no locking in unsafe mode.
+	mu lock.RWMutex
+
+	// items is the binary heap.
+	items []*Item[T]
+
+	// less orders the values.
+	less Less[T]
+
+	// stable makes values of equal priority pop in insertion order.
+	stable bool
+
+	// seq is the next insertion sequence number.
+	seq uint64
+}
+
+// New creates and returns an empty Queue ordered by `less`. Values of equal
+// priority pop in unspecified order.
+// The queue performs no locking unless `safe` is given as true.
+func New[T any](less Less[T], safe ...bool) *Queue[T] {
+	return &Queue[T]{
+		mu:   lock.CreateRWMutex(safe...),
+		less: less,
+	}
+}
+
+// NewStable is like New, but values of equal priority pop in the order they
+// were pushed, at the cost of a sequence number per item.
+func NewStable[T any](less Less[T], safe ...bool) *Queue[T] {
+	q := New(less, safe...)
+	q.stable = true
+	return q
+}
+
+// Push adds `value` to the queue and returns its handle.
+func (q *Queue[T]) Push(value T) *Item[T] {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	item := &Item[T]{value: value, index: len(q.items), seq: q.seq}
+	q.seq++
+	q.items = append(q.items, item)
+	q.up(item.index)
+	return item
+}
+
+// Pop removes and returns the value at the front.
+func (q *Queue[T]) Pop() (value T, found bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if len(q.items) == 0 {
+		return
+	}
+	return q.removeAt(0).value, true
+}
+
+// Peek returns the value at the front without removing it.
+func (q *Queue[T]) Peek() (value T, found bool) {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	if len(q.items) == 0 {
+		return
+	}
+	return q.items[0].value, true
+}
+
+// UpdatePriority replaces the value of `item` with `value` and moves it to
+// its new position. It reports false if the item is no longer queued.
+func (q *Queue[T]) UpdatePriority(item *Item[T], value T) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if !q.owns(item) {
+		return false
+	}
+	item.value = value
+	q.fix(item.index)
+	return true
+}
+
+// Remove removes `item` from the queue. It reports false if the item is no
+// longer queued.
+func (q *Queue[T]) Remove(item *Item[T]) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if !q.owns(item) {
+		return false
+	}
+	q.removeAt(item.index)
+	return true
+}
+
+// Contains reports whether `item` is still queued.
+func (q *Queue[T]) Contains(item *Item[T]) bool {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	return q.owns(item)
+}
+
+// Len returns the number of queued values.
+func (q *Queue[T]) Len() int {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	return len(q.items)
+}
+
+// Clear removes all values. Existing handles become invalid.
+func (q *Queue[T]) Clear() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for _, item := range q.items {
+		item.index = -1
+	}
+	q.items = nil
+}
+
+// Values returns the queued values in heap order, which is not sorted.
+func (q *Queue[T]) Values() []T {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	values := make([]T, len(q.items))
+	for i, item := range q.items {
+		values[i] = item.value
+	}
+	return values
+}
+
+// owns reports whether `item` is queued in q.
+func (q *Queue[T]) owns(item *Item[T]) bool {
+	return item != nil && item.index >= 0 && item.index < len(q.items) && q.items[item.index] == item
+}
+
+// removeAt removes and returns the item at heap position `i`.
+func (q *Queue[T]) removeAt(i int) *Item[T] {
+	last := len(q.items) - 1
+	if i != last {
+		q.swap(i, last)
+	}
+	item := q.items[last]
+	q.items[last] = nil
+	q.items = q.items[:last]
+	if i != last {
+		q.fix(i)
+	}
+	item.index = -1
+	return item
+}
+
+// fix restores the heap order after the item at position `i` changed.
+func (q *Queue[T]) fix(i int) {
+	if !q.down(i) {
+		q.up(i)
+	}
+}
+
+// up moves the item at position `i` towards the root.
+func (q *Queue[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !q.before(i, parent) {
+			return
+		}
+		q.swap(i, parent)
+		i = parent
+	}
+}
+
+// down moves the item at position `i` towards the leaves and reports whether
+// it moved.
+func (q *Queue[T]) down(i int) bool {
+	start := i
+	n := len(q.items)
+	for {
+		left := 2*i + 1
+		if left >= n {
+			break
+		}
+		child := left
+		if right := left + 1; right < n && q.before(right, left) {
+			child = right
+		}
+		if !q.before(child, i) {
+			break
+		}
+		q.swap(i, child)
+		i = child
+	}
+	return i > start
+}
+
+// before reports whether the item at position `i` must pop before the one at `j`.
+func (q *Queue[T]) before(i, j int) bool {
+	a, b := q.items[i], q.items[j]
+	if q.less(a.value, b.value) {
+		return true
+	}
+	if q.stable && !q.less(b.value, a.value) {
+		return a.seq < b.seq
+	}
+	return false
+}
+
+// swap exchanges the items at positions `i` and `j`.
+func (q *Queue[T]) swap(i, j int) {
+	q.items[i], q.items[j] = q.items[j], q.items[i]
+	q.items[i].index = i
+	q.items[j].index = j
+}
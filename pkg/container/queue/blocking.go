@@ -0,0 +1,176 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package queue
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrClosed is returned by operations on a closed Blocking queue.
+var ErrClosed = errors.New("queue: closed")
+
+// Blocking is a FIFO queue whose Push and Pop wait for room or items.
+//
+// Closing the queue rejects further pushes while still letting consumers
+// drain the remaining items; once drained, Pop reports ErrClosed. This gives
+// producer-consumer code a single, explicit shutdown path instead of relying
+// on channel close conventions.
+type Blocking[T any] struct {
+	// mu guards all fields below.
+	mu sync.Mutex
+
+	// buf holds the queued items.
+	buf buffer[T]
+
+	// limit is the maximum number of items, or 0 for unbounded.
+	limit int
+
+	// closed is set by Close.
+	closed bool
+
+	// changed is closed and replaced whenever items are added or removed or
+	// the queue is closed, waking up all waiters.
+	changed chan struct{}
+}
+
+// NewBlocking creates and returns an empty Blocking queue holding at most
+// `limit` items. A limit of zero or less makes the queue unbounded, in which
+// case Push never blocks.
+func NewBlocking[T any](limit int) *Blocking[T] {
+	if limit < 0 {
+		limit = 0
+	}
+	return &Blocking[T]{
+		limit:   limit,
+		changed: make(chan struct{}),
+	}
+}
+
+// Push appends `value`, waiting while the queue is full.
+// It returns ErrClosed if the queue is closed, or the context error if `ctx`
+// is done before there is room.
+func (q *Blocking[T]) Push(ctx context.Context, value T) error {
+	for {
+		q.mu.Lock()
+		if q.closed {
+			q.mu.Unlock()
+			return ErrClosed
+		}
+		if q.limit == 0 || q.buf.size < q.limit {
+			q.buf.pushBack(value)
+			q.notifyLocked()
+			q.mu.Unlock()
+			return nil
+		}
+		changed := q.changed
+		q.mu.Unlock()
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// TryPush appends `value` if there is room, without waiting.
+// It reports whether the value was queued.
+func (q *Blocking[T]) TryPush(value T) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed || (q.limit > 0 && q.buf.size >= q.limit) {
+		return false
+	}
+	q.buf.pushBack(value)
+	q.notifyLocked()
+	return true
+}
+
+// Pop removes and returns the oldest value, waiting while the queue is empty.
+// It returns ErrClosed once the queue is closed and drained, or the context
+// error if `ctx` is done before a value is available.
+func (q *Blocking[T]) Pop(ctx context.Context) (value T, err error) {
+	for {
+		q.mu.Lock()
+		if q.buf.size > 0 {
+			value = q.buf.popFront()
+			q.notifyLocked()
+			q.mu.Unlock()
+			return value, nil
+		}
+		if q.closed {
+			q.mu.Unlock()
+			return value, ErrClosed
+		}
+		changed := q.changed
+		q.mu.Unlock()
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return value, ctx.Err()
+		}
+	}
+}
+
+// PopTimeout is like Pop but gives up after `timeout`, returning
+// context.DeadlineExceeded.
+func (q *Blocking[T]) PopTimeout(timeout time.Duration) (value T, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return q.Pop(ctx)
+}
+
+// TryPop removes and returns the oldest value without waiting.
+func (q *Blocking[T]) TryPop() (value T, found bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.buf.size == 0 {
+		return
+	}
+	value = q.buf.popFront()
+	q.notifyLocked()
+	return value, true
+}
+
+// Close closes the queue. Pending and future pushes fail with ErrClosed, while
+// consumers can still pop the remaining items. Closing twice is a no-op.
+func (q *Blocking[T]) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return
+	}
+	q.closed = true
+	q.notifyLocked()
+}
+
+// IsClosed reports whether Close has been called.
+func (q *Blocking[T]) IsClosed() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.closed
+}
+
+// Len returns the number of queued values.
+func (q *Blocking[T]) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.buf.size
+}
+
+// Cap returns the maximum number of values, or 0 for an unbounded queue.
+func (q *Blocking[T]) Cap() int {
+	return q.limit
+}
+
+// notifyLocked wakes up all waiters. The caller must hold q.mu.
+func (q *Blocking[T]) notifyLocked() {
+	close(q.changed)
+	q.changed = make(chan struct{})
+}
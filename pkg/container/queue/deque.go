@@ -0,0 +1,193 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package queue
+
+import (
+	"github.com/focela/aegis/internal/concurrency/lock"
+)
+
+// minBufferSize is the initial capacity of a growable buffer.
+const minBufferSize = 16
+
+// buffer is a growable circular buffer shared by Deque and Blocking.
+// It is not synchronized.
+type buffer[T any] struct {
+	items []T
+	head  int
+	size  int
+}
+
+// pushBack appends `v` after the newest item.
+func (b *buffer[T]) pushBack(v T) {
+	b.grow()
+	b.items[(b.head+b.size)%len(b.items)] = v
+	b.size++
+}
+
+// pushFront inserts `v` before the oldest item.
+func (b *buffer[T]) pushFront(v T) {
+	b.grow()
+	b.head = (b.head - 1 + len(b.items)) % len(b.items)
+	b.items[b.head] = v
+	b.size++
+}
+
+// popFront removes the oldest item; the buffer must not be empty.
+func (b *buffer[T]) popFront() T {
+	var zero T
+	v := b.items[b.head]
+	b.items[b.head] = zero
+	b.head = (b.head + 1) % len(b.items)
+	b.size--
+	return v
+}
+
+// popBack removes the newest item; the buffer must not be empty.
+func (b *buffer[T]) popBack() T {
+	var zero T
+	i := (b.head + b.size - 1) % len(b.items)
+	v := b.items[i]
+	b.items[i] = zero
+	b.size--
+	return v
+}
+
+// at returns the item at logical position `i`, where 0 is the front.
+func (b *buffer[T]) at(i int) T {
+	return b.items[(b.head+i)%len(b.items)]
+}
+
+// grow doubles the storage when it is full.
+func (b *buffer[T]) grow() {
+	if b.size < len(b.items) {
+		return
+	}
+	capacity := len(b.items) * 2
+	if capacity < minBufferSize {
+		capacity = minBufferSize
+	}
+	items := make([]T, capacity)
+	for i := 0; i < b.size; i++ {
+		items[i] = b.at(i)
+	}
+	b.items = items
+	b.head = 0
+}
+
+// reset drops all items.
+func (b *buffer[T]) reset() {
+	b.items = nil
+	b.head = 0
+	b.size = 0
+}
+
+// Deque is a double-ended queue backed by a growable circular buffer.
+// The zero value is an empty deque without concurrent safety, ready to use.
+type Deque[T any] struct {
+	// mu guards buf; it performs no locking in unsafe mode.
+	mu lock.RWMutex
+
+	// buf holds the items.
+	buf buffer[T]
+}
+
+// NewDeque creates and returns an empty Deque.
+// The deque performs no locking unless `safe` is given as true.
+func NewDeque[T any](safe ...bool) *Deque[T] {
+	return &Deque[T]{mu: lock.CreateRWMutex(safe...)}
+}
+
+// PushBack appends `values` at the back in order.
+func (d *Deque[T]) PushBack(values ...T) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, v := range values {
+		d.buf.pushBack(v)
+	}
+}
+
+// PushFront inserts `values` at the front, so that the last value ends up first.
+func (d *Deque[T]) PushFront(values ...T) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, v := range values {
+		d.buf.pushFront(v)
+	}
+}
+
+// PopFront removes and returns the value at the front.
+func (d *Deque[T]) PopFront() (value T, found bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.buf.size == 0 {
+		return
+	}
+	return d.buf.popFront(), true
+}
+
+// PopBack removes and returns the value at the back.
+func (d *Deque[T]) PopBack() (value T, found bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.buf.size == 0 {
+		return
+	}
+	return d.buf.popBack(), true
+}
+
+// Front returns the value at the front without removing it.
+func (d *Deque[T]) Front() (value T, found bool) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	if d.buf.size == 0 {
+		return
+	}
+	return d.buf.at(0), true
+}
+
+// Back returns the value at the back without removing it.
+func (d *Deque[T]) Back() (value T, found bool) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	if d.buf.size == 0 {
+		return
+	}
+	return d.buf.at(d.buf.size - 1), true
+}
+
+// At returns the value at position `i` counted from the front.
+func (d *Deque[T]) At(i int) (value T, found bool) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	if i < 0 || i >= d.buf.size {
+		return
+	}
+	return d.buf.at(i), true
+}
+
+// Len returns the number of values.
+func (d *Deque[T]) Len() int {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.buf.size
+}
+
+// Clear removes all values.
+func (d *Deque[T]) Clear() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.buf.reset()
+}
+
+// Values returns the values from front to back.
+func (d *Deque[T]) Values() []T {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	values := make([]T, d.buf.size)
+	for i := range values {
+		values[i] = d.buf.at(i)
+	}
+	return values
+}
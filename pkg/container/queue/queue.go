@@ -0,0 +1,111 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package queue provides FIFO queues and a double-ended queue.
+//
+// Queue is an unbounded, non-blocking multi-producer multi-consumer queue built
+// on atomic operations. Blocking adds optional bounds, context-aware Push/Pop
+// and explicit Close semantics for producer-consumer pipelines. Deque supports
+// insertion and removal at both ends.
+package queue
+
+import (
+	"sync/atomic"
+)
+
+// node is a linked node of a Queue.
+type node[T any] struct {
+	value T
+	next  atomic.Pointer[node[T]]
+}
+
+// Queue is an unbounded lock-free FIFO queue safe for concurrent use by
+// multiple producers and consumers.
+//
+// It implements the Michael-Scott algorithm; the garbage collector rules out
+// the ABA problem, so no tagged pointers are needed.
+type Queue[T any] struct {
+	// head points to a dummy node whose successor is the oldest item.
+	head atomic.Pointer[node[T]]
+
+	// tail points to the last or second to last node.
+	tail atomic.Pointer[node[T]]
+
+	// size is the number of items, maintained on a best-effort basis.
+	size atomic.Int64
+}
+
+// New creates and returns an empty Queue.
+func New[T any]() *Queue[T] {
+	q := &Queue[T]{}
+	dummy := &node[T]{}
+	q.head.Store(dummy)
+	q.tail.Store(dummy)
+	return q
+}
+
+// Push appends `value` to the back of the queue.
+func (q *Queue[T]) Push(value T) {
+	n := &node[T]{value: value}
+	for {
+		tail := q.tail.Load()
+		next := tail.next.Load()
+		if tail != q.tail.Load() {
+			continue
+		}
+		if next != nil {
+			// Tail is lagging behind; help advance it
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+		if tail.next.CompareAndSwap(nil, n) {
+			q.tail.CompareAndSwap(tail, n)
+			q.size.Add(1)
+			return
+		}
+	}
+}
+
+// Pop removes and returns the value at the front of the queue.
+// It returns false immediately if the queue is empty.
+func (q *Queue[T]) Pop() (value T, found bool) {
+	for {
+		head := q.head.Load()
+		tail := q.tail.Load()
+		next := head.next.Load()
+		if head != q.head.Load() {
+			continue
+		}
+		if next == nil {
+			return
+		}
+		if head == tail {
+			// Tail is lagging behind; help advance it
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+		if q.head.CompareAndSwap(head, next) {
+			// The new head becomes the dummy node, so release its value
+			value = next.value
+			var zero T
+			next.value = zero
+			q.size.Add(-1)
+			return value, true
+		}
+	}
+}
+
+// Len returns the number of queued values. Under concurrent modification the
+// result is only an approximation.
+func (q *Queue[T]) Len() int {
+	if n := q.size.Load(); n > 0 {
+		return int(n)
+	}
+	return 0
+}
+
+// IsEmpty reports whether the queue currently holds no values.
+func (q *Queue[T]) IsEmpty() bool {
+	return q.head.Load().next.Load() == nil
+}
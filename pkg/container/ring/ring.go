@@ -0,0 +1,229 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package ring provides a fixed-capacity circular buffer.
+//
+// A Buffer either overwrites its oldest items when full, which suits bounded
+// storage of recent telemetry or latency samples, or rejects writes with
+// ErrFull, which suits bounded hand-off between producers and consumers.
+package ring
+
+import (
+	"errors"
+	"iter"
+
+	"github.com/focela/aegis/internal/concurrency/lock"
+)
+
+// Mode defines what a Buffer does when it is full.
+type Mode int
+
+// Buffer modes.
+const (
+	// ModeOverwrite discards the oldest items to make room for new ones.
+	ModeOverwrite Mode = iota
+
+	// ModeError rejects items that don't fit and reports ErrFull.
+	ModeError
+)
+
+// ErrFull is returned by writes to a full buffer in ModeError.
+var ErrFull = errors.New("ring: buffer is full")
+
+// Buffer is a circular buffer of fixed capacity.
+type Buffer[T any] struct {
+	// mu guards the buffer; it performs no locking in unsafe mode.
+	mu lock.RWMutex
+
+	// items is the backing storage, its length is the capacity.
+	items []T
+
+	// head is the index of the oldest item.
+	head int
+
+	// size is the number of stored items.
+	size int
+
+	// mode defines the behavior when full.
+	mode Mode
+}
+
+// New creates and returns an empty buffer holding at most `capacity` items.
+// It panics if `capacity` is not positive.
+//
+// Parameters:
+//   - capacity: The maximum number of items held by the buffer
+//   - mode: What happens on writes to a full buffer
+//   - safe: Optional boolean enabling concurrent safety
+//
+// Returns:
+//   - A pointer to a newly created Buffer
+func New[T any](capacity int, mode Mode, safe ...bool) *Buffer[T] {
+	if capacity <= 0 {
+		panic("ring: capacity must be positive")
+	}
+	return &Buffer[T]{
+		mu:    lock.CreateRWMutex(safe...),
+		items: make([]T, capacity),
+		mode:  mode,
+	}
+}
+
+// Push appends `item` as the newest item.
+// A full buffer in ModeError returns ErrFull and keeps its content.
+func (b *Buffer[T]) Push(item T) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.push(item) {
+		return ErrFull
+	}
+	return nil
+}
+
+// Write appends `items` in order and returns how many were stored.
+// In ModeError, items that don't fit are rejected and ErrFull is returned
+// along with the number of items written before the buffer filled up.
+func (b *Buffer[T]) Write(items ...T) (n int, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, item := range items {
+		if !b.push(item) {
+			return n, ErrFull
+		}
+		n++
+	}
+	return n, nil
+}
+
+// Pop removes and returns the oldest item.
+func (b *Buffer[T]) Pop() (item T, found bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.size == 0 {
+		return
+	}
+	return b.pop(), true
+}
+
+// Read removes up to len(dst) of the oldest items, copies them into `dst` in
+// order, and returns the number of items read.
+func (b *Buffer[T]) Read(dst []T) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	n := 0
+	for n < len(dst) && b.size > 0 {
+		dst[n] = b.pop()
+		n++
+	}
+	return n
+}
+
+// Peek returns the oldest item without removing it.
+func (b *Buffer[T]) Peek() (item T, found bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if b.size == 0 {
+		return
+	}
+	return b.items[b.head], true
+}
+
+// PeekNewest returns the newest item without removing it.
+func (b *Buffer[T]) PeekNewest() (item T, found bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if b.size == 0 {
+		return
+	}
+	return b.items[b.index(b.size-1)], true
+}
+
+// Len returns the number of stored items.
+func (b *Buffer[T]) Len() int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.size
+}
+
+// Cap returns the capacity of the buffer.
+func (b *Buffer[T]) Cap() int {
+	return len(b.items)
+}
+
+// IsEmpty reports whether the buffer holds no items.
+func (b *Buffer[T]) IsEmpty() bool {
+	return b.Len() == 0
+}
+
+// IsFull reports whether the buffer is at capacity.
+func (b *Buffer[T]) IsFull() bool {
+	return b.Len() == len(b.items)
+}
+
+// Reset removes all items.
+func (b *Buffer[T]) Reset() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	clear(b.items)
+	b.head = 0
+	b.size = 0
+}
+
+// Values returns a copy of the stored items from oldest to newest,
+// without removing them.
+func (b *Buffer[T]) Values() []T {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	values := make([]T, b.size)
+	for i := range values {
+		values[i] = b.items[b.index(i)]
+	}
+	return values
+}
+
+// All returns an iterator over the stored items from oldest to newest.
+// The read lock is held during iteration, so the loop body must not modify
+// the buffer.
+func (b *Buffer[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		b.mu.RLock()
+		defer b.mu.RUnlock()
+		for i := 0; i < b.size; i++ {
+			if !yield(b.items[b.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// push stores `item` according to the buffer mode and reports whether it was stored.
+func (b *Buffer[T]) push(item T) bool {
+	if b.size == len(b.items) {
+		if b.mode == ModeError {
+			return false
+		}
+		// Overwrite the oldest item, which becomes the newest
+		b.items[b.head] = item
+		b.head = (b.head + 1) % len(b.items)
+		return true
+	}
+	b.items[b.index(b.size)] = item
+	b.size++
+	return true
+}
+
+// pop removes and returns the oldest item; the buffer must not be empty.
+func (b *Buffer[T]) pop() T {
+	var zero T
+	item := b.items[b.head]
+	b.items[b.head] = zero
+	b.head = (b.head + 1) % len(b.items)
+	b.size--
+	return item
+}
+
+// index maps the logical position `i` (0 is the oldest) to a storage index.
+func (b *Buffer[T]) index(i int) int {
+	return (b.head + i) % len(b.items)
+}
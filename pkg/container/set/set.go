@@ -0,0 +1,259 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package set provides a generic set type with set algebra operations.
+//
+// Sets created with New perform no locking and are meant for use by a single
+// goroutine, replacing ad hoc map[T]struct{} values. Sets created with NewSafe
+// are guarded by a read/write lock and can be shared between goroutines.
+package set
+
+import (
+	"encoding/json"
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/focela/aegis/internal/concurrency/lock"
+)
+
+// Set is a collection of unique items.
+type Set[T comparable] struct {
+	// mu guards data; it performs no locking for sets created with New.
+	mu lock.RWMutex
+
+	// data holds the items.
+	data map[T]struct{}
+}
+
+// New creates and returns a set holding `items`. The set is not concurrent-safe.
+func New[T comparable](items ...T) *Set[T] {
+	return newSet(false, items)
+}
+
+// NewSafe creates and returns a concurrent-safe set holding `items`.
+func NewSafe[T comparable](items ...T) *Set[T] {
+	return newSet(true, items)
+}
+
+// newSet creates a set in the given safety mode.
+func newSet[T comparable](safe bool, items []T) *Set[T] {
+	s := &Set[T]{
+		mu:   lock.CreateRWMutex(safe),
+		data: make(map[T]struct{}, len(items)),
+	}
+	for _, item := range items {
+		s.data[item] = struct{}{}
+	}
+	return s
+}
+
+// IsSafe reports whether the set is concurrent-safe.
+func (s *Set[T]) IsSafe() bool {
+	return s.mu.IsSafe()
+}
+
+// Add adds `items` to the set.
+func (s *Set[T]) Add(items ...T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.data == nil {
+		s.data = make(map[T]struct{}, len(items))
+	}
+	for _, item := range items {
+		s.data[item] = struct{}{}
+	}
+}
+
+// AddIfAbsent adds `item` if it is not yet present and reports whether it was added.
+func (s *Set[T]) AddIfAbsent(item T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.data[item]; ok {
+		return false
+	}
+	if s.data == nil {
+		s.data = make(map[T]struct{})
+	}
+	s.data[item] = struct{}{}
+	return true
+}
+
+// Remove removes `items` from the set.
+func (s *Set[T]) Remove(items ...T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, item := range items {
+		delete(s.data, item)
+	}
+}
+
+// Contains reports whether `item` is in the set.
+func (s *Set[T]) Contains(item T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	_, ok := s.data[item]
+	return ok
+}
+
+// Size returns the number of items.
+func (s *Set[T]) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.data)
+}
+
+// IsEmpty reports whether the set has no items.
+func (s *Set[T]) IsEmpty() bool {
+	return s.Size() == 0
+}
+
+// Clear removes all items.
+func (s *Set[T]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data = make(map[T]struct{})
+}
+
+// Slice returns the items in unspecified order.
+func (s *Set[T]) Slice() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	items := make([]T, 0, len(s.data))
+	for item := range s.data {
+		items = append(items, item)
+	}
+	return items
+}
+
+// Iterate calls `f` for each item until `f` returns false.
+//
+// The read lock is held for the whole iteration, so `f` must not modify the set.
+func (s *Set[T]) Iterate(f func(item T) bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for item := range s.data {
+		if !f(item) {
+			return
+		}
+	}
+}
+
+// All returns an iterator over the items, for use with range-over-func loops.
+func (s *Set[T]) All() iter.Seq[T] {
+	return s.Iterate
+}
+
+// Clone returns a copy of the set in the same safety mode.
+func (s *Set[T]) Clone() *Set[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	clone := newSet[T](s.mu.IsSafe(), nil)
+	for item := range s.data {
+		clone.data[item] = struct{}{}
+	}
+	return clone
+}
+
+// Equal reports whether `s` and `other` hold exactly the same items.
+func (s *Set[T]) Equal(other *Set[T]) bool {
+	if s == other {
+		return true
+	}
+	return s.Size() == other.Size() && s.IsSubsetOf(other)
+}
+
+// IsSubsetOf reports whether every item of `s` is in `other`.
+// Items of `s` are snapshotted first so that the two sets are never locked at
+// the same time.
+func (s *Set[T]) IsSubsetOf(other *Set[T]) bool {
+	if s == other {
+		return true
+	}
+	for _, item := range s.Slice() {
+		if !other.Contains(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSupersetOf reports whether every item of `other` is in `s`.
+func (s *Set[T]) IsSupersetOf(other *Set[T]) bool {
+	return other.IsSubsetOf(s)
+}
+
+// Union returns a new set holding the items found in `s` or any of `others`.
+// The result has the same safety mode as `s`.
+func (s *Set[T]) Union(others ...*Set[T]) *Set[T] {
+	result := s.Clone()
+	for _, other := range others {
+		other.Iterate(func(item T) bool {
+			result.data[item] = struct{}{}
+			return true
+		})
+	}
+	return result
+}
+
+// Intersect returns a new set holding the items found in `s` and in all of `others`.
+// The result has the same safety mode as `s`.
+func (s *Set[T]) Intersect(others ...*Set[T]) *Set[T] {
+	result := s.Clone()
+	for _, other := range others {
+		for item := range result.data {
+			if !other.Contains(item) {
+				delete(result.data, item)
+			}
+		}
+	}
+	return result
+}
+
+// Diff returns a new set holding the items of `s` not found in any of `others`.
+// The result has the same safety mode as `s`.
+func (s *Set[T]) Diff(others ...*Set[T]) *Set[T] {
+	result := s.Clone()
+	for _, other := range others {
+		other.Iterate(func(item T) bool {
+			delete(result.data, item)
+			return true
+		})
+	}
+	return result
+}
+
+// String returns the items formatted like a Go slice, in unspecified order.
+func (s *Set[T]) String() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var b strings.Builder
+	b.WriteByte('[')
+	first := true
+	for item := range s.data {
+		if !first {
+			b.WriteByte(' ')
+		}
+		first = false
+		fmt.Fprint(&b, item)
+	}
+	b.WriteByte(']')
+	return b.String()
+}
+
+// MarshalJSON implements the json.Marshaler interface, encoding the set as an array.
+func (s *Set[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Slice())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, adding the items of
+// a JSON array to the set.
+func (s *Set[T]) UnmarshalJSON(b []byte) error {
+	var items []T
+	if err := json.Unmarshal(b, &items); err != nil {
+		return err
+	}
+	s.Add(items...)
+	return nil
+}
@@ -0,0 +1,331 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package sortedarray provides a slice kept sorted by a comparator.
+//
+// Lookups use binary search, so the array suits data that is read far more
+// often than it is written, such as sorted configuration lists or the
+// boundaries of intervals. In unique mode, values comparing equal to one
+// already present are dropped.
+package sortedarray
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/focela/aegis/internal/concurrency/lock"
+)
+
+// Comparator compares `a` and `b`, returning a negative number when a < b,
+// zero when a == b and a positive number when a > b.
+type Comparator[T any] func(a, b T) int
+
+// Array is a sorted slice guarded by a read/write lock.
+type Array[T any] struct {
+	// mu guards the array; it performs no locking in unsafe mode.
+	mu lock.RWMutex
+
+	// data holds the values in ascending order.
+	data []T
+
+	// comparator orders the values.
+	comparator Comparator[T]
+
+	// unique drops values equal to one already present.
+	unique bool
+}
+
+// New creates and returns an empty Array ordered by `comparator`.
+// The array performs no locking unless `safe` is given as true.
+func New[T any](comparator Comparator[T], safe ...bool) *Array[T] {
+	return &Array[T]{
+		mu:         lock.CreateRWMutex(safe...),
+		comparator: comparator,
+	}
+}
+
+// NewOrdered creates and returns an empty Array for an ordered type, sorted in
+// ascending natural order.
+func NewOrdered[T cmp.Ordered](safe ...bool) *Array[T] {
+	return New[T](cmp.Compare[T], safe...)
+}
+
+// NewFrom creates and returns an Array holding a sorted copy of `values`.
+func NewFrom[T any](values []T, comparator Comparator[T], safe ...bool) *Array[T] {
+	a := New(comparator, safe...)
+	a.data = slices.Clone(values)
+	slices.SortStableFunc(a.data, a.comparator)
+	return a
+}
+
+// SetUnique switches unique mode. Enabling it removes existing duplicates,
+// keeping the first of each run of equal values.
+func (a *Array[T]) SetUnique(unique bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.unique = unique
+	if unique {
+		a.compact()
+	}
+}
+
+// IsUnique reports whether the array is in unique mode.
+func (a *Array[T]) IsUnique() bool {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.unique
+}
+
+// Add inserts `values` at their sorted positions. Equal values are inserted
+// after the existing ones; in unique mode they are dropped.
+//
+// A batch is appended and re-sorted at once, which is cheaper than inserting
+// the values one by one.
+func (a *Array[T]) Add(values ...T) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if len(values) == 1 {
+		a.insert(values[0])
+		return
+	}
+	a.data = append(a.data, values...)
+	slices.SortStableFunc(a.data, a.comparator)
+	if a.unique {
+		a.compact()
+	}
+}
+
+// Search returns the index of the first value equal to `value` and whether one
+// was found. If not found, the index is where `value` would be inserted.
+func (a *Array[T]) Search(value T) (index int, found bool) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.search(value)
+}
+
+// SearchRange returns the bounds [start, end) of the values lying in the
+// half-open interval [from, to). The range is empty when start == end.
+func (a *Array[T]) SearchRange(from, to T) (start, end int) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	start, _ = a.search(from)
+	end, _ = a.search(to)
+	return start, max(start, end)
+}
+
+// Range returns a copy of the values lying in the half-open interval [from, to).
+func (a *Array[T]) Range(from, to T) []T {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	start, _ := a.search(from)
+	end, _ := a.search(to)
+	if end <= start {
+		return nil
+	}
+	return slices.Clone(a.data[start:end])
+}
+
+// Floor returns the largest value less than or equal to `value`.
+func (a *Array[T]) Floor(value T) (floor T, found bool) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	// First index whose value is greater than `value`
+	i := sort.Search(len(a.data), func(i int) bool {
+		return a.comparator(a.data[i], value) > 0
+	})
+	if i == 0 {
+		return
+	}
+	return a.data[i-1], true
+}
+
+// Ceiling returns the smallest value greater than or equal to `value`.
+func (a *Array[T]) Ceiling(value T) (ceiling T, found bool) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	i, _ := a.search(value)
+	if i == len(a.data) {
+		return
+	}
+	return a.data[i], true
+}
+
+// Contains reports whether a value equal to `value` is present.
+func (a *Array[T]) Contains(value T) bool {
+	_, found := a.Search(value)
+	return found
+}
+
+// Get returns the value at `index`.
+func (a *Array[T]) Get(index int) (value T, found bool) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	if index < 0 || index >= len(a.data) {
+		return
+	}
+	return a.data[index], true
+}
+
+// Min returns the smallest value.
+func (a *Array[T]) Min() (value T, found bool) {
+	return a.Get(0)
+}
+
+// Max returns the largest value.
+func (a *Array[T]) Max() (value T, found bool) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	if len(a.data) == 0 {
+		return
+	}
+	return a.data[len(a.data)-1], true
+}
+
+// Remove deletes and returns the value at `index`.
+func (a *Array[T]) Remove(index int) (value T, found bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if index < 0 || index >= len(a.data) {
+		return
+	}
+	value = a.data[index]
+	a.data = slices.Delete(a.data, index, index+1)
+	return value, true
+}
+
+// RemoveValue deletes the first value equal to `value` and reports whether
+// one was found.
+func (a *Array[T]) RemoveValue(value T) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	i, found := a.search(value)
+	if found {
+		a.data = slices.Delete(a.data, i, i+1)
+	}
+	return found
+}
+
+// Len returns the number of values.
+func (a *Array[T]) Len() int {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return len(a.data)
+}
+
+// IsEmpty reports whether the array has no values.
+func (a *Array[T]) IsEmpty() bool {
+	return a.Len() == 0
+}
+
+// Clear removes all values.
+func (a *Array[T]) Clear() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.data = nil
+}
+
+// Slice returns a copy of the values in ascending order.
+func (a *Array[T]) Slice() []T {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return slices.Clone(a.data)
+}
+
+// Iterate calls `f` for each value in ascending order until `f` returns false.
+//
+// The read lock is held for the whole iteration, so `f` must not modify the array.
+func (a *Array[T]) Iterate(f func(index int, value T) bool) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	for i, v := range a.data {
+		if !f(i, v) {
+			return
+		}
+	}
+}
+
+// All returns an iterator over the indexes and values in ascending order,
+// for use with range-over-func loops.
+func (a *Array[T]) All() iter.Seq2[int, T] {
+	return a.Iterate
+}
+
+// Clone returns a copy of the array with the same comparator, unique mode and
+// safety mode.
+func (a *Array[T]) Clone() *Array[T] {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	clone := New(a.comparator, a.mu.IsSafe())
+	clone.data = slices.Clone(a.data)
+	clone.unique = a.unique
+	return clone
+}
+
+// String returns the values in ascending order, formatted like a Go slice.
+func (a *Array[T]) String() string {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	var b strings.Builder
+	b.WriteByte('[')
+	for i, v := range a.data {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		fmt.Fprint(&b, v)
+	}
+	b.WriteByte(']')
+	return b.String()
+}
+
+// MarshalJSON implements the json.Marshaler interface, encoding the array as
+// a JSON array.
+func (a *Array[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.Slice())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, adding the items of
+// a JSON array. The array must have been created with a comparator.
+func (a *Array[T]) UnmarshalJSON(b []byte) error {
+	var values []T
+	if err := json.Unmarshal(b, &values); err != nil {
+		return err
+	}
+	a.Add(values...)
+	return nil
+}
+
+// search returns the index of the first value not less than `value` and
+// whether it equals `value`.
+func (a *Array[T]) search(value T) (int, bool) {
+	return slices.BinarySearchFunc(a.data, value, a.comparator)
+}
+
+// insert adds a single value at its sorted position. The caller must hold
+// the write lock.
+func (a *Array[T]) insert(value T) {
+	i, found := a.search(value)
+	if found {
+		if a.unique {
+			return
+		}
+		// Insert after the existing equal values
+		for i < len(a.data) && a.comparator(a.data[i], value) == 0 {
+			i++
+		}
+	}
+	a.data = slices.Insert(a.data, i, value)
+}
+
+// compact removes all but the first of each run of equal values. The caller
+// must hold the write lock.
+func (a *Array[T]) compact() {
+	a.data = slices.CompactFunc(a.data, func(x, y T) bool {
+		return a.comparator(x, y) == 0
+	})
+}
@@ -0,0 +1,295 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package tree
+
+// put inserts or replaces `key` and rebalances the tree.
+func (m *Map[K, V]) put(key K, value V) {
+	if m.root == nil {
+		m.root = &node[K, V]{key: key, value: value, color: black}
+		m.size++
+		return
+	}
+
+	// Walk down to the insertion point
+	n := m.root
+	var inserted *node[K, V]
+	for inserted == nil {
+		c := m.comparator(key, n.key)
+		switch {
+		case c == 0:
+			n.key = key
+			n.value = value
+			return
+		case c < 0:
+			if n.left == nil {
+				n.left = &node[K, V]{key: key, value: value, color: red, parent: n}
+				inserted = n.left
+			} else {
+				n = n.left
+			}
+		default:
+			if n.right == nil {
+				n.right = &node[K, V]{key: key, value: value, color: red, parent: n}
+				inserted = n.right
+			} else {
+				n = n.right
+			}
+		}
+	}
+
+	m.fixInsert(inserted)
+	m.size++
+}
+
+// fixInsert restores the red-black properties after inserting red node `n`.
+func (m *Map[K, V]) fixInsert(n *node[K, V]) {
+	for {
+		// The root is always black
+		if n.parent == nil {
+			n.color = black
+			return
+		}
+		// A black parent means no red-red violation
+		if colorOf(n.parent) == black {
+			return
+		}
+
+		// Red uncle: recolor and continue from the grandparent
+		grandparent := n.parent.parent
+		uncle := n.uncle()
+		if colorOf(uncle) == red {
+			n.parent.color = black
+			uncle.color = black
+			grandparent.color = red
+			n = grandparent
+			continue
+		}
+
+		// Black uncle: rotate an inner child to the outside first
+		if n == n.parent.right && n.parent == grandparent.left {
+			m.rotateLeft(n.parent)
+			n = n.left
+		} else if n == n.parent.left && n.parent == grandparent.right {
+			m.rotateRight(n.parent)
+			n = n.right
+		}
+
+		// Then rotate the grandparent towards the uncle
+		n.parent.color = black
+		grandparent = n.parent.parent
+		grandparent.color = red
+		if n == n.parent.left {
+			m.rotateRight(grandparent)
+		} else {
+			m.rotateLeft(grandparent)
+		}
+		return
+	}
+}
+
+// delete removes node `n` and rebalances the tree.
+func (m *Map[K, V]) delete(n *node[K, V]) {
+	// A node with two children swaps its entry with its in-order predecessor,
+	// which has at most one child, and that node is removed instead
+	if n.left != nil && n.right != nil {
+		predecessor := maxNode(n.left)
+		n.key = predecessor.key
+		n.value = predecessor.value
+		n = predecessor
+	}
+
+	child := n.left
+	if child == nil {
+		child = n.right
+	}
+	if n.color == black {
+		n.color = colorOf(child)
+		m.fixDelete(n)
+	}
+	m.replaceNode(n, child)
+	if n.parent == nil && child != nil {
+		child.color = black
+	}
+	m.size--
+}
+
+// fixDelete restores the red-black properties before removing black node `n`,
+// which is still linked into the tree at this point.
+func (m *Map[K, V]) fixDelete(n *node[K, V]) {
+	for n.parent != nil {
+		// Red sibling: rotate so that the sibling becomes black
+		sibling := n.sibling()
+		if colorOf(sibling) == red {
+			n.parent.color = red
+			sibling.color = black
+			if n == n.parent.left {
+				m.rotateLeft(n.parent)
+			} else {
+				m.rotateRight(n.parent)
+			}
+			sibling = n.sibling()
+		}
+
+		// Black parent, sibling and nephews: push the problem up the tree
+		if colorOf(n.parent) == black &&
+			colorOf(sibling) == black &&
+			colorOf(sibling.left) == black &&
+			colorOf(sibling.right) == black {
+			sibling.color = red
+			n = n.parent
+			continue
+		}
+
+		// Red parent with black sibling and nephews: swap colors and stop
+		if colorOf(n.parent) == red &&
+			colorOf(sibling) == black &&
+			colorOf(sibling.left) == black &&
+			colorOf(sibling.right) == black {
+			sibling.color = red
+			n.parent.color = black
+			return
+		}
+
+		// Inner red nephew: rotate it to the outside
+		if n == n.parent.left &&
+			colorOf(sibling) == black &&
+			colorOf(sibling.left) == red &&
+			colorOf(sibling.right) == black {
+			sibling.color = red
+			sibling.left.color = black
+			m.rotateRight(sibling)
+		} else if n == n.parent.right &&
+			colorOf(sibling) == black &&
+			colorOf(sibling.right) == red &&
+			colorOf(sibling.left) == black {
+			sibling.color = red
+			sibling.right.color = black
+			m.rotateLeft(sibling)
+		}
+
+		// Outer red nephew: rotate the parent and finish
+		sibling = n.sibling()
+		sibling.color = colorOf(n.parent)
+		n.parent.color = black
+		if n == n.parent.left && colorOf(sibling.right) == red {
+			sibling.right.color = black
+			m.rotateLeft(n.parent)
+		} else if colorOf(sibling.left) == red {
+			sibling.left.color = black
+			m.rotateRight(n.parent)
+		}
+		return
+	}
+}
+
+// rotateLeft rotates the subtree rooted at `n` to the left.
+func (m *Map[K, V]) rotateLeft(n *node[K, V]) {
+	right := n.right
+	m.replaceNode(n, right)
+	n.right = right.left
+	if right.left != nil {
+		right.left.parent = n
+	}
+	right.left = n
+	n.parent = right
+}
+
+// rotateRight rotates the subtree rooted at `n` to the right.
+func (m *Map[K, V]) rotateRight(n *node[K, V]) {
+	left := n.left
+	m.replaceNode(n, left)
+	n.left = left.right
+	if left.right != nil {
+		left.right.parent = n
+	}
+	left.right = n
+	n.parent = left
+}
+
+// replaceNode puts `replacement` in the position of `old` within its parent.
+func (m *Map[K, V]) replaceNode(old, replacement *node[K, V]) {
+	switch {
+	case old.parent == nil:
+		m.root = replacement
+	case old == old.parent.left:
+		old.parent.left = replacement
+	default:
+		old.parent.right = replacement
+	}
+	if replacement != nil {
+		replacement.parent = old.parent
+	}
+}
+
+// uncle returns the sibling of the parent of `n`.
+func (n *node[K, V]) uncle() *node[K, V] {
+	if n.parent == nil || n.parent.parent == nil {
+		return nil
+	}
+	return n.parent.sibling()
+}
+
+// sibling returns the other child of the parent of `n`.
+func (n *node[K, V]) sibling() *node[K, V] {
+	if n.parent == nil {
+		return nil
+	}
+	if n == n.parent.left {
+		return n.parent.right
+	}
+	return n.parent.left
+}
+
+// next returns the in-order successor of `n`, or nil.
+func (n *node[K, V]) next() *node[K, V] {
+	if n.right != nil {
+		return minNode(n.right)
+	}
+	for n.parent != nil && n == n.parent.right {
+		n = n.parent
+	}
+	return n.parent
+}
+
+// prev returns the in-order predecessor of `n`, or nil.
+func (n *node[K, V]) prev() *node[K, V] {
+	if n.left != nil {
+		return maxNode(n.left)
+	}
+	for n.parent != nil && n == n.parent.left {
+		n = n.parent
+	}
+	return n.parent
+}
+
+// minNode returns the leftmost node of the subtree rooted at `n`.
+func minNode[K any, V any](n *node[K, V]) *node[K, V] {
+	if n == nil {
+		return nil
+	}
+	for n.left != nil {
+		n = n.left
+	}
+	return n
+}
+
+// maxNode returns the rightmost node of the subtree rooted at `n`.
+func maxNode[K any, V any](n *node[K, V]) *node[K, V] {
+	if n == nil {
+		return nil
+	}
+	for n.right != nil {
+		n = n.right
+	}
+	return n
+}
+
+// colorOf returns the color of `n`, treating nil leaves as black.
+func colorOf[K any, V any](n *node[K, V]) bool {
+	if n == nil {
+		return black
+	}
+	return n.color
+}
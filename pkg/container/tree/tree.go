@@ -0,0 +1,331 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package tree provides an ordered map backed by a red-black tree.
+//
+// Keys are kept sorted by a user-supplied comparator, which makes the map
+// suitable for sorted iteration, nearest-key lookups (Floor/Ceiling) and range
+// queries, e.g. rate tables or time-indexed data.
+package tree
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/focela/aegis/internal/concurrency/lock"
+)
+
+// Comparator compares `a` and `b`, returning a negative number when a < b,
+// zero when a == b and a positive number when a > b.
+type Comparator[K any] func(a, b K) int
+
+// Node colors of the red-black tree.
+const (
+	black = true
+	red   = false
+)
+
+// node is a red-black tree node. Nil children are black leaves.
+type node[K any, V any] struct {
+	key    K
+	value  V
+	color  bool
+	left   *node[K, V]
+	right  *node[K, V]
+	parent *node[K, V]
+}
+
+// Map is an ordered map guarded by a read/write lock.
+type Map[K any, V any] struct {
+	// mu guards the tree; it performs no locking in unsafe mode.
+	mu lock.RWMutex
+
+	// root is the root node, nil when the map is empty.
+	root *node[K, V]
+
+	// size is the number of entries.
+	size int
+
+	// comparator orders the keys.
+	comparator Comparator[K]
+}
+
+// New creates and returns an empty Map ordered by `comparator`.
+// The map performs no locking unless `safe` is given as true.
+func New[K any, V any](comparator Comparator[K], safe ...bool) *Map[K, V] {
+	return &Map[K, V]{
+		mu:         lock.CreateRWMutex(safe...),
+		comparator: comparator,
+	}
+}
+
+// NewOrdered creates and returns an empty Map for an ordered key type,
+// sorted in ascending natural order.
+func NewOrdered[K cmp.Ordered, V any](safe ...bool) *Map[K, V] {
+	return New[K, V](cmp.Compare[K], safe...)
+}
+
+// Set stores `value` under `key`, replacing any existing value.
+func (m *Map[K, V]) Set(key K, value V) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.put(key, value)
+}
+
+// Get returns the value stored under `key` and whether it was found.
+func (m *Map[K, V]) Get(key K) (value V, found bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if n := m.lookup(key); n != nil {
+		return n.value, true
+	}
+	return
+}
+
+// GetOrSet returns the value stored under `key`. If the key is absent, it
+// stores `value` and returns it.
+func (m *Map[K, V]) GetOrSet(key K, value V) V {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if n := m.lookup(key); n != nil {
+		return n.value
+	}
+	m.put(key, value)
+	return value
+}
+
+// Contains reports whether `key` is present.
+func (m *Map[K, V]) Contains(key K) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.lookup(key) != nil
+}
+
+// Remove deletes `key` and returns the value it held, if any.
+func (m *Map[K, V]) Remove(key K) (value V, found bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	n := m.lookup(key)
+	if n == nil {
+		return
+	}
+	value = n.value
+	m.delete(n)
+	return value, true
+}
+
+// Size returns the number of entries.
+func (m *Map[K, V]) Size() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.size
+}
+
+// IsEmpty reports whether the map has no entries.
+func (m *Map[K, V]) IsEmpty() bool {
+	return m.Size() == 0
+}
+
+// Clear removes all entries.
+func (m *Map[K, V]) Clear() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.root = nil
+	m.size = 0
+}
+
+// Keys returns the keys in ascending order.
+func (m *Map[K, V]) Keys() []K {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	keys := make([]K, 0, m.size)
+	for n := minNode(m.root); n != nil; n = n.next() {
+		keys = append(keys, n.key)
+	}
+	return keys
+}
+
+// Values returns the values in ascending key order.
+func (m *Map[K, V]) Values() []V {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	values := make([]V, 0, m.size)
+	for n := minNode(m.root); n != nil; n = n.next() {
+		values = append(values, n.value)
+	}
+	return values
+}
+
+// Min returns the entry with the smallest key.
+func (m *Map[K, V]) Min() (key K, value V, found bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if n := minNode(m.root); n != nil {
+		return n.key, n.value, true
+	}
+	return
+}
+
+// Max returns the entry with the largest key.
+func (m *Map[K, V]) Max() (key K, value V, found bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if n := maxNode(m.root); n != nil {
+		return n.key, n.value, true
+	}
+	return
+}
+
+// Floor returns the entry with the largest key less than or equal to `key`.
+func (m *Map[K, V]) Floor(key K) (floorKey K, value V, found bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if n := m.floor(key); n != nil {
+		return n.key, n.value, true
+	}
+	return
+}
+
+// Ceiling returns the entry with the smallest key greater than or equal to `key`.
+func (m *Map[K, V]) Ceiling(key K) (ceilingKey K, value V, found bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if n := m.ceiling(key); n != nil {
+		return n.key, n.value, true
+	}
+	return
+}
+
+// IterAsc calls `f` for each entry in ascending key order until `f` returns false.
+//
+// The read lock is held for the whole iteration, so `f` must not modify the map.
+func (m *Map[K, V]) IterAsc(f func(key K, value V) bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for n := minNode(m.root); n != nil; n = n.next() {
+		if !f(n.key, n.value) {
+			return
+		}
+	}
+}
+
+// IterDesc calls `f` for each entry in descending key order until `f` returns false.
+//
+// The read lock is held for the whole iteration, so `f` must not modify the map.
+func (m *Map[K, V]) IterDesc(f func(key K, value V) bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for n := maxNode(m.root); n != nil; n = n.prev() {
+		if !f(n.key, n.value) {
+			return
+		}
+	}
+}
+
+// IterRange calls `f` in ascending order for each entry whose key lies in the
+// half-open interval [from, to), until `f` returns false.
+//
+// The read lock is held for the whole iteration, so `f` must not modify the map.
+func (m *Map[K, V]) IterRange(from, to K, f func(key K, value V) bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for n := m.ceiling(from); n != nil && m.comparator(n.key, to) < 0; n = n.next() {
+		if !f(n.key, n.value) {
+			return
+		}
+	}
+}
+
+// All returns an iterator over the entries in ascending key order,
+// for use with range-over-func loops.
+func (m *Map[K, V]) All() iter.Seq2[K, V] {
+	return m.IterAsc
+}
+
+// Backward returns an iterator over the entries in descending key order.
+func (m *Map[K, V]) Backward() iter.Seq2[K, V] {
+	return m.IterDesc
+}
+
+// Clone returns a copy of the map with the same comparator and safety mode.
+func (m *Map[K, V]) Clone() *Map[K, V] {
+	clone := New[K, V](m.comparator, m.mu.IsSafe())
+	m.IterAsc(func(key K, value V) bool {
+		clone.put(key, value)
+		return true
+	})
+	return clone
+}
+
+// String returns the entries in ascending key order, formatted like a Go map.
+func (m *Map[K, V]) String() string {
+	var b strings.Builder
+	b.WriteString("map[")
+	first := true
+	m.IterAsc(func(key K, value V) bool {
+		if !first {
+			b.WriteByte(' ')
+		}
+		first = false
+		fmt.Fprintf(&b, "%v:%v", key, value)
+		return true
+	})
+	b.WriteByte(']')
+	return b.String()
+}
+
+// lookup returns the node holding `key`, or nil.
+func (m *Map[K, V]) lookup(key K) *node[K, V] {
+	n := m.root
+	for n != nil {
+		c := m.comparator(key, n.key)
+		switch {
+		case c == 0:
+			return n
+		case c < 0:
+			n = n.left
+		default:
+			n = n.right
+		}
+	}
+	return nil
+}
+
+// floor returns the node with the largest key <= `key`, or nil.
+func (m *Map[K, V]) floor(key K) *node[K, V] {
+	var found *node[K, V]
+	n := m.root
+	for n != nil {
+		c := m.comparator(key, n.key)
+		switch {
+		case c == 0:
+			return n
+		case c < 0:
+			n = n.left
+		default:
+			found, n = n, n.right
+		}
+	}
+	return found
+}
+
+// ceiling returns the node with the smallest key >= `key`, or nil.
+func (m *Map[K, V]) ceiling(key K) *node[K, V] {
+	var found *node[K, V]
+	n := m.root
+	for n != nil {
+		c := m.comparator(key, n.key)
+		switch {
+		case c == 0:
+			return n
+		case c < 0:
+			found, n = n, n.left
+		default:
+			n = n.right
+		}
+	}
+	return found
+}
@@ -0,0 +1,320 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package trie provides a prefix tree over separator-delimited string keys.
+//
+// Keys such as "/api/users/list" or "server.http.port" are split into segments
+// on a separator, so prefixes are matched on whole segments. Stored keys may
+// contain wildcard segments: Wildcard matches exactly one segment and CatchAll,
+// as the last segment, matches everything below. This makes the trie the
+// primitive behind routing tables and configuration path matching.
+package trie
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+
+	"github.com/focela/aegis/internal/concurrency/lock"
+)
+
+// Wildcard segments recognized by Match.
+const (
+	// Wildcard matches exactly one segment.
+	Wildcard = "*"
+
+	// CatchAll matches the remaining segments, including none. It is only
+	// meaningful as the last segment of a key.
+	CatchAll = "**"
+)
+
+// node is a trie node holding one segment.
+type node[V any] struct {
+	// children indexes the child nodes by segment.
+	children map[string]*node[V]
+
+	// key is the key stored at this node, as inserted.
+	key string
+
+	// value is the stored value, valid when hasValue is set.
+	value V
+
+	// hasValue reports whether a key ends at this node.
+	hasValue bool
+}
+
+// Trie maps separator-delimited keys to values.
+type Trie[V any] struct {
+	// mu guards the trie; it performs no locking in unsafe mode.
+	mu lock.RWMutex
+
+	// root is the node of the empty key.
+	root *node[V]
+
+	// separator delimits the segments of keys.
+	separator string
+
+	// size is the number of stored keys.
+	size int
+}
+
+// New creates and returns an empty Trie whose keys are split on `separator`,
+// e.g. "/" for paths or "." for configuration keys. Leading and trailing
+// separators are ignored, so "/a/b/" and "a/b" are the same key.
+// The trie performs no locking unless `safe` is given as true.
+func New[V any](separator string, safe ...bool) *Trie[V] {
+	if separator == "" {
+		panic("trie: separator must not be empty")
+	}
+	return &Trie[V]{
+		mu:        lock.CreateRWMutex(safe...),
+		root:      &node[V]{},
+		separator: separator,
+	}
+}
+
+// Set stores `value` under `key`, replacing any existing value.
+func (t *Trie[V]) Set(key string, value V) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	n := t.root
+	for _, seg := range t.split(key) {
+		child, ok := n.children[seg]
+		if !ok {
+			if n.children == nil {
+				n.children = make(map[string]*node[V])
+			}
+			child = &node[V]{}
+			n.children[seg] = child
+		}
+		n = child
+	}
+	if !n.hasValue {
+		t.size++
+	}
+	n.key, n.value, n.hasValue = key, value, true
+}
+
+// Get returns the value stored under exactly `key`. Wildcard segments are
+// compared literally; use Match to resolve them.
+func (t *Trie[V]) Get(key string) (value V, found bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if n := t.lookup(t.split(key)); n != nil && n.hasValue {
+		return n.value, true
+	}
+	return
+}
+
+// Contains reports whether exactly `key` is stored.
+func (t *Trie[V]) Contains(key string) bool {
+	_, found := t.Get(key)
+	return found
+}
+
+// Remove deletes `key` and returns the value it held, if any.
+func (t *Trie[V]) Remove(key string) (value V, found bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	segs := t.split(key)
+	// Record the path to prune the nodes left empty
+	path := make([]*node[V], 0, len(segs)+1)
+	n := t.root
+	path = append(path, n)
+	for _, seg := range segs {
+		if n = n.children[seg]; n == nil {
+			return
+		}
+		path = append(path, n)
+	}
+	if !n.hasValue {
+		return
+	}
+	value = n.value
+	var zero V
+	n.key, n.value, n.hasValue = "", zero, false
+	t.size--
+	for i := len(segs); i > 0; i-- {
+		if path[i].hasValue || len(path[i].children) > 0 {
+			break
+		}
+		delete(path[i-1].children, segs[i-1])
+	}
+	return value, true
+}
+
+// LongestPrefix returns the longest stored key that is a prefix of `key`, in
+// whole segments, along with its value. Wildcard segments are compared
+// literally.
+func (t *Trie[V]) LongestPrefix(key string) (prefix string, value V, found bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	n := t.root
+	if n.hasValue {
+		prefix, value, found = n.key, n.value, true
+	}
+	for _, seg := range t.split(key) {
+		if n = n.children[seg]; n == nil {
+			break
+		}
+		if n.hasValue {
+			prefix, value, found = n.key, n.value, true
+		}
+	}
+	return
+}
+
+// Match resolves `key` against the stored keys, honoring wildcard segments,
+// and returns the matching stored key with its value.
+//
+// At each segment a literal match takes precedence over Wildcard, which takes
+// precedence over CatchAll.
+func (t *Trie[V]) Match(key string) (pattern string, value V, found bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if n := match(t.root, t.split(key)); n != nil {
+		return n.key, n.value, true
+	}
+	return
+}
+
+// WalkPrefix calls `f` for each stored key starting with `prefix`, in whole
+// segments, until `f` returns false. Keys are visited depth-first with
+// sibling segments in lexical order.
+//
+// The read lock is held for the whole walk, so `f` must not modify the trie.
+func (t *Trie[V]) WalkPrefix(prefix string, f func(key string, value V) bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if n := t.lookup(t.split(prefix)); n != nil {
+		walk(n, f)
+	}
+}
+
+// Walk calls `f` for each stored key until `f` returns false, in the order of
+// WalkPrefix.
+//
+// The read lock is held for the whole walk, so `f` must not modify the trie.
+func (t *Trie[V]) Walk(f func(key string, value V) bool) {
+	t.WalkPrefix("", f)
+}
+
+// All returns an iterator over the stored keys and values, for use with
+// range-over-func loops.
+func (t *Trie[V]) All() iter.Seq2[string, V] {
+	return t.Walk
+}
+
+// Keys returns the stored keys in the order of Walk.
+func (t *Trie[V]) Keys() []string {
+	keys := make([]string, 0, t.Len())
+	t.Walk(func(key string, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+// Len returns the number of stored keys.
+func (t *Trie[V]) Len() int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.size
+}
+
+// IsEmpty reports whether the trie holds no keys.
+func (t *Trie[V]) IsEmpty() bool {
+	return t.Len() == 0
+}
+
+// Clear removes all keys.
+func (t *Trie[V]) Clear() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.root = &node[V]{}
+	t.size = 0
+}
+
+// String returns the stored keys and values, formatted like a Go map.
+func (t *Trie[V]) String() string {
+	var b strings.Builder
+	b.WriteString("map[")
+	first := true
+	t.Walk(func(key string, value V) bool {
+		if !first {
+			b.WriteByte(' ')
+		}
+		first = false
+		fmt.Fprintf(&b, "%s:%v", key, value)
+		return true
+	})
+	b.WriteByte(']')
+	return b.String()
+}
+
+// split returns the segments of `key`.
+func (t *Trie[V]) split(key string) []string {
+	key = strings.Trim(key, t.separator)
+	if key == "" {
+		return nil
+	}
+	return strings.Split(key, t.separator)
+}
+
+// lookup returns the node reached by following `segs` literally, or nil.
+func (t *Trie[V]) lookup(segs []string) *node[V] {
+	n := t.root
+	for _, seg := range segs {
+		if n = n.children[seg]; n == nil {
+			return nil
+		}
+	}
+	return n
+}
+
+// match returns the node storing the best key matching `segs` below `n`, or nil.
+func match[V any](n *node[V], segs []string) *node[V] {
+	if len(segs) == 0 {
+		if n.hasValue {
+			return n
+		}
+		if c := n.children[CatchAll]; c != nil && c.hasValue {
+			return c
+		}
+		return nil
+	}
+	if c := n.children[segs[0]]; c != nil {
+		if m := match(c, segs[1:]); m != nil {
+			return m
+		}
+	}
+	if c := n.children[Wildcard]; c != nil {
+		if m := match(c, segs[1:]); m != nil {
+			return m
+		}
+	}
+	if c := n.children[CatchAll]; c != nil && c.hasValue {
+		return c
+	}
+	return nil
+}
+
+// walk visits the keys stored at and below `n` and reports whether to continue.
+func walk[V any](n *node[V], f func(key string, value V) bool) bool {
+	if n.hasValue && !f(n.key, n.value) {
+		return false
+	}
+	segs := make([]string, 0, len(n.children))
+	for seg := range n.children {
+		segs = append(segs, seg)
+	}
+	slices.Sort(segs)
+	for _, seg := range segs {
+		if !walk(n.children[seg], f) {
+			return false
+		}
+	}
+	return true
+}
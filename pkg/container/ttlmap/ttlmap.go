@@ -0,0 +1,323 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package ttlmap provides a concurrent map whose entries expire.
+//
+// Each entry has its own time-to-live. Expired entries are removed lazily when
+// they are looked up and, optionally, by a background purger, and an expiry
+// callback is invoked for each of them. With sliding expiry, every read extends
+// the entry's lifetime, which suits session and nonce storage.
+package ttlmap
+
+import (
+	"sync"
+	"time"
+
+	"github.com/focela/aegis/pkg/clock"
+	"github.com/focela/aegis/pkg/timeutil"
+)
+
+// purgeJitter spreads the purges of maps created together, as a fraction of
+// the purge interval.
+const purgeJitter = 0.1
+
+// entry is a stored value with its expiry.
+type entry[V any] struct {
+	value    V
+	ttl      time.Duration
+	expireAt time.Time
+}
+
+// expired reports whether the entry is expired at `now`.
+func (e *entry[V]) expired(now time.Time) bool {
+	return !e.expireAt.IsZero() && !now.Before(e.expireAt)
+}
+
+// Option configures a Map.
+type Option[K comparable, V any] func(*Map[K, V])
+
+// WithTTL sets the default time-to-live of entries. Zero, the default, means
+// no expiry.
+func WithTTL[K comparable, V any](ttl time.Duration) Option[K, V] {
+	return func(m *Map[K, V]) {
+		m.ttl = ttl
+	}
+}
+
+// WithOnExpire sets a callback invoked for each entry removed because it
+// expired. It runs outside the map lock and may safely call back into the map.
+func WithOnExpire[K comparable, V any](f func(key K, value V)) Option[K, V] {
+	return func(m *Map[K, V]) {
+		m.onExpire = f
+	}
+}
+
+// WithSlidingExpiry makes every successful Get restart the entry's TTL.
+func WithSlidingExpiry[K comparable, V any]() Option[K, V] {
+	return func(m *Map[K, V]) {
+		m.sliding = true
+	}
+}
+
+// WithPurgeInterval starts a background goroutine removing expired entries
+// about every `interval`, give or take 10% so that maps created together do
+// not purge in lockstep. Close stops it.
+func WithPurgeInterval[K comparable, V any](interval time.Duration) Option[K, V] {
+	return func(m *Map[K, V]) {
+		m.purgeInterval = interval
+	}
+}
+
+// WithClock sets the clock expiry is measured on, clock.Real() by default.
+func WithClock[K comparable, V any](clk clock.Clock) Option[K, V] {
+	return func(m *Map[K, V]) {
+		if clk != nil {
+			m.clock = clk
+		}
+	}
+}
+
+// Map is a concurrent-safe map with per-entry expiry.
+type Map[K comparable, V any] struct {
+	// mu guards data.
+	mu sync.Mutex
+
+	// data holds the entries.
+	data map[K]*entry[V]
+
+	// ttl is the default time-to-live, zero for none.
+	ttl time.Duration
+
+	// sliding restarts the TTL of entries on access.
+	sliding bool
+
+	// onExpire is called for expired entries.
+	onExpire func(key K, value V)
+
+	// purgeInterval is the period of background purging, zero for none.
+	purgeInterval time.Duration
+
+	// done stops the background purger.
+	done chan struct{}
+
+	// closeOnce guards closing done.
+	closeOnce sync.Once
+
+	// clock tells the time.
+	clock clock.Clock
+}
+
+// expired is an entry removed under the lock whose callback is still pending.
+type expired[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// New creates and returns an empty Map. When a purge interval is configured,
+// Close must be called to stop the background purger.
+func New[K comparable, V any](opts ...Option[K, V]) *Map[K, V] {
+	m := &Map[K, V]{
+		data:  make(map[K]*entry[V]),
+		done:  make(chan struct{}),
+		clock: clock.Real(),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	if m.purgeInterval > 0 {
+		go m.purgeLoop()
+	}
+	return m
+}
+
+// Set stores `value` under `key` with the default TTL.
+func (m *Map[K, V]) Set(key K, value V) {
+	m.SetWithTTL(key, value, m.ttl)
+}
+
+// SetWithTTL stores `value` under `key`, expiring after `ttl`. Zero means no
+// expiry.
+func (m *Map[K, V]) SetWithTTL(key K, value V, ttl time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.data[key] = &entry[V]{value: value, ttl: ttl, expireAt: m.expireAt(ttl)}
+}
+
+// SetIfAbsent stores `value` under `key` with the default TTL only if no live
+// entry exists. It returns true if the value was stored.
+func (m *Map[K, V]) SetIfAbsent(key K, value V) bool {
+	m.mu.Lock()
+	var pending []expired[K, V]
+	if e, ok := m.data[key]; ok {
+		if !e.expired(m.clock.Now()) {
+			m.mu.Unlock()
+			return false
+		}
+		pending = append(pending, expired[K, V]{key, e.value})
+	}
+	m.data[key] = &entry[V]{value: value, ttl: m.ttl, expireAt: m.expireAt(m.ttl)}
+	m.mu.Unlock()
+	m.notify(pending)
+	return true
+}
+
+// Get returns the value stored under `key`. An expired entry is removed and
+// reported as absent. With sliding expiry, a hit restarts the entry's TTL.
+func (m *Map[K, V]) Get(key K) (value V, found bool) {
+	m.mu.Lock()
+	e, ok := m.data[key]
+	if !ok {
+		m.mu.Unlock()
+		return
+	}
+	now := m.clock.Now()
+	if e.expired(now) {
+		delete(m.data, key)
+		m.mu.Unlock()
+		m.notify([]expired[K, V]{{key, e.value}})
+		return
+	}
+	if m.sliding && e.ttl > 0 {
+		e.expireAt = now.Add(e.ttl)
+	}
+	value = e.value
+	m.mu.Unlock()
+	return value, true
+}
+
+// TTL returns the time left before `key` expires. A live entry without expiry
+// reports zero.
+func (m *Map[K, V]) TTL(key K) (ttl time.Duration, found bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e, ok := m.data[key]
+	now := m.clock.Now()
+	if !ok || e.expired(now) {
+		return 0, false
+	}
+	if e.expireAt.IsZero() {
+		return 0, true
+	}
+	return e.expireAt.Sub(now), true
+}
+
+// Extend gives the live entry of `key` a new TTL, counted from now. It reports
+// false if the key is absent or expired.
+func (m *Map[K, V]) Extend(key K, ttl time.Duration) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e, ok := m.data[key]
+	if !ok || e.expired(m.clock.Now()) {
+		return false
+	}
+	e.ttl, e.expireAt = ttl, m.expireAt(ttl)
+	return true
+}
+
+// Contains reports whether a live entry exists for `key`, without extending it.
+func (m *Map[K, V]) Contains(key K) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e, ok := m.data[key]
+	return ok && !e.expired(m.clock.Now())
+}
+
+// Remove deletes `key` and returns its value if it was live. The expiry
+// callback is not invoked.
+func (m *Map[K, V]) Remove(key K) (value V, found bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e, ok := m.data[key]
+	if !ok {
+		return
+	}
+	delete(m.data, key)
+	if e.expired(m.clock.Now()) {
+		return
+	}
+	return e.value, true
+}
+
+// Len returns the number of entries, including expired ones not yet purged.
+func (m *Map[K, V]) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.data)
+}
+
+// Keys returns the keys of the live entries in unspecified order.
+func (m *Map[K, V]) Keys() []K {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := m.clock.Now()
+	keys := make([]K, 0, len(m.data))
+	for k, e := range m.data {
+		if !e.expired(now) {
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
+
+// Purge removes all expired entries and returns how many were removed.
+func (m *Map[K, V]) Purge() int {
+	m.mu.Lock()
+	now := m.clock.Now()
+	var pending []expired[K, V]
+	for k, e := range m.data {
+		if e.expired(now) {
+			delete(m.data, k)
+			pending = append(pending, expired[K, V]{k, e.value})
+		}
+	}
+	m.mu.Unlock()
+	m.notify(pending)
+	return len(pending)
+}
+
+// Clear removes all entries without invoking the expiry callback.
+func (m *Map[K, V]) Clear() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.data = make(map[K]*entry[V])
+}
+
+// Close stops the background purger, if any. The map remains usable.
+func (m *Map[K, V]) Close() {
+	m.closeOnce.Do(func() {
+		close(m.done)
+	})
+}
+
+// expireAt returns the expiry of an entry stored now with `ttl`.
+func (m *Map[K, V]) expireAt(ttl time.Duration) time.Time {
+	if ttl <= 0 {
+		return time.Time{}
+	}
+	return m.clock.Now().Add(ttl)
+}
+
+// notify invokes the expiry callback for `pending`, outside the lock.
+func (m *Map[K, V]) notify(pending []expired[K, V]) {
+	if m.onExpire == nil {
+		return
+	}
+	for _, p := range pending {
+		m.onExpire(p.key, p.value)
+	}
+}
+
+// purgeLoop purges expired entries periodically until Close is called.
+func (m *Map[K, V]) purgeLoop() {
+	ticker := timeutil.NewTTLTicker(m.purgeInterval, purgeJitter, timeutil.WithTickerClock(m.clock))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C():
+			m.Purge()
+		case <-m.done:
+			return
+		}
+	}
+}
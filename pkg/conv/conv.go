@@ -0,0 +1,235 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package conv provides lenient conversions between Go types.
+//
+// The concrete functions (Int, String, Bool, Time, ...) never fail: values that
+// cannot be converted yield the zero value of the target type. This makes them
+// convenient for loosely typed input such as configuration, query parameters
+// and decoded JSON.
+package conv
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/focela/aegis/internal/utils/empty"
+)
+
+// Boolean string literals recognized by Bool, compared case-insensitively.
+var (
+	// falseStrings are treated as false; any other non-empty string is true.
+	falseStrings = map[string]struct{}{
+		"":      {},
+		"0":     {},
+		"f":     {},
+		"n":     {},
+		"no":    {},
+		"off":   {},
+		"false": {},
+	}
+)
+
+// String converts `value` to a string.
+// Numbers are formatted in their shortest representation, time.Time values use
+// RFC3339 with nanoseconds, and maps, slices and structs are encoded as JSON.
+func String(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+
+	switch v := value.(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	case []rune:
+		return string(v)
+	case int:
+		return strconv.Itoa(v)
+	case int8:
+		return strconv.FormatInt(int64(v), 10)
+	case int16:
+		return strconv.FormatInt(int64(v), 10)
+	case int32:
+		return strconv.FormatInt(int64(v), 10)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case uint:
+		return strconv.FormatUint(uint64(v), 10)
+	case uint8:
+		return strconv.FormatUint(uint64(v), 10)
+	case uint16:
+		return strconv.FormatUint(uint64(v), 10)
+	case uint32:
+		return strconv.FormatUint(uint64(v), 10)
+	case uint64:
+		return strconv.FormatUint(v, 10)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case time.Time:
+		if v.IsZero() {
+			return ""
+		}
+		return v.Format(time.RFC3339Nano)
+	case *time.Time:
+		if v == nil || v.IsZero() {
+			return ""
+		}
+		return v.Format(time.RFC3339Nano)
+	case time.Duration:
+		return v.String()
+	case error:
+		return v.Error()
+	case fmt.Stringer:
+		if empty.IsNil(v) {
+			return ""
+		}
+		return v.String()
+	}
+
+	// Fall back to reflection for named types, pointers and composite values
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return ""
+		}
+		return String(rv.Elem().Interface())
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(rv.Uint(), 10)
+
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'f', -1, 64)
+
+	case reflect.Bool:
+		return strconv.FormatBool(rv.Bool())
+
+	case reflect.String:
+		return rv.String()
+
+	case reflect.Map, reflect.Slice, reflect.Array, reflect.Struct:
+		if b, err := json.Marshal(value); err == nil {
+			return string(b)
+		}
+	}
+
+	return fmt.Sprintf("%v", value)
+}
+
+// Bytes converts `value` to a byte slice.
+// Strings are converted directly; other values are converted with String first.
+func Bytes(value interface{}) []byte {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []byte:
+		return v
+	case string:
+		return []byte(v)
+	default:
+		return []byte(String(value))
+	}
+}
+
+// Bool converts `value` to a bool.
+// Strings such as "", "0", "false", "off", "no" are false, as are numeric zeros
+// and empty slices or maps. Anything else is true.
+func Bool(value interface{}) bool {
+	b, _ := doBool(value)
+	return b
+}
+
+// doBool implements Bool. Every value has a boolean interpretation, so it never
+// fails; the error result keeps its shape in line with the other converters.
+func doBool(value interface{}) (bool, error) {
+	switch v := value.(type) {
+	case nil:
+		return false, nil
+	case bool:
+		return v, nil
+	case *bool:
+		return v != nil && *v, nil
+	case string:
+		_, ok := falseStrings[strings.ToLower(strings.TrimSpace(v))]
+		return !ok, nil
+	case []byte:
+		_, ok := falseStrings[strings.ToLower(strings.TrimSpace(string(v)))]
+		return !ok, nil
+	}
+
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return false, nil
+		}
+		return doBool(rv.Elem().Interface())
+
+	case reflect.String:
+		return doBool(rv.String())
+
+	default:
+		return !empty.IsEmpty(value), nil
+	}
+}
+
+// normalize dereferences pointers and unwraps named basic types to their
+// underlying builtin type, so the converters only need to handle builtin kinds.
+// It reports whether anything was unwrapped; nil pointers normalize to nil.
+func normalize(value interface{}) (interface{}, bool) {
+	rv := reflect.ValueOf(value)
+	derefed := false
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return nil, true
+		}
+		rv = rv.Elem()
+		derefed = true
+	}
+
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), true
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return rv.Uint(), true
+
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+
+	case reflect.Bool:
+		return rv.Bool(), true
+
+	case reflect.String:
+		return rv.String(), true
+
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return rv.Bytes(), true
+		}
+	}
+
+	if !rv.IsValid() {
+		return nil, derefed
+	}
+	return rv.Interface(), derefed
+}
+
+// unsupportedError reports that `value` cannot be converted to `target`.
+func unsupportedError(value interface{}, target string) error {
+	return fmt.Errorf("conv: cannot convert %T to %s", value, target)
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package conv
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Float32 converts `value` to a float32.
+func Float32(value interface{}) float32 {
+	return float32(Float64(value))
+}
+
+// Float64 converts `value` to a float64.
+func Float64(value interface{}) float64 {
+	f, _ := doFloat64(value)
+	return f
+}
+
+// doFloat64 implements Float64 and reports values that cannot be converted.
+func doFloat64(value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case nil:
+		return 0, nil
+	case float32:
+		return float64(v), nil
+	case float64:
+		return v, nil
+	case string:
+		return parseFloat64(v)
+	case []byte:
+		return parseFloat64(string(v))
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, bool:
+		i, err := doInt64(v)
+		if u, ok := v.(uint64); ok {
+			return float64(u), nil
+		}
+		return float64(i), err
+	}
+
+	// Retry once with named types and pointers unwrapped
+	if n, ok := normalize(value); ok {
+		return doFloat64(n)
+	}
+	return 0, unsupportedError(value, "float64")
+}
+
+// parseFloat64 parses `s` as a floating point number.
+func parseFloat64(s string) (float64, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("conv: invalid float %q: %w", s, err)
+	}
+	return f, nil
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package conv
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Int converts `value` to an int.
+func Int(value interface{}) int {
+	return int(Int64(value))
+}
+
+// Int8 converts `value` to an int8.
+func Int8(value interface{}) int8 {
+	return int8(Int64(value))
+}
+
+// Int16 converts `value` to an int16.
+func Int16(value interface{}) int16 {
+	return int16(Int64(value))
+}
+
+// Int32 converts `value` to an int32.
+func Int32(value interface{}) int32 {
+	return int32(Int64(value))
+}
+
+// Int64 converts `value` to an int64.
+// Strings are parsed as decimal, or as hexadecimal when prefixed with "0x";
+// strings holding a float are truncated. Booleans convert to 1 or 0.
+func Int64(value interface{}) int64 {
+	i, _ := doInt64(value)
+	return i
+}
+
+// doInt64 implements Int64 and reports values that cannot be converted.
+func doInt64(value interface{}) (int64, error) {
+	switch v := value.(type) {
+	case nil:
+		return 0, nil
+	case int:
+		return int64(v), nil
+	case int8:
+		return int64(v), nil
+	case int16:
+		return int64(v), nil
+	case int32:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	case uint:
+		return int64(v), nil
+	case uint8:
+		return int64(v), nil
+	case uint16:
+		return int64(v), nil
+	case uint32:
+		return int64(v), nil
+	case uint64:
+		return int64(v), nil
+	case float32:
+		return int64(v), nil
+	case float64:
+		return int64(v), nil
+	case bool:
+		if v {
+			return 1, nil
+		}
+		return 0, nil
+	case string:
+		return parseInt64(v)
+	case []byte:
+		return parseInt64(string(v))
+	case time.Duration:
+		return int64(v), nil
+	case time.Time:
+		return v.Unix(), nil
+	}
+
+	// Retry once with named types and pointers unwrapped
+	if n, ok := normalize(value); ok {
+		return doInt64(n)
+	}
+	return 0, unsupportedError(value, "int64")
+}
+
+// parseInt64 parses `s` as a decimal or "0x"-prefixed hexadecimal integer,
+// falling back to float parsing with truncation.
+func parseInt64(s string) (int64, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, nil
+	}
+
+	// Hexadecimal with optional sign
+	negative := false
+	digits := s
+	if digits[0] == '-' || digits[0] == '+' {
+		negative = digits[0] == '-'
+		digits = digits[1:]
+	}
+	if len(digits) > 2 && digits[0] == '0' && (digits[1] == 'x' || digits[1] == 'X') {
+		u, err := strconv.ParseUint(digits[2:], 16, 64)
+		if err != nil {
+			return 0, fmt.Errorf("conv: invalid integer %q: %w", s, err)
+		}
+		if negative {
+			return -int64(u), nil
+		}
+		return int64(u), nil
+	}
+
+	// Decimal, then float truncation
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return i, nil
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return int64(f), nil
+	}
+	return 0, fmt.Errorf("conv: invalid integer %q", s)
+}
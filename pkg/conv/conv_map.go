@@ -0,0 +1,96 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package conv
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+
+	"github.com/focela/aegis/internal/utils/empty"
+)
+
+// Map converts `value` to a map[string]interface{}.
+//
+// Maps have their keys converted with String, structs are converted field by
+// field using the names from StructTagPriority, and strings holding a JSON
+// object are decoded. Nested values are kept as they are. Values that cannot be
+// converted yield nil.
+func Map(value interface{}) map[string]interface{} {
+	m, _ := doMap(value)
+	return m
+}
+
+// doMap implements Map and reports values that cannot be converted.
+func doMap(value interface{}) (map[string]interface{}, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case map[string]interface{}:
+		return v, nil
+	case string:
+		return decodeJSONObject([]byte(v))
+	case []byte:
+		return decodeJSONObject(v)
+	}
+
+	rv := reflect.ValueOf(value)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return nil, nil
+		}
+		rv = rv.Elem()
+	}
+
+	switch rv.Kind() {
+	case reflect.Map:
+		if rv.IsNil() {
+			return nil, nil
+		}
+		out := make(map[string]interface{}, rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			out[String(iter.Key().Interface())] = iter.Value().Interface()
+		}
+		return out, nil
+
+	case reflect.Struct:
+		fields := cachedStructFields(rv.Type())
+		out := make(map[string]interface{}, len(fields))
+		for _, field := range fields {
+			fieldValue := rv.FieldByIndex(field.index)
+			if field.omitEmpty && empty.IsEmpty(fieldValue) {
+				continue
+			}
+			out[field.name] = fieldValue.Interface()
+		}
+		return out, nil
+
+	case reflect.String:
+		return decodeJSONObject([]byte(rv.String()))
+
+	default:
+		return nil, unsupportedError(value, "map")
+	}
+}
+
+// decodeJSONObject decodes `data` when it holds a JSON object.
+// Numbers are kept as json.Number so that large integers don't lose precision.
+func decodeJSONObject(data []byte) (map[string]interface{}, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return nil, nil
+	}
+	if data[0] != '{' {
+		return nil, unsupportedError(string(data), "map")
+	}
+	var m map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
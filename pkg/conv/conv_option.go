@@ -0,0 +1,87 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package conv
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Options configures how conversions interpret their input.
+// Options can be set globally with SetDefaultOptions or passed per call to the
+// converters that accept them; per-call options are applied on top of the
+// global defaults.
+type Options struct {
+	// Location is used to interpret timestamps that carry no zone information,
+	// and is the location of times built from unix timestamps.
+	// Defaults to time.Local.
+	Location *time.Location
+
+	// Layouts are tried, in order, before the built-in layouts when parsing
+	// time strings.
+	Layouts []string
+}
+
+// Option modifies an Options value.
+type Option func(*Options)
+
+// defaultOptions holds the process-wide options shared by all conversions.
+// It is replaced atomically so that readers never observe a partial update.
+var defaultOptions atomic.Pointer[Options]
+
+func init() {
+	defaultOptions.Store(&Options{Location: time.Local})
+}
+
+// WithLocation sets the location used for zone-less timestamps.
+// A nil location is ignored.
+func WithLocation(loc *time.Location) Option {
+	return func(o *Options) {
+		if loc != nil {
+			o.Location = loc
+		}
+	}
+}
+
+// WithLayouts prepends custom layouts to the ones tried when parsing time strings.
+func WithLayouts(layouts ...string) Option {
+	return func(o *Options) {
+		o.Layouts = append(append([]string(nil), layouts...), o.Layouts...)
+	}
+}
+
+// SetDefaultOptions replaces the global options with the defaults modified by `opts`.
+// It is typically called once during application start, so that all services
+// parse zone-less timestamps against the same location.
+func SetDefaultOptions(opts ...Option) {
+	o := &Options{Location: time.Local}
+	for _, opt := range opts {
+		opt(o)
+	}
+	defaultOptions.Store(o)
+}
+
+// DefaultOptions returns a copy of the current global options.
+func DefaultOptions() Options {
+	o := *defaultOptions.Load()
+	o.Layouts = append([]string(nil), o.Layouts...)
+	return o
+}
+
+// buildOptions returns the global options with `opts` applied on top.
+func buildOptions(opts []Option) *Options {
+	base := defaultOptions.Load()
+	if len(opts) == 0 {
+		return base
+	}
+	o := &Options{
+		Location: base.Location,
+		Layouts:  append([]string(nil), base.Layouts...),
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package conv
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+)
+
+// Interfaces converts `value` to a []interface{}.
+// Slices and arrays are converted element-wise, strings holding a JSON array are
+// decoded, and any other non-nil value becomes a single-element slice.
+func Interfaces(value interface{}) []interface{} {
+	return doInterfaces(value)
+}
+
+// Strings converts `value` to a []string, converting each element with String.
+func Strings(value interface{}) []string {
+	if v, ok := value.([]string); ok {
+		return v
+	}
+	items := doInterfaces(value)
+	if items == nil {
+		return nil
+	}
+	out := make([]string, len(items))
+	for i, item := range items {
+		out[i] = String(item)
+	}
+	return out
+}
+
+// Ints converts `value` to a []int, converting each element with Int.
+func Ints(value interface{}) []int {
+	if v, ok := value.([]int); ok {
+		return v
+	}
+	items := doInterfaces(value)
+	if items == nil {
+		return nil
+	}
+	out := make([]int, len(items))
+	for i, item := range items {
+		out[i] = Int(item)
+	}
+	return out
+}
+
+// Int64s converts `value` to a []int64, converting each element with Int64.
+func Int64s(value interface{}) []int64 {
+	if v, ok := value.([]int64); ok {
+		return v
+	}
+	items := doInterfaces(value)
+	if items == nil {
+		return nil
+	}
+	out := make([]int64, len(items))
+	for i, item := range items {
+		out[i] = Int64(item)
+	}
+	return out
+}
+
+// Float64s converts `value` to a []float64, converting each element with Float64.
+func Float64s(value interface{}) []float64 {
+	if v, ok := value.([]float64); ok {
+		return v
+	}
+	items := doInterfaces(value)
+	if items == nil {
+		return nil
+	}
+	out := make([]float64, len(items))
+	for i, item := range items {
+		out[i] = Float64(item)
+	}
+	return out
+}
+
+// doInterfaces implements Interfaces.
+func doInterfaces(value interface{}) []interface{} {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		return v
+	case string:
+		if items, ok := decodeJSONArray([]byte(v)); ok {
+			return items
+		}
+		return []interface{}{v}
+	case []byte:
+		if items, ok := decodeJSONArray(v); ok {
+			return items
+		}
+		return []interface{}{v}
+	}
+
+	rv := reflect.ValueOf(value)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		if rv.Kind() == reflect.Slice && rv.IsNil() {
+			return nil
+		}
+		out := make([]interface{}, rv.Len())
+		for i := range out {
+			out[i] = rv.Index(i).Interface()
+		}
+		return out
+
+	default:
+		return []interface{}{rv.Interface()}
+	}
+}
+
+// decodeJSONArray decodes `data` when it holds a JSON array.
+func decodeJSONArray(data []byte) ([]interface{}, bool) {
+	data = bytes.TrimSpace(data)
+	if len(data) < 2 || data[0] != '[' || data[len(data)-1] != ']' {
+		return nil, false
+	}
+	var items []interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&items); err != nil {
+		return nil, false
+	}
+	return items, true
+}
@@ -0,0 +1,189 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package conv
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// StructTagPriority lists the struct tags consulted, in order, for the external
+// name of a field. The first tag present on a field wins; fields without any of
+// them use their Go name. A tag value of "-" excludes the field.
+// Field metadata is cached per type, so changes must happen before the first
+// conversion.
+var StructTagPriority = []string{"conv", "json"}
+
+// structField describes an exported field reachable from a struct type,
+// including fields promoted from embedded structs.
+type structField struct {
+	// index is the index sequence for reflect.Value.FieldByIndex.
+	index []int
+
+	// name is the external name from the struct tag, or the Go field name.
+	name string
+
+	// goName is the Go field name.
+	goName string
+
+	// omitEmpty reports whether the tag requested omitting empty values.
+	omitEmpty bool
+}
+
+// structFieldCache caches []structField by reflect.Type.
+var structFieldCache sync.Map
+
+// Struct converts `params` into the struct pointed to by `pointer`.
+//
+// `params` may be a map, a struct, or a string holding a JSON object. Keys are
+// matched to fields by their tag or Go name first, then case-insensitively with
+// '_', '-' and spaces ignored, so "user_name" fills a field named UserName.
+// Field values are converted recursively, and conversion stops at the first
+// field that fails.
+func Struct(params interface{}, pointer interface{}, opts ...Option) error {
+	rv := reflect.ValueOf(pointer)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("conv: destination must be a non-nil pointer, got %T", pointer)
+	}
+
+	// Allocate intermediate pointers such as **T
+	elem := rv.Elem()
+	for elem.Kind() == reflect.Ptr {
+		if elem.IsNil() {
+			elem.Set(reflect.New(elem.Type().Elem()))
+		}
+		elem = elem.Elem()
+	}
+	if elem.Kind() != reflect.Struct {
+		return fmt.Errorf("conv: destination must point to a struct, got %T", pointer)
+	}
+
+	return doStruct(params, elem, buildOptions(opts))
+}
+
+// doStruct fills the addressable struct value `dst` from `params`.
+func doStruct(params interface{}, dst reflect.Value, o *Options) error {
+	m, err := doMap(params)
+	if err != nil {
+		return err
+	}
+	if len(m) == 0 {
+		return nil
+	}
+
+	var normalizedKeys map[string]string
+	for _, field := range cachedStructFields(dst.Type()) {
+		value, ok := m[field.name]
+		if !ok && field.goName != field.name {
+			value, ok = m[field.goName]
+		}
+		if !ok {
+			// Build the fuzzy index only when an exact lookup misses
+			if normalizedKeys == nil {
+				normalizedKeys = make(map[string]string, len(m))
+				for key := range m {
+					normalizedKeys[normalizeKey(key)] = key
+				}
+			}
+			var key string
+			if key, ok = normalizedKeys[normalizeKey(field.name)]; ok {
+				value = m[key]
+			}
+		}
+		if !ok {
+			continue
+		}
+
+		fieldValue := dst.FieldByIndex(field.index)
+		converted, err := convertValue(value, fieldValue.Type(), o)
+		if err != nil {
+			return fmt.Errorf("conv: field %q: %w", field.name, err)
+		}
+		fieldValue.Set(converted)
+	}
+	return nil
+}
+
+// cachedStructFields returns the fields of struct type `t`, computing and
+// caching them on first use.
+func cachedStructFields(t reflect.Type) []structField {
+	if v, ok := structFieldCache.Load(t); ok {
+		return v.([]structField)
+	}
+	fields := collectStructFields(t, nil)
+	structFieldCache.Store(t, fields)
+	return fields
+}
+
+// collectStructFields walks `t`, flattening untagged embedded structs.
+func collectStructFields(t reflect.Type, parentIndex []int) []structField {
+	var fields []structField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		index := append(append([]int(nil), parentIndex...), i)
+
+		name, omitEmpty := lookupFieldTag(f)
+		if name == "-" {
+			continue
+		}
+
+		// Promote fields of embedded structs unless the embedding is named by a tag
+		if f.Anonymous && name == "" && f.Type.Kind() == reflect.Struct {
+			fields = append(fields, collectStructFields(f.Type, index)...)
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields = append(fields, structField{
+			index:     index,
+			name:      name,
+			goName:    f.Name,
+			omitEmpty: omitEmpty,
+		})
+	}
+	return fields
+}
+
+// lookupFieldTag returns the name and omitempty option from the first tag in
+// StructTagPriority present on `f`.
+func lookupFieldTag(f reflect.StructField) (name string, omitEmpty bool) {
+	for _, tagName := range StructTagPriority {
+		tag, ok := f.Tag.Lookup(tagName)
+		if !ok {
+			continue
+		}
+		parts := strings.Split(tag, ",")
+		for _, option := range parts[1:] {
+			if option == "omitempty" {
+				omitEmpty = true
+			}
+		}
+		return parts[0], omitEmpty
+	}
+	return "", false
+}
+
+// normalizeKey lowercases `key` and strips separators for fuzzy field matching.
+func normalizeKey(key string) string {
+	var b strings.Builder
+	b.Grow(len(key))
+	for _, r := range key {
+		switch r {
+		case '_', '-', ' ', '.':
+			continue
+		}
+		if 'A' <= r && r <= 'Z' {
+			r += 'a' - 'A'
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
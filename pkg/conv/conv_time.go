@@ -0,0 +1,228 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package conv
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// builtinTimeLayouts are tried, in order, after any custom layouts when parsing
+// time strings. Layouts with zone information keep the parsed zone; the others
+// are interpreted in the configured location.
+var builtinTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999 -0700 MST",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04",
+	"2006-01-02",
+	"2006/01/02 15:04:05.999999999",
+	"2006/01/02 15:04",
+	"2006/01/02",
+	"2006.01.02 15:04:05.999999999",
+	"2006.01.02",
+	"20060102150405",
+	"20060102",
+	time.RFC1123Z,
+	time.RFC1123,
+	time.RFC850,
+	time.RFC822Z,
+	time.RFC822,
+	time.RubyDate,
+	time.UnixDate,
+	time.ANSIC,
+}
+
+// Thresholds used to guess the unit of a unix timestamp from its magnitude.
+// Seconds stay below 1e11 until the year 5138, so anything larger is assumed
+// to be in a finer unit.
+const (
+	unixMilliThreshold = 1e11
+	unixMicroThreshold = 1e14
+	unixNanoThreshold  = 1e17
+)
+
+// Time converts `value` to a time.Time.
+//
+// Strings are parsed against the custom layouts from the options followed by
+// the built-in layouts; strings without zone information are interpreted in the
+// configured location. Numbers and numeric strings are treated as unix
+// timestamps whose unit (s, ms, µs, ns) is inferred from their magnitude,
+// keeping the fraction of floats.
+// Values that cannot be converted yield the zero time.
+func Time(value interface{}, opts ...Option) time.Time {
+	t, _ := doTime(value, buildOptions(opts))
+	return t
+}
+
+// Duration converts `value` to a time.Duration.
+// Strings are parsed with time.ParseDuration, while numbers and numeric strings
+// are taken as nanoseconds.
+func Duration(value interface{}) time.Duration {
+	d, _ := doDuration(value)
+	return d
+}
+
+// doTime implements Time and reports values that cannot be converted.
+func doTime(value interface{}, o *Options) (time.Time, error) {
+	switch v := value.(type) {
+	case nil:
+		return time.Time{}, nil
+	case time.Time:
+		return v, nil
+	case *time.Time:
+		if v == nil {
+			return time.Time{}, nil
+		}
+		return *v, nil
+	case string:
+		return parseTime(v, o)
+	case []byte:
+		return parseTime(string(v), o)
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		i, _ := doInt64(v)
+		return unixTime(i, o), nil
+	case float32, float64:
+		f, _ := doFloat64(v)
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return time.Time{}, fmt.Errorf("conv: invalid unix timestamp %v", f)
+		}
+		whole, frac := math.Modf(f)
+		i := int64(whole)
+		return unixTime(i, o).Add(time.Duration(frac * float64(unixUnit(i)))), nil
+	}
+
+	// Retry once with named types and pointers unwrapped
+	if n, ok := normalize(value); ok {
+		return doTime(n, o)
+	}
+	return time.Time{}, unsupportedError(value, "time.Time")
+}
+
+// parseTime parses `s` using the layouts in `o` and the built-in layouts.
+func parseTime(s string, o *Options) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}, nil
+	}
+
+	// Custom layouts always win, even over numeric detection
+	for _, layout := range o.Layouts {
+		if t, err := time.ParseInLocation(layout, s, o.Location); err == nil {
+			return t, nil
+		}
+	}
+
+	// Compact date forms are all digits, so they must be tried before
+	// treating the string as a unix timestamp
+	if isDigits(s) && len(s) != 8 && len(s) != 14 {
+		if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return unixTime(i, o), nil
+		}
+	}
+
+	for _, layout := range builtinTimeLayouts {
+		if t, err := time.ParseInLocation(layout, s, o.Location); err == nil {
+			return t, nil
+		}
+	}
+
+	// Remaining numeric strings, including signed and fractional timestamps
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return doTime(f, o)
+	}
+	return time.Time{}, fmt.Errorf("conv: unrecognized time format %q", s)
+}
+
+// unixTime builds a time from a unix timestamp whose unit is inferred from
+// its magnitude, in the configured location.
+func unixTime(i int64, o *Options) time.Time {
+	var t time.Time
+	switch unixUnit(i) {
+	case time.Nanosecond:
+		t = time.Unix(0, i)
+	case time.Microsecond:
+		t = time.UnixMicro(i)
+	case time.Millisecond:
+		t = time.UnixMilli(i)
+	default:
+		t = time.Unix(i, 0)
+	}
+	return t.In(o.Location)
+}
+
+// unixUnit returns the unit of the unix timestamp `i`, inferred from its
+// magnitude.
+func unixUnit(i int64) time.Duration {
+	abs := i
+	if abs < 0 {
+		abs = -abs
+	}
+	switch {
+	case abs >= unixNanoThreshold:
+		return time.Nanosecond
+	case abs >= unixMicroThreshold:
+		return time.Microsecond
+	case abs >= unixMilliThreshold:
+		return time.Millisecond
+	default:
+		return time.Second
+	}
+}
+
+// doDuration implements Duration and reports values that cannot be converted.
+func doDuration(value interface{}) (time.Duration, error) {
+	switch v := value.(type) {
+	case nil:
+		return 0, nil
+	case time.Duration:
+		return v, nil
+	case string:
+		return parseDuration(v)
+	case []byte:
+		return parseDuration(string(v))
+	}
+
+	// Named string types are parsed as durations, everything else as nanoseconds
+	if n, ok := normalize(value); ok {
+		if s, isString := n.(string); isString {
+			return parseDuration(s)
+		}
+	}
+	i, err := doInt64(value)
+	return time.Duration(i), err
+}
+
+// parseDuration parses a duration string such as "1h30m", or a plain number
+// of nanoseconds.
+func parseDuration(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, nil
+	}
+	if d, err := time.ParseDuration(s); err == nil {
+		return d, nil
+	}
+	i, err := parseInt64(s)
+	if err != nil {
+		return 0, fmt.Errorf("conv: invalid duration %q", s)
+	}
+	return time.Duration(i), nil
+}
+
+// isDigits reports whether `s` consists solely of ASCII digits.
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
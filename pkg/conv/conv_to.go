@@ -0,0 +1,293 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package conv
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Reflected types that get dedicated conversion rules.
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+)
+
+// To converts `value` to type T.
+//
+// It dispatches on T to the same rules as the concrete converters: scalars use
+// Int64/Float64/Bool/String semantics, time.Time and time.Duration use Time and
+// Duration, slices and arrays are converted element-wise, maps key- and
+// value-wise, and structs through Struct. Pointer types are allocated as needed.
+//
+// Unlike the concrete converters, To reports failures, including numbers that
+// overflow the target type, instead of returning a zero value silently.
+func To[T any](value interface{}, opts ...Option) (T, error) {
+	var zero T
+	rv, err := convertValue(value, reflect.TypeOf((*T)(nil)).Elem(), buildOptions(opts))
+	if err != nil {
+		return zero, err
+	}
+	out, _ := rv.Interface().(T)
+	return out, nil
+}
+
+// MustTo is like To but panics if the conversion fails.
+func MustTo[T any](value interface{}, opts ...Option) T {
+	out, err := To[T](value, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return out
+}
+
+// convertValue converts `value` to a value of type `t`.
+func convertValue(value interface{}, t reflect.Type, o *Options) (reflect.Value, error) {
+	if value == nil {
+		return reflect.Zero(t), nil
+	}
+
+	// Dereference source pointers unless the target is itself a pointer
+	rv := reflect.ValueOf(value)
+	for rv.Kind() == reflect.Ptr && t.Kind() != reflect.Ptr {
+		if rv.IsNil() {
+			return reflect.Zero(t), nil
+		}
+		rv = rv.Elem()
+		value = rv.Interface()
+	}
+
+	// Values already of a compatible type are used as they are
+	if rv.Type().AssignableTo(t) {
+		out := reflect.New(t).Elem()
+		out.Set(rv)
+		return out, nil
+	}
+
+	switch t {
+	case timeType:
+		tm, err := doTime(value, o)
+		return reflect.ValueOf(tm), err
+
+	case durationType:
+		d, err := doDuration(value)
+		return reflect.ValueOf(d), err
+	}
+
+	out := reflect.New(t).Elem()
+	switch t.Kind() {
+	case reflect.Ptr:
+		if rv.Kind() == reflect.Ptr && rv.IsNil() {
+			return reflect.Zero(t), nil
+		}
+		elem, err := convertValue(value, t.Elem(), o)
+		if err != nil {
+			return out, err
+		}
+		ptr := reflect.New(t.Elem())
+		ptr.Elem().Set(elem)
+		return ptr, nil
+
+	case reflect.Interface:
+		if !rv.Type().Implements(t) {
+			return out, unsupportedError(value, t.String())
+		}
+		out.Set(rv)
+
+	case reflect.Bool:
+		b, err := doBool(value)
+		if err != nil {
+			return out, err
+		}
+		out.SetBool(b)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if err := checkInteger(value, t); err != nil {
+			return out, err
+		}
+		i, err := doInt64(value)
+		if err != nil {
+			return out, err
+		}
+		if out.OverflowInt(i) {
+			return out, fmt.Errorf("conv: value %d overflows %s", i, t)
+		}
+		out.SetInt(i)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if err := checkInteger(value, t); err != nil {
+			return out, err
+		}
+		u, err := doUint64(value)
+		if err != nil {
+			return out, err
+		}
+		if out.OverflowUint(u) {
+			return out, fmt.Errorf("conv: value %d overflows %s", u, t)
+		}
+		out.SetUint(u)
+
+	case reflect.Float32, reflect.Float64:
+		f, err := doFloat64(value)
+		if err != nil {
+			return out, err
+		}
+		if out.OverflowFloat(f) {
+			return out, fmt.Errorf("conv: value %g overflows %s", f, t)
+		}
+		out.SetFloat(f)
+
+	case reflect.String:
+		out.SetString(String(value))
+
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			out.SetBytes(Bytes(value))
+			break
+		}
+		items := doInterfaces(value)
+		if items == nil {
+			break
+		}
+		out.Set(reflect.MakeSlice(t, len(items), len(items)))
+		for i, item := range items {
+			elem, err := convertValue(item, t.Elem(), o)
+			if err != nil {
+				return out, fmt.Errorf("conv: index %d: %w", i, err)
+			}
+			out.Index(i).Set(elem)
+		}
+
+	case reflect.Array:
+		items := doInterfaces(value)
+		for i := 0; i < len(items) && i < t.Len(); i++ {
+			elem, err := convertValue(items[i], t.Elem(), o)
+			if err != nil {
+				return out, fmt.Errorf("conv: index %d: %w", i, err)
+			}
+			out.Index(i).Set(elem)
+		}
+
+	case reflect.Map:
+		return convertMap(rv, t, o)
+
+	case reflect.Struct:
+		if err := doStruct(value, out, o); err != nil {
+			return out, err
+		}
+
+	default:
+		if !rv.Type().ConvertibleTo(t) {
+			return out, unsupportedError(value, t.String())
+		}
+		out.Set(rv.Convert(t))
+	}
+	return out, nil
+}
+
+// convertMap converts the map, struct or JSON object in `rv` to map type `t`,
+// converting keys and values individually.
+func convertMap(rv reflect.Value, t reflect.Type, o *Options) (reflect.Value, error) {
+	out := reflect.New(t).Elem()
+
+	// Non-map sources are first turned into map[string]interface{}
+	if rv.Kind() != reflect.Map {
+		m, err := doMap(rv.Interface())
+		if err != nil || m == nil {
+			return out, err
+		}
+		rv = reflect.ValueOf(m)
+	}
+	if rv.IsNil() {
+		return out, nil
+	}
+
+	out.Set(reflect.MakeMapWithSize(t, rv.Len()))
+	iter := rv.MapRange()
+	for iter.Next() {
+		key, err := convertValue(iter.Key().Interface(), t.Key(), o)
+		if err != nil {
+			return out, fmt.Errorf("conv: map key %v: %w", iter.Key(), err)
+		}
+		elem, err := convertValue(iter.Value().Interface(), t.Elem(), o)
+		if err != nil {
+			return out, fmt.Errorf("conv: map key %v: %w", iter.Key(), err)
+		}
+		out.SetMapIndex(key, elem)
+	}
+	return out, nil
+}
+
+// checkInteger returns an error if `value`, a number or a numeric string, is
+// out of the 64-bit range of the integer type `t`: negative for an unsigned
+// type, above math.MaxInt64 for a signed one, or a float beyond either. The
+// lenient conversions would otherwise wrap it silently; narrower types are
+// checked after conversion.
+func checkInteger(value interface{}, t reflect.Type) error {
+	n, ok := normalize(value)
+	if !ok {
+		return nil
+	}
+	signed := t.Kind() >= reflect.Int && t.Kind() <= reflect.Int64
+	overflows := false
+	switch v := n.(type) {
+	case int64:
+		overflows = !signed && v < 0
+	case uint64:
+		overflows = signed && v > math.MaxInt64
+	case float64:
+		if signed {
+			overflows = math.IsNaN(v) || v < math.MinInt64 || v >= 1<<63
+		} else {
+			overflows = math.IsNaN(v) || v <= -1 || v >= 1<<64
+		}
+	case string:
+		return checkIntegerString(v, t)
+	case []byte:
+		return checkIntegerString(string(v), t)
+	}
+	if overflows {
+		return fmt.Errorf("conv: value %v overflows %s", n, t)
+	}
+	return nil
+}
+
+// checkIntegerString is checkInteger for the number in `s`, parsed as
+// parseInt64 does. Invalid numbers are left to the conversion to report.
+func checkIntegerString(s string, t reflect.Type) error {
+	s = strings.TrimSpace(s)
+	digits := s
+	if digits != "" && (digits[0] == '-' || digits[0] == '+') {
+		digits = digits[1:]
+	}
+	if len(digits) > 2 && digits[0] == '0' && (digits[1] == 'x' || digits[1] == 'X') {
+		u, err := strconv.ParseUint(digits[2:], 16, 64)
+		if err != nil {
+			return nil
+		}
+		if strings.HasPrefix(s, "-") && u != 0 {
+			if t.Kind() >= reflect.Int && t.Kind() <= reflect.Int64 && u <= 1<<63 {
+				return nil
+			}
+			return fmt.Errorf("conv: value %s overflows %s", s, t)
+		}
+		return checkInteger(u, t)
+	}
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return checkInteger(i, t)
+	}
+	if u, err := strconv.ParseUint(s, 10, 64); err == nil {
+		return checkInteger(u, t)
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil || errors.Is(err, strconv.ErrRange) {
+		return checkInteger(f, t)
+	}
+	return nil
+}
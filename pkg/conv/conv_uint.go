@@ -0,0 +1,102 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package conv
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Uint converts `value` to a uint.
+func Uint(value interface{}) uint {
+	return uint(Uint64(value))
+}
+
+// Uint8 converts `value` to a uint8.
+func Uint8(value interface{}) uint8 {
+	return uint8(Uint64(value))
+}
+
+// Uint16 converts `value` to a uint16.
+func Uint16(value interface{}) uint16 {
+	return uint16(Uint64(value))
+}
+
+// Uint32 converts `value` to a uint32.
+func Uint32(value interface{}) uint32 {
+	return uint32(Uint64(value))
+}
+
+// Uint64 converts `value` to a uint64.
+// Parsing rules match Int64; negative values wrap as in a Go conversion.
+func Uint64(value interface{}) uint64 {
+	u, _ := doUint64(value)
+	return u
+}
+
+// doUint64 implements Uint64 and reports values that cannot be converted.
+func doUint64(value interface{}) (uint64, error) {
+	switch v := value.(type) {
+	case nil:
+		return 0, nil
+	case int:
+		return uint64(v), nil
+	case int8:
+		return uint64(v), nil
+	case int16:
+		return uint64(v), nil
+	case int32:
+		return uint64(v), nil
+	case int64:
+		return uint64(v), nil
+	case uint:
+		return uint64(v), nil
+	case uint8:
+		return uint64(v), nil
+	case uint16:
+		return uint64(v), nil
+	case uint32:
+		return uint64(v), nil
+	case uint64:
+		return v, nil
+	case float32:
+		return uint64(v), nil
+	case float64:
+		return uint64(v), nil
+	case bool:
+		if v {
+			return 1, nil
+		}
+		return 0, nil
+	case string:
+		return parseUint64(v)
+	case []byte:
+		return parseUint64(string(v))
+	case time.Duration:
+		return uint64(v), nil
+	}
+
+	// Retry once with named types and pointers unwrapped
+	if n, ok := normalize(value); ok {
+		return doUint64(n)
+	}
+	return 0, unsupportedError(value, "uint64")
+}
+
+// parseUint64 parses `s` as an unsigned integer. Values beyond the int64 range
+// are parsed natively; everything else shares the Int64 rules.
+func parseUint64(s string) (uint64, error) {
+	s = strings.TrimSpace(s)
+	if u, err := strconv.ParseUint(s, 10, 64); err == nil {
+		return u, nil
+	}
+	i, err := parseInt64(s)
+	if err != nil {
+		return 0, fmt.Errorf("conv: invalid unsigned integer %q", s)
+	}
+	return uint64(i), nil
+}
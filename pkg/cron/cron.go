@@ -0,0 +1,373 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package cron runs jobs on cron schedules with second precision.
+//
+// Entries are scheduled on a timing wheel of pkg/timer, one timer entry per
+// cron entry re-armed after each run, so a Cron with many entries uses no
+// goroutine while idle. Each entry has a unique name, by which it can be
+// looked up and removed, and may evaluate its expression in its own time
+// zone. In singleton mode, a run is skipped while the previous one is still
+// running. Stop waits for running jobs, so a service can shut down without
+// cutting a job off midway.
+package cron
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/focela/aegis/pkg/clock"
+	"github.com/focela/aegis/pkg/timer"
+)
+
+// JobFunc is a job run by a Cron. Its context is cancelled when Stop gives
+// up waiting for running jobs.
+type JobFunc func(ctx context.Context)
+
+// Option configures a Cron.
+type Option func(*Cron)
+
+// WithLocation sets the default time zone of expressions, time.Local by
+// default.
+func WithLocation(loc *time.Location) Option {
+	return func(c *Cron) {
+		c.loc = loc
+	}
+}
+
+// WithTimer schedules the entries on `t` instead of a dedicated timer, which
+// lets several Crons share one wheel. Stop does not close a shared timer.
+func WithTimer(t *timer.Timer) Option {
+	return func(c *Cron) {
+		c.timer = t
+	}
+}
+
+// WithClock sets the clock schedules are computed from, clock.Real() by
+// default. The dedicated timer uses it too; a timer passed to WithTimer
+// should be created on the same clock.
+func WithClock(clk clock.Clock) Option {
+	return func(c *Cron) {
+		if clk != nil {
+			c.clock = clk
+		}
+	}
+}
+
+// EntryOption configures an Entry.
+type EntryOption func(*Entry)
+
+// WithName names the entry. Names are unique within a Cron; without a name
+// the entry is named after its sequence number.
+func WithName(name string) EntryOption {
+	return func(e *Entry) {
+		e.name = name
+	}
+}
+
+// WithEntryLocation evaluates the expression of the entry in `loc`, unless
+// the expression sets its own time zone.
+func WithEntryLocation(loc *time.Location) EntryOption {
+	return func(e *Entry) {
+		e.loc = loc
+	}
+}
+
+// WithSingleton skips a run of the entry while the previous one is running.
+func WithSingleton() EntryOption {
+	return func(e *Entry) {
+		e.singleton = true
+	}
+}
+
+// Cron is a set of scheduled entries. It is safe for concurrent use.
+type Cron struct {
+	// mu guards the fields below it.
+	mu sync.Mutex
+
+	// timer schedules the runs.
+	timer *timer.Timer
+
+	// ownTimer reports whether timer was created by New.
+	ownTimer bool
+
+	// loc is the default time zone.
+	loc *time.Location
+
+	// clock tells the time.
+	clock clock.Clock
+
+	// entries holds the entries by name.
+	entries map[string]*Entry
+
+	// seq numbers the entries.
+	seq int
+
+	// started reports whether the entries are scheduled.
+	started bool
+
+	// ctx is the context of the jobs, cancelled by cancel.
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// wg counts the running jobs.
+	wg sync.WaitGroup
+}
+
+// Entry is a job scheduled by a Cron.
+type Entry struct {
+	// cron is the owning Cron, whose lock guards prev, next and timerEntry.
+	cron *Cron
+
+	name      string
+	spec      string
+	schedule  Schedule
+	job       JobFunc
+	loc       *time.Location
+	singleton bool
+
+	// prev and next are the last and upcoming run times.
+	prev, next time.Time
+
+	// timerEntry is the armed timer entry, nil when not scheduled.
+	timerEntry *timer.Entry
+
+	// running is set while a singleton job runs.
+	running atomic.Bool
+}
+
+// New creates and returns a Cron. Entries run once Start is called.
+func New(opts ...Option) *Cron {
+	c := &Cron{
+		loc:     time.Local,
+		clock:   clock.Real(),
+		entries: make(map[string]*Entry),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.timer == nil {
+		c.timer, c.ownTimer = timer.New(timer.WithClock(c.clock)), true
+	}
+	c.ctx, c.cancel = context.WithCancel(context.Background())
+	return c
+}
+
+// Add schedules `job` on the cron expression `spec`, in the syntax of Parse.
+func (c *Cron) Add(spec string, job JobFunc, opts ...EntryOption) (*Entry, error) {
+	schedule, err := Parse(spec)
+	if err != nil {
+		return nil, err
+	}
+	return c.AddSchedule(schedule, job, append([]EntryOption{withSpec(spec)}, opts...)...)
+}
+
+// AddSchedule schedules `job` on `schedule`.
+func (c *Cron) AddSchedule(schedule Schedule, job JobFunc, opts ...EntryOption) (*Entry, error) {
+	e := &Entry{cron: c, schedule: schedule, job: job}
+	for _, opt := range opts {
+		opt(e)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.seq++
+	if e.name == "" {
+		e.name = fmt.Sprintf("entry-%d", c.seq)
+	}
+	if _, ok := c.entries[e.name]; ok {
+		return nil, fmt.Errorf("cron: duplicate entry name %q", e.name)
+	}
+	if e.loc == nil {
+		e.loc = c.loc
+	}
+	c.entries[e.name] = e
+	if c.started {
+		c.arm(e, c.clock.Now())
+	}
+	return e, nil
+}
+
+// Get returns the entry named `name`, or nil.
+func (c *Cron) Get(name string) *Entry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.entries[name]
+}
+
+// Remove unschedules the entry named `name`, reporting whether it existed.
+// A run in progress is not interrupted.
+func (c *Cron) Remove(name string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[name]
+	if !ok {
+		return false
+	}
+	delete(c.entries, name)
+	c.disarm(e)
+	return true
+}
+
+// Entries returns the entries sorted by name.
+func (c *Cron) Entries() []*Entry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entries := make([]*Entry, 0, len(c.entries))
+	for _, e := range c.entries {
+		entries = append(entries, e)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].name < entries[j].name
+	})
+	return entries
+}
+
+// Start schedules the entries. It does nothing if already started.
+func (c *Cron) Start() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.started {
+		return
+	}
+	c.started = true
+	now := c.clock.Now()
+	for _, e := range c.entries {
+		c.arm(e, now)
+	}
+}
+
+// Stop unschedules the entries and waits for running jobs to return or for
+// `ctx` to be done, in which case the context of the jobs is cancelled and
+// ctx.Err() is returned. The Cron may be started again afterwards.
+func (c *Cron) Stop(ctx context.Context) error {
+	c.mu.Lock()
+	c.started = false
+	for _, e := range c.entries {
+		c.disarm(e)
+	}
+	c.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		c.wg.Wait()
+		close(done)
+	}()
+	var err error
+	select {
+	case <-done:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	c.mu.Lock()
+	c.cancel()
+	c.ctx, c.cancel = context.WithCancel(context.Background())
+	c.mu.Unlock()
+	return err
+}
+
+// Close stops the Cron without waiting and releases its timer. It must not
+// be used afterwards.
+func (c *Cron) Close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.started = false
+	for _, e := range c.entries {
+		c.disarm(e)
+	}
+	c.cancel()
+	if c.ownTimer {
+		c.timer.Close()
+	}
+}
+
+// arm schedules the next run of `e` after `from`. It must be called with the
+// lock held.
+func (c *Cron) arm(e *Entry, from time.Time) {
+	next := e.schedule.Next(from.In(e.loc))
+	if next.IsZero() {
+		e.next, e.timerEntry = time.Time{}, nil
+		return
+	}
+	e.next = next
+	e.timerEntry = c.timer.AddOnce(c.clock.Until(next), func() {
+		c.fire(e, next)
+	})
+}
+
+// disarm cancels the scheduled run of `e`. It must be called with the lock
+// held.
+func (c *Cron) disarm(e *Entry) {
+	if e.timerEntry != nil {
+		e.timerEntry.Close()
+		e.timerEntry = nil
+	}
+	e.next = time.Time{}
+}
+
+// fire runs `e`, due at `at`, and schedules its next run.
+func (c *Cron) fire(e *Entry, at time.Time) {
+	c.mu.Lock()
+	if !c.started || c.entries[e.name] != e || !e.next.Equal(at) {
+		c.mu.Unlock()
+		return
+	}
+	e.prev = at
+	// Schedule from the due time so runs do not drift, but skip the runs
+	// missed while the process was stalled
+	from := at
+	if now := c.clock.Now(); now.Sub(at) > time.Second {
+		from = now
+	}
+	c.arm(e, from)
+	if e.singleton && !e.running.CompareAndSwap(false, true) {
+		c.mu.Unlock()
+		return
+	}
+	ctx := c.ctx
+	c.wg.Add(1)
+	c.mu.Unlock()
+
+	defer c.wg.Done()
+	if e.singleton {
+		defer e.running.Store(false)
+	}
+	e.job(ctx)
+}
+
+// Name returns the name of the entry.
+func (e *Entry) Name() string {
+	return e.name
+}
+
+// Spec returns the expression of the entry, empty if added by AddSchedule.
+func (e *Entry) Spec() string {
+	return e.spec
+}
+
+// Prev returns the due time of the last run, or the zero time.
+func (e *Entry) Prev() time.Time {
+	e.cron.mu.Lock()
+	defer e.cron.mu.Unlock()
+	return e.prev
+}
+
+// Next returns the due time of the next run, or the zero time if the entry
+// is not scheduled.
+func (e *Entry) Next() time.Time {
+	e.cron.mu.Lock()
+	defer e.cron.mu.Unlock()
+	return e.next
+}
+
+// withSpec records the expression of an entry.
+func withSpec(spec string) EntryOption {
+	return func(e *Entry) {
+		e.spec = spec
+	}
+}
@@ -0,0 +1,261 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule computes the run times of an entry.
+type Schedule interface {
+	// Next returns the first run time strictly after `t`, or the zero time
+	// if there is none.
+	Next(t time.Time) time.Time
+}
+
+// field is the range and names of one expression field.
+type field struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+// The fields of an expression, in order.
+var fields = [6]field{
+	{name: "second", min: 0, max: 59},
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}},
+	{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}},
+}
+
+// descriptors are the predefined expressions.
+var descriptors = map[string]string{
+	"@yearly":   "0 0 0 1 1 *",
+	"@annually": "0 0 0 1 1 *",
+	"@monthly":  "0 0 0 1 * *",
+	"@weekly":   "0 0 0 * * 0",
+	"@daily":    "0 0 0 * * *",
+	"@midnight": "0 0 0 * * *",
+	"@hourly":   "0 0 * * * *",
+}
+
+// specSchedule is the Schedule of an expression, with one bit per allowed
+// value of each field.
+type specSchedule struct {
+	second, minute, hour, dom, month, dow uint64
+
+	// domStar and dowStar report unrestricted day fields.
+	domStar, dowStar bool
+
+	// loc is the time zone of the fields, nil for that of the argument.
+	loc *time.Location
+}
+
+// everySchedule runs at a fixed interval.
+type everySchedule struct {
+	interval time.Duration
+}
+
+// Parse parses a cron expression.
+//
+// The expression has six space-separated fields: second, minute, hour, day
+// of month, month and day of week. Each field is "*" or "?" for any value,
+// or a comma-separated list of values and "a-b" ranges, either optionally
+// followed by "/step". Months and days of week may be given by their first
+// three letters, and Sunday is 0 or 7. When both day fields are restricted,
+// a day matching either runs. A five-field expression, without seconds, runs
+// at second zero.
+//
+// The descriptors @yearly, @monthly, @weekly, @daily and @hourly are also
+// accepted, as is "@every <duration>", which runs at a fixed interval from
+// the previous run. A "CRON_TZ=<zone> " or "TZ=<zone> " prefix evaluates the
+// fields in that IANA time zone.
+func Parse(spec string) (Schedule, error) {
+	expr := strings.TrimSpace(spec)
+	var loc *time.Location
+	if strings.HasPrefix(expr, "CRON_TZ=") || strings.HasPrefix(expr, "TZ=") {
+		zone, rest, _ := strings.Cut(expr[strings.IndexByte(expr, '=')+1:], " ")
+		var err error
+		if loc, err = time.LoadLocation(zone); err != nil {
+			return nil, fmt.Errorf("cron: invalid time zone in %q: %w", spec, err)
+		}
+		expr = strings.TrimSpace(rest)
+	}
+	if d, ok := strings.CutPrefix(expr, "@every "); ok {
+		interval, err := time.ParseDuration(strings.TrimSpace(d))
+		if err != nil || interval < time.Second {
+			return nil, fmt.Errorf("cron: invalid interval in %q", spec)
+		}
+		return everySchedule{interval: interval}, nil
+	}
+	if strings.HasPrefix(expr, "@") {
+		var ok bool
+		if expr, ok = descriptors[strings.ToLower(expr)]; !ok {
+			return nil, fmt.Errorf("cron: unknown descriptor %q", spec)
+		}
+	}
+	parts := strings.Fields(expr)
+	if len(parts) == 5 {
+		parts = append([]string{"0"}, parts...)
+	}
+	if len(parts) != 6 {
+		return nil, fmt.Errorf("cron: expected 6 fields in %q, found %d", spec, len(parts))
+	}
+	var values [6]uint64
+	for i, part := range parts {
+		v, err := parseField(part, fields[i])
+		if err != nil {
+			return nil, fmt.Errorf("cron: %s in %q", err, spec)
+		}
+		values[i] = v
+	}
+	s := &specSchedule{
+		second:  values[0],
+		minute:  values[1],
+		hour:    values[2],
+		dom:     values[3],
+		month:   values[4],
+		dow:     values[5],
+		domStar: parts[3] == "*" || parts[3] == "?",
+		dowStar: parts[5] == "*" || parts[5] == "?",
+		loc:     loc,
+	}
+	// Sunday may be written 7
+	if s.dow&(1<<7) != 0 {
+		s.dow = s.dow&^(1<<7) | 1
+	}
+	return s, nil
+}
+
+// MustParse is like Parse but panics on error.
+func MustParse(spec string) Schedule {
+	s, err := Parse(spec)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// parseField returns the bits of the values allowed by `expr` for `f`.
+func parseField(expr string, f field) (uint64, error) {
+	var bitset uint64
+	for _, item := range strings.Split(expr, ",") {
+		rangeExpr, stepExpr, hasStep := strings.Cut(item, "/")
+		lo, hi := f.min, f.max
+		if rangeExpr != "*" && rangeExpr != "?" {
+			loExpr, hiExpr, isRange := strings.Cut(rangeExpr, "-")
+			var err error
+			if lo, err = parseValue(loExpr, f); err != nil {
+				return 0, err
+			}
+			switch {
+			case isRange:
+				if hi, err = parseValue(hiExpr, f); err != nil {
+					return 0, err
+				}
+			case !hasStep:
+				hi = lo
+			}
+		}
+		step := 1
+		if hasStep {
+			var err error
+			if step, err = strconv.Atoi(stepExpr); err != nil || step < 1 {
+				return 0, fmt.Errorf("invalid step %q of %s", stepExpr, f.name)
+			}
+		}
+		if lo > hi {
+			return 0, fmt.Errorf("invalid range %q of %s", rangeExpr, f.name)
+		}
+		for v := lo; v <= hi; v += step {
+			bitset |= 1 << v
+		}
+	}
+	return bitset, nil
+}
+
+// parseValue parses a number or name of `f`.
+func parseValue(expr string, f field) (int, error) {
+	if v, ok := f.names[strings.ToLower(expr)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(expr)
+	if err != nil || v < f.min || v > f.max {
+		return 0, fmt.Errorf("invalid %s %q", f.name, expr)
+	}
+	return v, nil
+}
+
+// Next implements Schedule.
+func (s *specSchedule) Next(t time.Time) time.Time {
+	orig := t.Location()
+	if s.loc != nil {
+		t = t.In(s.loc)
+	}
+	loc := t.Location()
+	t = t.Truncate(time.Second).Add(time.Second)
+	// Give up after five years without a match, as for February 30
+	limit := t.Year() + 5
+
+	for t.Year() <= limit {
+		if !has(s.month, int(t.Month())) {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !has(s.hour, t.Hour()) {
+			next := time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			// Around a DST change the wall clock hour may not move forward
+			if !next.After(t) {
+				next = t.Add(time.Hour)
+			}
+			t = next
+			continue
+		}
+		if !has(s.minute, t.Minute()) {
+			t = t.Truncate(time.Minute).Add(time.Minute)
+			continue
+		}
+		if !has(s.second, t.Second()) {
+			t = t.Add(time.Second)
+			continue
+		}
+		return t.In(orig)
+	}
+	return time.Time{}
+}
+
+// dayMatches reports whether the day of `t` is allowed.
+func (s *specSchedule) dayMatches(t time.Time) bool {
+	dom := has(s.dom, t.Day())
+	dow := has(s.dow, int(t.Weekday()))
+	if s.domStar || s.dowStar {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+// Next implements Schedule.
+func (s everySchedule) Next(t time.Time) time.Time {
+	return t.Truncate(time.Second).Add(s.interval)
+}
+
+// has reports whether bit `v` of `bitset` is set.
+func has(bitset uint64, v int) bool {
+	return bitset&(1<<v) != 0
+}
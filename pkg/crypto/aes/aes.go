@@ -0,0 +1,296 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package aes provides AES encryption in GCM and CBC modes.
+//
+// Keys must be 16, 24 or 32 bytes long, selecting AES-128, AES-192 or
+// AES-256. By default a random nonce or IV is generated for every encryption
+// and prepended to the output, where decryption reads it back, so callers
+// only handle the key. GCM, the default mode, also authenticates the data and
+// should be preferred; CBC with PKCS#7 padding is provided for
+// interoperability. Errors carry codes of pkg/errors/code: invalid keys,
+// nonces and inputs are code.CodeInvalidParameter, and data failing
+// authentication or padding checks is code.CodeSecurityReason.
+package aes
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"io"
+	"slices"
+
+	"github.com/focela/aegis/pkg/errors"
+	"github.com/focela/aegis/pkg/errors/code"
+)
+
+// Mode is a block cipher mode.
+type Mode int
+
+// Block cipher modes.
+const (
+	// ModeGCM is the Galois/Counter Mode, an authenticated encryption mode.
+	ModeGCM Mode = iota
+
+	// ModeCBC is the Cipher Block Chaining mode with PKCS#7 padding.
+	ModeCBC
+)
+
+// options holds the settings of an encryption or decryption.
+type options struct {
+	// mode is the block cipher mode.
+	mode Mode
+
+	// nonce is the fixed nonce or IV, nil to generate or read it.
+	nonce []byte
+
+	// additionalData is the GCM additional authenticated data.
+	additionalData []byte
+}
+
+// Option configures an encryption or decryption.
+type Option func(*options)
+
+// WithMode selects the block cipher mode, ModeGCM by default.
+func WithMode(mode Mode) Option {
+	return func(o *options) {
+		o.mode = mode
+	}
+}
+
+// WithNonce uses `nonce`, the GCM nonce or the CBC IV, instead of a random
+// one. The nonce is then neither prepended to the output nor read from the
+// input. A nonce must never be reused with the same key in GCM mode, so this
+// option is meant for interoperability with fixed-IV protocols.
+func WithNonce(nonce []byte) Option {
+	return func(o *options) {
+		o.nonce = nonce
+	}
+}
+
+// WithAdditionalData authenticates `data` along with the ciphertext in GCM
+// mode, without encrypting it. Decryption must be given the same data.
+func WithAdditionalData(data []byte) Option {
+	return func(o *options) {
+		o.additionalData = data
+	}
+}
+
+// buildOptions returns the options set by `opts`.
+func buildOptions(opts []Option) *options {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// Encrypt encrypts `data` with `key` in the mode selected by the options,
+// GCM by default.
+func Encrypt(data, key []byte, opts ...Option) ([]byte, error) {
+	o := buildOptions(opts)
+	block, err := newBlock(key)
+	if err != nil {
+		return nil, err
+	}
+	switch o.mode {
+	case ModeGCM:
+		return encryptGCM(block, data, o)
+	case ModeCBC:
+		return encryptCBC(block, data, o)
+	}
+	return nil, errors.NewCodef(code.CodeNotSupported, "aes: unknown mode %d", o.mode)
+}
+
+// Decrypt decrypts `data`, encrypted by Encrypt with the same key and
+// options.
+func Decrypt(data, key []byte, opts ...Option) ([]byte, error) {
+	o := buildOptions(opts)
+	block, err := newBlock(key)
+	if err != nil {
+		return nil, err
+	}
+	switch o.mode {
+	case ModeGCM:
+		return decryptGCM(block, data, o)
+	case ModeCBC:
+		return decryptCBC(block, data, o)
+	}
+	return nil, errors.NewCodef(code.CodeNotSupported, "aes: unknown mode %d", o.mode)
+}
+
+// EncryptGCM is like Encrypt in GCM mode.
+func EncryptGCM(data, key []byte, opts ...Option) ([]byte, error) {
+	return Encrypt(data, key, withMode(opts, ModeGCM)...)
+}
+
+// DecryptGCM is like Decrypt in GCM mode.
+func DecryptGCM(data, key []byte, opts ...Option) ([]byte, error) {
+	return Decrypt(data, key, withMode(opts, ModeGCM)...)
+}
+
+// EncryptCBC is like Encrypt in CBC mode.
+func EncryptCBC(data, key []byte, opts ...Option) ([]byte, error) {
+	return Encrypt(data, key, withMode(opts, ModeCBC)...)
+}
+
+// DecryptCBC is like Decrypt in CBC mode.
+func DecryptCBC(data, key []byte, opts ...Option) ([]byte, error) {
+	return Decrypt(data, key, withMode(opts, ModeCBC)...)
+}
+
+// withMode returns `opts` followed by WithMode(`mode`), without writing to
+// the caller's slice.
+func withMode(opts []Option, mode Mode) []Option {
+	return append(slices.Clip(opts), WithMode(mode))
+}
+
+// newBlock creates the AES block cipher of `key`, checking its size.
+func newBlock(key []byte) (cipher.Block, error) {
+	switch len(key) {
+	case 16, 24, 32:
+		return aes.NewCipher(key)
+	}
+	return nil, errors.NewCodef(code.CodeInvalidParameter, "aes: invalid key size %d, must be 16, 24 or 32 bytes", len(key))
+}
+
+// randomBytes returns `n` random bytes.
+func randomBytes(n int) ([]byte, error) {
+	b := make([]byte, n)
+	if _, err := io.ReadFull(rand.Reader, b); err != nil {
+		return nil, errors.WrapCode(code.CodeInternalError, err, "aes: generating nonce")
+	}
+	return b, nil
+}
+
+// encryptGCM encrypts `data` in GCM mode.
+func encryptGCM(block cipher.Block, data []byte, o *options) ([]byte, error) {
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.WrapCode(code.CodeInternalError, err, "aes")
+	}
+	if o.nonce != nil {
+		if len(o.nonce) != gcm.NonceSize() {
+			return nil, errors.NewCodef(code.CodeInvalidParameter, "aes: invalid nonce size %d, must be %d bytes", len(o.nonce), gcm.NonceSize())
+		}
+		return gcm.Seal(nil, o.nonce, data, o.additionalData), nil
+	}
+	nonce, err := randomBytes(gcm.NonceSize())
+	if err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, data, o.additionalData), nil
+}
+
+// decryptGCM decrypts `data` in GCM mode.
+func decryptGCM(block cipher.Block, data []byte, o *options) ([]byte, error) {
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.WrapCode(code.CodeInternalError, err, "aes")
+	}
+	nonce := o.nonce
+	if nonce == nil {
+		if len(data) < gcm.NonceSize()+gcm.Overhead() {
+			return nil, errors.NewCode(code.CodeInvalidParameter, "aes: ciphertext too short")
+		}
+		nonce, data = data[:gcm.NonceSize()], data[gcm.NonceSize():]
+	} else if len(nonce) != gcm.NonceSize() {
+		return nil, errors.NewCodef(code.CodeInvalidParameter, "aes: invalid nonce size %d, must be %d bytes", len(nonce), gcm.NonceSize())
+	}
+	plain, err := gcm.Open(nil, nonce, data, o.additionalData)
+	if err != nil {
+		return nil, errors.WrapCode(code.CodeSecurityReason, err, "aes: decryption failed")
+	}
+	return plain, nil
+}
+
+// encryptCBC encrypts `data` in CBC mode with PKCS#7 padding.
+func encryptCBC(block cipher.Block, data []byte, o *options) ([]byte, error) {
+	iv := o.nonce
+	if iv != nil && len(iv) != aes.BlockSize {
+		return nil, errors.NewCodef(code.CodeInvalidParameter, "aes: invalid IV size %d, must be %d bytes", len(iv), aes.BlockSize)
+	}
+	padded, err := PKCS7Pad(data, aes.BlockSize)
+	if err != nil {
+		return nil, err
+	}
+	var out []byte
+	if iv == nil {
+		if iv, err = randomBytes(aes.BlockSize); err != nil {
+			return nil, err
+		}
+		out = make([]byte, aes.BlockSize+len(padded))
+		copy(out, iv)
+	} else {
+		out = make([]byte, len(padded))
+	}
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(out[len(out)-len(padded):], padded)
+	return out, nil
+}
+
+// decryptCBC decrypts `data` in CBC mode and removes its PKCS#7 padding.
+func decryptCBC(block cipher.Block, data []byte, o *options) ([]byte, error) {
+	iv := o.nonce
+	if iv == nil {
+		if len(data) < aes.BlockSize {
+			return nil, errors.NewCode(code.CodeInvalidParameter, "aes: ciphertext too short")
+		}
+		iv, data = data[:aes.BlockSize], data[aes.BlockSize:]
+	} else if len(iv) != aes.BlockSize {
+		return nil, errors.NewCodef(code.CodeInvalidParameter, "aes: invalid IV size %d, must be %d bytes", len(iv), aes.BlockSize)
+	}
+	if len(data) == 0 || len(data)%aes.BlockSize != 0 {
+		return nil, errors.NewCode(code.CodeInvalidParameter, "aes: ciphertext is not a multiple of the block size")
+	}
+	plain := make([]byte, len(data))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(plain, data)
+	return PKCS7Unpad(plain, aes.BlockSize)
+}
+
+// PKCS7Pad returns `data` padded to a multiple of `blockSize`, from 1 to
+// 255, as defined by PKCS#7. A full block of padding is added to data
+// already aligned.
+func PKCS7Pad(data []byte, blockSize int) ([]byte, error) {
+	if err := checkBlockSize(blockSize); err != nil {
+		return nil, err
+	}
+	n := blockSize - len(data)%blockSize
+	padded := make([]byte, len(data)+n)
+	copy(padded, data)
+	for i := len(data); i < len(padded); i++ {
+		padded[i] = byte(n)
+	}
+	return padded, nil
+}
+
+// PKCS7Unpad returns `data` without its PKCS#7 padding for `blockSize`.
+func PKCS7Unpad(data []byte, blockSize int) ([]byte, error) {
+	if err := checkBlockSize(blockSize); err != nil {
+		return nil, err
+	}
+	if len(data) == 0 || len(data)%blockSize != 0 {
+		return nil, errors.NewCode(code.CodeSecurityReason, "aes: invalid padding")
+	}
+	n := int(data[len(data)-1])
+	if n == 0 || n > blockSize {
+		return nil, errors.NewCode(code.CodeSecurityReason, "aes: invalid padding")
+	}
+	// Check every padding byte without an early exit
+	var bad byte
+	for _, b := range data[len(data)-n:] {
+		bad |= b ^ byte(n)
+	}
+	if bad != 0 {
+		return nil, errors.NewCode(code.CodeSecurityReason, "aes: invalid padding")
+	}
+	return data[:len(data)-n], nil
+}
+
+// checkBlockSize checks that `blockSize` can be padded with PKCS#7.
+func checkBlockSize(blockSize int) error {
+	if blockSize < 1 || blockSize > 255 {
+		return errors.NewCodef(code.CodeInvalidParameter, "aes: invalid block size %d, must be from 1 to 255", blockSize)
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package chacha20 provides ChaCha20-Poly1305 and XChaCha20-Poly1305
+// authenticated encryption.
+//
+// ChaCha20-Poly1305 is fast in software, so it suits platforms without AES
+// hardware acceleration, where AES-GCM is slow and exposed to timing attacks.
+// The API follows pkg/crypto/aes: keys are 32 bytes, and a random nonce is
+// generated for every encryption and prepended to the output unless one is
+// given with WithNonce. XChaCha20-Poly1305, of EncryptX and DecryptX, uses
+// 24-byte nonces, which are safe to draw at random for any number of
+// messages under one key. Errors carry the same codes as in pkg/crypto/aes.
+package chacha20
+
+import (
+	"crypto/cipher"
+	"crypto/rand"
+	"io"
+
+	"golang.org/x/crypto/chacha20poly1305"
+
+	"github.com/focela/aegis/pkg/errors"
+	"github.com/focela/aegis/pkg/errors/code"
+)
+
+// KeySize is the size of a key in bytes.
+const KeySize = chacha20poly1305.KeySize
+
+// options holds the settings of an encryption or decryption.
+type options struct {
+	// nonce is the fixed nonce, nil to generate or read it.
+	nonce []byte
+
+	// additionalData is the additional authenticated data.
+	additionalData []byte
+}
+
+// Option configures an encryption or decryption.
+type Option func(*options)
+
+// WithNonce uses `nonce` instead of a random one. The nonce is then neither
+// prepended to the output nor read from the input. A nonce must never be
+// reused with the same key.
+func WithNonce(nonce []byte) Option {
+	return func(o *options) {
+		o.nonce = nonce
+	}
+}
+
+// WithAdditionalData authenticates `data` along with the ciphertext, without
+// encrypting it. Decryption must be given the same data.
+func WithAdditionalData(data []byte) Option {
+	return func(o *options) {
+		o.additionalData = data
+	}
+}
+
+// Encrypt encrypts `data` with `key` using ChaCha20-Poly1305.
+func Encrypt(data, key []byte, opts ...Option) ([]byte, error) {
+	aead, err := newAEAD(key, chacha20poly1305.New)
+	if err != nil {
+		return nil, err
+	}
+	return seal(aead, data, opts)
+}
+
+// Decrypt decrypts `data`, encrypted by Encrypt with the same key and
+// options.
+func Decrypt(data, key []byte, opts ...Option) ([]byte, error) {
+	aead, err := newAEAD(key, chacha20poly1305.New)
+	if err != nil {
+		return nil, err
+	}
+	return open(aead, data, opts)
+}
+
+// EncryptX encrypts `data` with `key` using XChaCha20-Poly1305.
+func EncryptX(data, key []byte, opts ...Option) ([]byte, error) {
+	aead, err := newAEAD(key, chacha20poly1305.NewX)
+	if err != nil {
+		return nil, err
+	}
+	return seal(aead, data, opts)
+}
+
+// DecryptX decrypts `data`, encrypted by EncryptX with the same key and
+// options.
+func DecryptX(data, key []byte, opts ...Option) ([]byte, error) {
+	aead, err := newAEAD(key, chacha20poly1305.NewX)
+	if err != nil {
+		return nil, err
+	}
+	return open(aead, data, opts)
+}
+
+// newAEAD creates the AEAD of `key` with `constructor`, checking the key size.
+func newAEAD(key []byte, constructor func([]byte) (cipher.AEAD, error)) (cipher.AEAD, error) {
+	if len(key) != KeySize {
+		return nil, errors.NewCodef(code.CodeInvalidParameter, "chacha20: invalid key size %d, must be %d bytes", len(key), KeySize)
+	}
+	aead, err := constructor(key)
+	if err != nil {
+		return nil, errors.WrapCode(code.CodeInternalError, err, "chacha20")
+	}
+	return aead, nil
+}
+
+// seal encrypts `data` with `aead`.
+func seal(aead cipher.AEAD, data []byte, opts []Option) ([]byte, error) {
+	o := buildOptions(opts)
+	if o.nonce != nil {
+		if len(o.nonce) != aead.NonceSize() {
+			return nil, errors.NewCodef(code.CodeInvalidParameter, "chacha20: invalid nonce size %d, must be %d bytes", len(o.nonce), aead.NonceSize())
+		}
+		return aead.Seal(nil, o.nonce, data, o.additionalData), nil
+	}
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(data)+aead.Overhead())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, errors.WrapCode(code.CodeInternalError, err, "chacha20: generating nonce")
+	}
+	return aead.Seal(nonce, nonce, data, o.additionalData), nil
+}
+
+// open decrypts `data` with `aead`.
+func open(aead cipher.AEAD, data []byte, opts []Option) ([]byte, error) {
+	o := buildOptions(opts)
+	nonce := o.nonce
+	if nonce == nil {
+		if len(data) < aead.NonceSize()+aead.Overhead() {
+			return nil, errors.NewCode(code.CodeInvalidParameter, "chacha20: ciphertext too short")
+		}
+		nonce, data = data[:aead.NonceSize()], data[aead.NonceSize():]
+	} else if len(nonce) != aead.NonceSize() {
+		return nil, errors.NewCodef(code.CodeInvalidParameter, "chacha20: invalid nonce size %d, must be %d bytes", len(nonce), aead.NonceSize())
+	}
+	plain, err := aead.Open(nil, nonce, data, o.additionalData)
+	if err != nil {
+		return nil, errors.WrapCode(code.CodeSecurityReason, err, "chacha20: decryption failed")
+	}
+	return plain, nil
+}
+
+// buildOptions returns the options set by `opts`.
+func buildOptions(opts []Option) *options {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
@@ -0,0 +1,174 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package digest provides one-shot cryptographic digests.
+//
+// Each algorithm has helpers for byte slices, strings, readers and files that
+// return the lowercase hex digest, plus a Raw variant returning the digest
+// bytes. Sum, SumReader and SumFile accept any linked crypto.Hash. MD5 and
+// SHA-1 are broken for collision resistance and should only be used for
+// checksums and compatibility.
+package digest
+
+import (
+	"crypto"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"io"
+	"os"
+
+	"github.com/focela/aegis/pkg/errors"
+	"github.com/focela/aegis/pkg/errors/code"
+)
+
+// Sum returns the `hash` digest of `data`.
+func Sum(hash crypto.Hash, data []byte) ([]byte, error) {
+	if !hash.Available() {
+		return nil, errors.NewCodef(code.CodeNotSupported, "digest: hash %d is not available", hash)
+	}
+	h := hash.New()
+	h.Write(data)
+	return h.Sum(nil), nil
+}
+
+// SumReader returns the `hash` digest of everything read from `r`.
+func SumReader(hash crypto.Hash, r io.Reader) ([]byte, error) {
+	if !hash.Available() {
+		return nil, errors.NewCodef(code.CodeNotSupported, "digest: hash %d is not available", hash)
+	}
+	h := hash.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return nil, errors.WrapCode(code.CodeOperationFailed, err, "digest: reading input")
+	}
+	return h.Sum(nil), nil
+}
+
+// SumFile returns the `hash` digest of the file `path`, streaming its content.
+func SumFile(hash crypto.Hash, path string) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.WrapCodef(code.CodeOperationFailed, err, "digest: opening %s", path)
+	}
+	defer f.Close()
+	return SumReader(hash, f)
+}
+
+// MD5 returns the hex MD5 digest of `data`.
+func MD5(data []byte) string {
+	sum := md5.Sum(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// MD5String returns the hex MD5 digest of `s`.
+func MD5String(s string) string {
+	return MD5([]byte(s))
+}
+
+// MD5Raw returns the MD5 digest of `data`.
+func MD5Raw(data []byte) []byte {
+	sum := md5.Sum(data)
+	return sum[:]
+}
+
+// MD5Reader returns the hex MD5 digest of everything read from `r`.
+func MD5Reader(r io.Reader) (string, error) {
+	return hexOf(SumReader(crypto.MD5, r))
+}
+
+// MD5File returns the hex MD5 digest of the file `path`.
+func MD5File(path string) (string, error) {
+	return hexOf(SumFile(crypto.MD5, path))
+}
+
+// SHA1 returns the hex SHA-1 digest of `data`.
+func SHA1(data []byte) string {
+	sum := sha1.Sum(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// SHA1String returns the hex SHA-1 digest of `s`.
+func SHA1String(s string) string {
+	return SHA1([]byte(s))
+}
+
+// SHA1Raw returns the SHA-1 digest of `data`.
+func SHA1Raw(data []byte) []byte {
+	sum := sha1.Sum(data)
+	return sum[:]
+}
+
+// SHA1Reader returns the hex SHA-1 digest of everything read from `r`.
+func SHA1Reader(r io.Reader) (string, error) {
+	return hexOf(SumReader(crypto.SHA1, r))
+}
+
+// SHA1File returns the hex SHA-1 digest of the file `path`.
+func SHA1File(path string) (string, error) {
+	return hexOf(SumFile(crypto.SHA1, path))
+}
+
+// SHA256 returns the hex SHA-256 digest of `data`.
+func SHA256(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// SHA256String returns the hex SHA-256 digest of `s`.
+func SHA256String(s string) string {
+	return SHA256([]byte(s))
+}
+
+// SHA256Raw returns the SHA-256 digest of `data`.
+func SHA256Raw(data []byte) []byte {
+	sum := sha256.Sum256(data)
+	return sum[:]
+}
+
+// SHA256Reader returns the hex SHA-256 digest of everything read from `r`.
+func SHA256Reader(r io.Reader) (string, error) {
+	return hexOf(SumReader(crypto.SHA256, r))
+}
+
+// SHA256File returns the hex SHA-256 digest of the file `path`.
+func SHA256File(path string) (string, error) {
+	return hexOf(SumFile(crypto.SHA256, path))
+}
+
+// SHA512 returns the hex SHA-512 digest of `data`.
+func SHA512(data []byte) string {
+	sum := sha512.Sum512(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// SHA512String returns the hex SHA-512 digest of `s`.
+func SHA512String(s string) string {
+	return SHA512([]byte(s))
+}
+
+// SHA512Raw returns the SHA-512 digest of `data`.
+func SHA512Raw(data []byte) []byte {
+	sum := sha512.Sum512(data)
+	return sum[:]
+}
+
+// SHA512Reader returns the hex SHA-512 digest of everything read from `r`.
+func SHA512Reader(r io.Reader) (string, error) {
+	return hexOf(SumReader(crypto.SHA512, r))
+}
+
+// SHA512File returns the hex SHA-512 digest of the file `path`.
+func SHA512File(path string) (string, error) {
+	return hexOf(SumFile(crypto.SHA512, path))
+}
+
+// hexOf returns the hex encoding of `sum`, passing `err` through.
+func hexOf(sum []byte, err error) (string, error) {
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(sum), nil
+}
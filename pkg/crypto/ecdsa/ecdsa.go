@@ -0,0 +1,302 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package ecdsa provides ECDSA signatures over P-256 with SHA-256, the ES256
+// algorithm of JSON Web Signatures.
+//
+// Signatures are encoded as the fixed-size concatenation of r and s, 32 bytes
+// each, as JWS requires, rather than the variable-length ASN.1 form; SignASN1
+// and VerifyASN1 handle the latter for X.509 and TLS tooling. Keys are loaded
+// from PEM or DER data, in SEC 1 or PKCS#8 form for private keys and PKIX form
+// or X.509 certificates for public keys, or from JSON Web Keys. Errors carry
+// codes of pkg/errors/code like those of pkg/crypto/rsa.
+package ecdsa
+
+import (
+	"bytes"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"os"
+
+	"github.com/focela/aegis/pkg/errors"
+	"github.com/focela/aegis/pkg/errors/code"
+)
+
+// SignatureSize is the size of a signature in bytes.
+const SignatureSize = 64
+
+// coordSize is the size of a P-256 coordinate or scalar in bytes.
+const coordSize = 32
+
+// jwk is the JSON Web Key form of a P-256 key.
+type jwk struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+	D   string `json:"d,omitempty"`
+}
+
+// GenerateKey generates a P-256 private key.
+func GenerateKey() (*ecdsa.PrivateKey, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, errors.WrapCode(code.CodeInternalError, err, "ecdsa: generating key")
+	}
+	return key, nil
+}
+
+// Sign signs the SHA-256 digest of `data` with `key` and returns the 64-byte
+// r||s signature.
+func Sign(data []byte, key *ecdsa.PrivateKey) ([]byte, error) {
+	if err := checkPrivate(key); err != nil {
+		return nil, err
+	}
+	digest := sha256.Sum256(data)
+	r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+	if err != nil {
+		return nil, errors.WrapCode(code.CodeInvalidParameter, err, "ecdsa: signing failed")
+	}
+	sig := make([]byte, SignatureSize)
+	r.FillBytes(sig[:coordSize])
+	s.FillBytes(sig[coordSize:])
+	return sig, nil
+}
+
+// Verify checks that `sig` is a 64-byte r||s signature of `data` by `key`.
+func Verify(data, sig []byte, key *ecdsa.PublicKey) error {
+	if err := checkCurve(key); err != nil {
+		return err
+	}
+	if len(sig) != SignatureSize {
+		return errors.NewCodef(code.CodeSecurityReason, "ecdsa: invalid signature size %d", len(sig))
+	}
+	digest := sha256.Sum256(data)
+	r := new(big.Int).SetBytes(sig[:coordSize])
+	s := new(big.Int).SetBytes(sig[coordSize:])
+	if !ecdsa.Verify(key, digest[:], r, s) {
+		return errors.NewCode(code.CodeSecurityReason, "ecdsa: verification failed")
+	}
+	return nil
+}
+
+// SignASN1 is like Sign but returns the ASN.1 DER signature.
+func SignASN1(data []byte, key *ecdsa.PrivateKey) ([]byte, error) {
+	if err := checkPrivate(key); err != nil {
+		return nil, err
+	}
+	digest := sha256.Sum256(data)
+	sig, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+	if err != nil {
+		return nil, errors.WrapCode(code.CodeInvalidParameter, err, "ecdsa: signing failed")
+	}
+	return sig, nil
+}
+
+// VerifyASN1 is like Verify for an ASN.1 DER signature.
+func VerifyASN1(data, sig []byte, key *ecdsa.PublicKey) error {
+	if err := checkCurve(key); err != nil {
+		return err
+	}
+	digest := sha256.Sum256(data)
+	if !ecdsa.VerifyASN1(key, digest[:], sig) {
+		return errors.NewCode(code.CodeSecurityReason, "ecdsa: verification failed")
+	}
+	return nil
+}
+
+// EncodePrivateKey returns the PKCS#8 PEM encoding of `key`.
+func EncodePrivateKey(key *ecdsa.PrivateKey) ([]byte, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, errors.WrapCode(code.CodeInvalidParameter, err, "ecdsa: encoding private key")
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
+
+// EncodePublicKey returns the PKIX PEM encoding of `key`.
+func EncodePublicKey(key *ecdsa.PublicKey) ([]byte, error) {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		return nil, errors.WrapCode(code.CodeInvalidParameter, err, "ecdsa: encoding public key")
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), nil
+}
+
+// ParsePrivateKey parses a SEC 1 or PKCS#8 P-256 private key, PEM or DER
+// encoded.
+func ParsePrivateKey(data []byte) (*ecdsa.PrivateKey, error) {
+	der := decodePEM(data)
+	key, err := x509.ParseECPrivateKey(der)
+	if err != nil {
+		parsed, pkcs8Err := x509.ParsePKCS8PrivateKey(der)
+		if pkcs8Err != nil {
+			return nil, errors.WrapCode(code.CodeInvalidParameter, pkcs8Err, "ecdsa: parsing private key")
+		}
+		var ok bool
+		if key, ok = parsed.(*ecdsa.PrivateKey); !ok {
+			return nil, errors.NewCodef(code.CodeInvalidParameter, "ecdsa: private key is %T, not ECDSA", parsed)
+		}
+	}
+	if err = checkCurve(&key.PublicKey); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// ParsePublicKey parses a PKIX P-256 public key, or the public key of an
+// X.509 certificate, PEM or DER encoded.
+func ParsePublicKey(data []byte) (*ecdsa.PublicKey, error) {
+	der := decodePEM(data)
+	parsed, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		cert, certErr := x509.ParseCertificate(der)
+		if certErr != nil {
+			return nil, errors.WrapCode(code.CodeInvalidParameter, err, "ecdsa: parsing public key")
+		}
+		parsed = cert.PublicKey
+	}
+	key, ok := parsed.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, errors.NewCodef(code.CodeInvalidParameter, "ecdsa: public key is %T, not ECDSA", parsed)
+	}
+	if err = checkCurve(key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// LoadPrivateKey reads and parses the private key file `path`.
+func LoadPrivateKey(path string) (*ecdsa.PrivateKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.WrapCodef(code.CodeOperationFailed, err, "ecdsa: reading %s", path)
+	}
+	return ParsePrivateKey(data)
+}
+
+// LoadPublicKey reads and parses the public key or certificate file `path`.
+func LoadPublicKey(path string) (*ecdsa.PublicKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.WrapCodef(code.CodeOperationFailed, err, "ecdsa: reading %s", path)
+	}
+	return ParsePublicKey(data)
+}
+
+// ParseJWK parses a P-256 JSON Web Key. The private key is nil when the JWK
+// has no "d" member.
+func ParseJWK(data []byte) (*ecdsa.PublicKey, *ecdsa.PrivateKey, error) {
+	var k jwk
+	if err := json.Unmarshal(data, &k); err != nil {
+		return nil, nil, errors.WrapCode(code.CodeInvalidParameter, err, "ecdsa: parsing JWK")
+	}
+	if k.Kty != "EC" || k.Crv != "P-256" {
+		return nil, nil, errors.NewCodef(code.CodeInvalidParameter, "ecdsa: unsupported JWK kty %q crv %q", k.Kty, k.Crv)
+	}
+	x, errX := decodeCoord(k.X)
+	y, errY := decodeCoord(k.Y)
+	if errX != nil || errY != nil {
+		return nil, nil, errors.NewCode(code.CodeInvalidParameter, "ecdsa: invalid JWK coordinates")
+	}
+	// Validate the point through its uncompressed encoding
+	point := append(append([]byte{4}, x...), y...)
+	if _, err := ecdh.P256().NewPublicKey(point); err != nil {
+		return nil, nil, errors.WrapCode(code.CodeInvalidParameter, err, "ecdsa: JWK point is not on P-256")
+	}
+	pub := &ecdsa.PublicKey{
+		Curve: elliptic.P256(),
+		X:     new(big.Int).SetBytes(x),
+		Y:     new(big.Int).SetBytes(y),
+	}
+	if k.D == "" {
+		return pub, nil, nil
+	}
+	d, err := decodeCoord(k.D)
+	if err != nil {
+		return nil, nil, errors.NewCode(code.CodeInvalidParameter, "ecdsa: invalid JWK private scalar")
+	}
+	priv, err := ecdh.P256().NewPrivateKey(d)
+	if err != nil {
+		return nil, nil, errors.WrapCode(code.CodeInvalidParameter, err, "ecdsa: invalid JWK private scalar")
+	}
+	if !bytes.Equal(priv.PublicKey().Bytes(), point) {
+		return nil, nil, errors.NewCode(code.CodeInvalidParameter, "ecdsa: JWK private and public parts do not match")
+	}
+	return pub, &ecdsa.PrivateKey{PublicKey: *pub, D: new(big.Int).SetBytes(d)}, nil
+}
+
+// PublicJWK returns the JSON Web Key of `key`.
+func PublicJWK(key *ecdsa.PublicKey) ([]byte, error) {
+	if err := checkCurve(key); err != nil {
+		return nil, err
+	}
+	return json.Marshal(publicJWK(key))
+}
+
+// PrivateJWK returns the JSON Web Key of `key`, including the private scalar.
+func PrivateJWK(key *ecdsa.PrivateKey) ([]byte, error) {
+	if err := checkPrivate(key); err != nil {
+		return nil, err
+	}
+	k := publicJWK(&key.PublicKey)
+	k.D = encodeCoord(key.D)
+	return json.Marshal(k)
+}
+
+// publicJWK returns the JWK members of `key`.
+func publicJWK(key *ecdsa.PublicKey) jwk {
+	return jwk{Kty: "EC", Crv: "P-256", X: encodeCoord(key.X), Y: encodeCoord(key.Y)}
+}
+
+// encodeCoord returns the fixed-size base64url encoding of `n`.
+func encodeCoord(n *big.Int) string {
+	return base64.RawURLEncoding.EncodeToString(n.FillBytes(make([]byte, coordSize)))
+}
+
+// decodeCoord decodes a fixed-size base64url coordinate.
+func decodeCoord(s string) ([]byte, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) != coordSize {
+		return nil, errors.New("ecdsa: invalid coordinate size")
+	}
+	return b, nil
+}
+
+// checkCurve fails unless `key` is on P-256.
+func checkCurve(key *ecdsa.PublicKey) error {
+	if key == nil || key.Curve != elliptic.P256() {
+		return errors.NewCode(code.CodeInvalidParameter, "ecdsa: key is not on P-256")
+	}
+	return nil
+}
+
+// checkPrivate returns an error if `key` is not a P-256 private key with
+// its private scalar.
+func checkPrivate(key *ecdsa.PrivateKey) error {
+	if key == nil || key.D == nil {
+		return errors.NewCode(code.CodeInvalidParameter, "ecdsa: key has no private part")
+	}
+	return checkCurve(&key.PublicKey)
+}
+
+// decodePEM returns the content of the first PEM block of `data`, or `data`
+// itself when it is not PEM encoded.
+func decodePEM(data []byte) []byte {
+	if block, _ := pem.Decode(data); block != nil {
+		return block.Bytes
+	}
+	return data
+}
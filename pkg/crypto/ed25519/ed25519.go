@@ -0,0 +1,192 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package ed25519 provides Ed25519 signatures, the EdDSA algorithm of JSON
+// Web Signatures.
+//
+// Ed25519 signatures are deterministic: signing the same data with the same
+// key always yields the same 64 bytes. Keys are loaded from PEM or DER data,
+// in PKCS#8 form for private keys and PKIX form or X.509 certificates for
+// public keys, or from JSON Web Keys of type OKP. Errors carry codes of
+// pkg/errors/code like those of pkg/crypto/rsa.
+package ed25519
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"os"
+
+	"github.com/focela/aegis/pkg/errors"
+	"github.com/focela/aegis/pkg/errors/code"
+)
+
+// SignatureSize is the size of a signature in bytes.
+const SignatureSize = ed25519.SignatureSize
+
+// jwk is the JSON Web Key form of an Ed25519 key.
+type jwk struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	D   string `json:"d,omitempty"`
+}
+
+// GenerateKey generates a key pair.
+func GenerateKey() (ed25519.PublicKey, ed25519.PrivateKey, error) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, nil, errors.WrapCode(code.CodeInternalError, err, "ed25519: generating key")
+	}
+	return pub, priv, nil
+}
+
+// Sign signs `data` with `key`.
+func Sign(data []byte, key ed25519.PrivateKey) ([]byte, error) {
+	if len(key) != ed25519.PrivateKeySize {
+		return nil, errors.NewCodef(code.CodeInvalidParameter, "ed25519: invalid private key size %d", len(key))
+	}
+	return ed25519.Sign(key, data), nil
+}
+
+// Verify checks that `sig` is a signature of `data` by `key`.
+func Verify(data, sig []byte, key ed25519.PublicKey) error {
+	if len(key) != ed25519.PublicKeySize {
+		return errors.NewCodef(code.CodeInvalidParameter, "ed25519: invalid public key size %d", len(key))
+	}
+	if !ed25519.Verify(key, data, sig) {
+		return errors.NewCode(code.CodeSecurityReason, "ed25519: verification failed")
+	}
+	return nil
+}
+
+// EncodePrivateKey returns the PKCS#8 PEM encoding of `key`.
+func EncodePrivateKey(key ed25519.PrivateKey) ([]byte, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, errors.WrapCode(code.CodeInvalidParameter, err, "ed25519: encoding private key")
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
+
+// EncodePublicKey returns the PKIX PEM encoding of `key`.
+func EncodePublicKey(key ed25519.PublicKey) ([]byte, error) {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		return nil, errors.WrapCode(code.CodeInvalidParameter, err, "ed25519: encoding public key")
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), nil
+}
+
+// ParsePrivateKey parses a PKCS#8 private key, PEM or DER encoded.
+func ParsePrivateKey(data []byte) (ed25519.PrivateKey, error) {
+	parsed, err := x509.ParsePKCS8PrivateKey(decodePEM(data))
+	if err != nil {
+		return nil, errors.WrapCode(code.CodeInvalidParameter, err, "ed25519: parsing private key")
+	}
+	key, ok := parsed.(ed25519.PrivateKey)
+	if !ok {
+		return nil, errors.NewCodef(code.CodeInvalidParameter, "ed25519: private key is %T, not Ed25519", parsed)
+	}
+	return key, nil
+}
+
+// ParsePublicKey parses a PKIX public key, or the public key of an X.509
+// certificate, PEM or DER encoded.
+func ParsePublicKey(data []byte) (ed25519.PublicKey, error) {
+	der := decodePEM(data)
+	parsed, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		cert, certErr := x509.ParseCertificate(der)
+		if certErr != nil {
+			return nil, errors.WrapCode(code.CodeInvalidParameter, err, "ed25519: parsing public key")
+		}
+		parsed = cert.PublicKey
+	}
+	key, ok := parsed.(ed25519.PublicKey)
+	if !ok {
+		return nil, errors.NewCodef(code.CodeInvalidParameter, "ed25519: public key is %T, not Ed25519", parsed)
+	}
+	return key, nil
+}
+
+// LoadPrivateKey reads and parses the private key file `path`.
+func LoadPrivateKey(path string) (ed25519.PrivateKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.WrapCodef(code.CodeOperationFailed, err, "ed25519: reading %s", path)
+	}
+	return ParsePrivateKey(data)
+}
+
+// LoadPublicKey reads and parses the public key or certificate file `path`.
+func LoadPublicKey(path string) (ed25519.PublicKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.WrapCodef(code.CodeOperationFailed, err, "ed25519: reading %s", path)
+	}
+	return ParsePublicKey(data)
+}
+
+// ParseJWK parses an Ed25519 JSON Web Key. The private key is nil when the
+// JWK has no "d" member.
+func ParseJWK(data []byte) (ed25519.PublicKey, ed25519.PrivateKey, error) {
+	var k jwk
+	if err := json.Unmarshal(data, &k); err != nil {
+		return nil, nil, errors.WrapCode(code.CodeInvalidParameter, err, "ed25519: parsing JWK")
+	}
+	if k.Kty != "OKP" || k.Crv != "Ed25519" {
+		return nil, nil, errors.NewCodef(code.CodeInvalidParameter, "ed25519: unsupported JWK kty %q crv %q", k.Kty, k.Crv)
+	}
+	x, err := base64.RawURLEncoding.DecodeString(k.X)
+	if err != nil || len(x) != ed25519.PublicKeySize {
+		return nil, nil, errors.NewCode(code.CodeInvalidParameter, "ed25519: invalid JWK public key")
+	}
+	pub := ed25519.PublicKey(x)
+	if k.D == "" {
+		return pub, nil, nil
+	}
+	d, err := base64.RawURLEncoding.DecodeString(k.D)
+	if err != nil || len(d) != ed25519.SeedSize {
+		return nil, nil, errors.NewCode(code.CodeInvalidParameter, "ed25519: invalid JWK private key")
+	}
+	priv := ed25519.NewKeyFromSeed(d)
+	if !pub.Equal(priv.Public()) {
+		return nil, nil, errors.NewCode(code.CodeInvalidParameter, "ed25519: JWK private and public parts do not match")
+	}
+	return pub, priv, nil
+}
+
+// PublicJWK returns the JSON Web Key of `key`.
+func PublicJWK(key ed25519.PublicKey) ([]byte, error) {
+	if len(key) != ed25519.PublicKeySize {
+		return nil, errors.NewCodef(code.CodeInvalidParameter, "ed25519: invalid public key size %d", len(key))
+	}
+	return json.Marshal(jwk{Kty: "OKP", Crv: "Ed25519", X: base64.RawURLEncoding.EncodeToString(key)})
+}
+
+// PrivateJWK returns the JSON Web Key of `key`, including the private seed.
+func PrivateJWK(key ed25519.PrivateKey) ([]byte, error) {
+	if len(key) != ed25519.PrivateKeySize {
+		return nil, errors.NewCodef(code.CodeInvalidParameter, "ed25519: invalid private key size %d", len(key))
+	}
+	return json.Marshal(jwk{
+		Kty: "OKP",
+		Crv: "Ed25519",
+		X:   base64.RawURLEncoding.EncodeToString(key.Public().(ed25519.PublicKey)),
+		D:   base64.RawURLEncoding.EncodeToString(key.Seed()),
+	})
+}
+
+// decodePEM returns the content of the first PEM block of `data`, or `data`
+// itself when it is not PEM encoded.
+func decodePEM(data []byte) []byte {
+	if block, _ := pem.Decode(data); block != nil {
+		return block.Bytes
+	}
+	return data
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package hmac provides one-shot HMAC computation and verification.
+//
+// The Verify functions compare in constant time and accept the expected MAC
+// as raw bytes or as a hex string, optionally prefixed with the algorithm name
+// as in "sha256=...", which is how most webhook providers send signatures:
+//
+//	if !hmac.VerifySHA256Hex(secret, body, r.Header.Get("X-Signature")) {
+//		// reject the request
+//	}
+package hmac
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"encoding/hex"
+	"strings"
+
+	// Register the hashes used by the helpers.
+	_ "crypto/sha1"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+)
+
+// Sum returns the HMAC of `data` with `key` using `hash`, which must be
+// linked in. It panics otherwise, as crypto.Hash.New does.
+func Sum(hash crypto.Hash, key, data []byte) []byte {
+	mac := hmac.New(hash.New, key)
+	mac.Write(data)
+	return mac.Sum(nil)
+}
+
+// Verify reports whether `expected` is the HMAC of `data` with `key` using
+// `hash`, comparing in constant time.
+func Verify(hash crypto.Hash, key, data, expected []byte) bool {
+	return hmac.Equal(Sum(hash, key, data), expected)
+}
+
+// VerifyHex is like Verify with `expected` hex encoded, in either case and
+// optionally prefixed by "<algorithm>=". Malformed hex never verifies.
+func VerifyHex(hash crypto.Hash, key, data []byte, expected string) bool {
+	if i := strings.IndexByte(expected, '='); i >= 0 {
+		expected = expected[i+1:]
+	}
+	mac, err := hex.DecodeString(expected)
+	if err != nil {
+		return false
+	}
+	return Verify(hash, key, data, mac)
+}
+
+// Equal compares two MACs in constant time.
+func Equal(mac1, mac2 []byte) bool {
+	return hmac.Equal(mac1, mac2)
+}
+
+// SHA1 returns the HMAC-SHA1 of `data` with `key`.
+func SHA1(key, data []byte) []byte {
+	return Sum(crypto.SHA1, key, data)
+}
+
+// SHA1Hex returns the hex HMAC-SHA1 of `data` with `key`.
+func SHA1Hex(key, data []byte) string {
+	return hex.EncodeToString(SHA1(key, data))
+}
+
+// VerifySHA1Hex reports whether `expected` is the hex HMAC-SHA1 of `data`.
+func VerifySHA1Hex(key, data []byte, expected string) bool {
+	return VerifyHex(crypto.SHA1, key, data, expected)
+}
+
+// SHA256 returns the HMAC-SHA256 of `data` with `key`.
+func SHA256(key, data []byte) []byte {
+	return Sum(crypto.SHA256, key, data)
+}
+
+// SHA256Hex returns the hex HMAC-SHA256 of `data` with `key`.
+func SHA256Hex(key, data []byte) string {
+	return hex.EncodeToString(SHA256(key, data))
+}
+
+// VerifySHA256 reports whether `expected` is the HMAC-SHA256 of `data`.
+func VerifySHA256(key, data, expected []byte) bool {
+	return Verify(crypto.SHA256, key, data, expected)
+}
+
+// VerifySHA256Hex reports whether `expected` is the hex HMAC-SHA256 of `data`.
+func VerifySHA256Hex(key, data []byte, expected string) bool {
+	return VerifyHex(crypto.SHA256, key, data, expected)
+}
+
+// SHA512 returns the HMAC-SHA512 of `data` with `key`.
+func SHA512(key, data []byte) []byte {
+	return Sum(crypto.SHA512, key, data)
+}
+
+// SHA512Hex returns the hex HMAC-SHA512 of `data` with `key`.
+func SHA512Hex(key, data []byte) string {
+	return hex.EncodeToString(SHA512(key, data))
+}
+
+// VerifySHA512 reports whether `expected` is the HMAC-SHA512 of `data`.
+func VerifySHA512(key, data, expected []byte) bool {
+	return Verify(crypto.SHA512, key, data, expected)
+}
+
+// VerifySHA512Hex reports whether `expected` is the hex HMAC-SHA512 of `data`.
+func VerifySHA512Hex(key, data []byte, expected string) bool {
+	return VerifyHex(crypto.SHA512, key, data, expected)
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package kdf provides key derivation with PBKDF2, scrypt and HKDF.
+//
+// PBKDF2 and scrypt stretch low-entropy passwords into keys and are slow on
+// purpose; scrypt is also memory-hard and should be preferred. HKDF expands
+// an already strong secret, such as a Diffie-Hellman shared secret or a
+// master key, into independent keys told apart by their info string. Each
+// function takes an optional parameter struct; the defaults follow current
+// OWASP and RFC guidance and services deriving the same keys must share them.
+// Errors carry codes of pkg/errors/code like those of pkg/crypto/aes.
+package kdf
+
+import (
+	"crypto"
+	"crypto/hkdf"
+	"crypto/pbkdf2"
+	"crypto/rand"
+
+	// Register the SHA-2 hashes selectable in the parameters.
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/focela/aegis/pkg/errors"
+	"github.com/focela/aegis/pkg/errors/code"
+)
+
+// DefaultSaltSize is the size of salts returned by Salt.
+const DefaultSaltSize = 16
+
+// PBKDF2Params are the parameters of PBKDF2.
+type PBKDF2Params struct {
+	// Hash is the HMAC hash.
+	Hash crypto.Hash
+
+	// Iterations is the iteration count.
+	Iterations int
+
+	// KeyLength is the length of the derived key in bytes.
+	KeyLength int
+}
+
+// ScryptParams are the parameters of scrypt.
+type ScryptParams struct {
+	// N is the CPU and memory cost, a power of two.
+	N int
+
+	// R is the block size.
+	R int
+
+	// P is the parallelization factor.
+	P int
+
+	// KeyLength is the length of the derived key in bytes.
+	KeyLength int
+}
+
+// HKDFParams are the parameters of HKDF.
+type HKDFParams struct {
+	// Hash is the HMAC hash.
+	Hash crypto.Hash
+
+	// KeyLength is the length of the derived key in bytes.
+	KeyLength int
+}
+
+var (
+	// DefaultPBKDF2 is PBKDF2-HMAC-SHA256 with the 600,000 iterations
+	// recommended by OWASP, deriving 32 bytes.
+	DefaultPBKDF2 = PBKDF2Params{Hash: crypto.SHA256, Iterations: 600000, KeyLength: 32}
+
+	// DefaultScrypt is scrypt with N=2^15, r=8 and p=1, using 32 MiB of
+	// memory, deriving 32 bytes.
+	DefaultScrypt = ScryptParams{N: 1 << 15, R: 8, P: 1, KeyLength: 32}
+
+	// DefaultHKDF is HKDF-SHA256 deriving 32 bytes.
+	DefaultHKDF = HKDFParams{Hash: crypto.SHA256, KeyLength: 32}
+)
+
+// Salt returns a random salt of DefaultSaltSize bytes, or of `size` bytes if
+// given.
+func Salt(size ...int) []byte {
+	n := DefaultSaltSize
+	if len(size) > 0 {
+		n = size[0]
+	}
+	salt := make([]byte, n)
+	_, _ = rand.Read(salt)
+	return salt
+}
+
+// PBKDF2 derives a key from `password` and `salt` with PBKDF2, using
+// DefaultPBKDF2 unless `params` is given.
+func PBKDF2(password, salt []byte, params ...PBKDF2Params) ([]byte, error) {
+	p := DefaultPBKDF2
+	if len(params) > 0 {
+		p = params[0]
+	}
+	if !p.Hash.Available() {
+		return nil, errors.NewCodef(code.CodeNotSupported, "kdf: hash %d is not available", p.Hash)
+	}
+	if p.Iterations < 1 || p.KeyLength < 1 {
+		return nil, errors.NewCodef(code.CodeInvalidParameter, "kdf: invalid PBKDF2 parameters %+v", p)
+	}
+	key, err := pbkdf2.Key(p.Hash.New, string(password), salt, p.Iterations, p.KeyLength)
+	if err != nil {
+		return nil, errors.WrapCode(code.CodeInvalidParameter, err, "kdf")
+	}
+	return key, nil
+}
+
+// Scrypt derives a key from `password` and `salt` with scrypt, using
+// DefaultScrypt unless `params` is given.
+func Scrypt(password, salt []byte, params ...ScryptParams) ([]byte, error) {
+	p := DefaultScrypt
+	if len(params) > 0 {
+		p = params[0]
+	}
+	key, err := scrypt.Key(password, salt, p.N, p.R, p.P, p.KeyLength)
+	if err != nil {
+		return nil, errors.WrapCodef(code.CodeInvalidParameter, err, "kdf: invalid scrypt parameters %+v", p)
+	}
+	return key, nil
+}
+
+// HKDF derives a key from `secret` with HKDF, extracting with `salt` and
+// expanding with `info`, using DefaultHKDF unless `params` is given. Keys
+// for different purposes should be derived with different `info`.
+func HKDF(secret, salt []byte, info string, params ...HKDFParams) ([]byte, error) {
+	p := DefaultHKDF
+	if len(params) > 0 {
+		p = params[0]
+	}
+	if !p.Hash.Available() {
+		return nil, errors.NewCodef(code.CodeNotSupported, "kdf: hash %d is not available", p.Hash)
+	}
+	key, err := hkdf.Key(p.Hash.New, secret, salt, info, p.KeyLength)
+	if err != nil {
+		return nil, errors.WrapCodef(code.CodeInvalidParameter, err, "kdf: invalid HKDF parameters %+v", p)
+	}
+	return key, nil
+}
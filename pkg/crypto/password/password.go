@@ -0,0 +1,237 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package password provides password hashing with bcrypt and argon2id.
+//
+// Hash returns a self-describing string holding the algorithm, its
+// parameters and the salt, in the modular crypt format "$2a$..." for bcrypt
+// and in the PHC format "$argon2id$v=19$m=...,t=...,p=...$salt$hash" for
+// argon2id, so Verify needs nothing but the password and the stored hash.
+// When the policy changes, NeedsRehash tells which stored hashes were made
+// with other settings, so they can be upgraded at the next successful login.
+package password
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/focela/aegis/pkg/errors"
+	"github.com/focela/aegis/pkg/errors/code"
+)
+
+// Algorithm is a password hashing algorithm.
+type Algorithm string
+
+// Supported algorithms.
+const (
+	// Argon2id is the argon2id algorithm of RFC 9106, the default.
+	Argon2id Algorithm = "argon2id"
+
+	// Bcrypt is the bcrypt algorithm.
+	Bcrypt Algorithm = "bcrypt"
+)
+
+// Default parameters, following the second recommended option of RFC 9106
+// for argon2id.
+const (
+	DefaultBcryptCost = 12
+	DefaultTime       = 3
+	DefaultMemory     = 64 * 1024
+	DefaultThreads    = 4
+	DefaultKeyLength  = 32
+	DefaultSaltLength = 16
+)
+
+// options is the hashing policy.
+type options struct {
+	algorithm  Algorithm
+	cost       int
+	time       uint32
+	memory     uint32
+	threads    uint8
+	keyLength  uint32
+	saltLength int
+}
+
+// Option configures the hashing policy.
+type Option func(*options)
+
+// WithAlgorithm selects the algorithm, Argon2id by default.
+func WithAlgorithm(algorithm Algorithm) Option {
+	return func(o *options) {
+		o.algorithm = algorithm
+	}
+}
+
+// WithBcryptCost sets the bcrypt cost, DefaultBcryptCost by default.
+func WithBcryptCost(cost int) Option {
+	return func(o *options) {
+		o.cost = cost
+	}
+}
+
+// WithArgon2 sets the argon2id number of passes `time`, the memory in KiB
+// `memory` and the parallelism `threads`.
+func WithArgon2(time, memory uint32, threads uint8) Option {
+	return func(o *options) {
+		o.time, o.memory, o.threads = time, memory, threads
+	}
+}
+
+// WithArgon2Lengths sets the argon2id salt and key lengths in bytes.
+func WithArgon2Lengths(saltLength int, keyLength uint32) Option {
+	return func(o *options) {
+		o.saltLength, o.keyLength = saltLength, keyLength
+	}
+}
+
+// buildOptions returns the policy set by `opts`.
+func buildOptions(opts []Option) *options {
+	o := &options{
+		algorithm:  Argon2id,
+		cost:       DefaultBcryptCost,
+		time:       DefaultTime,
+		memory:     DefaultMemory,
+		threads:    DefaultThreads,
+		keyLength:  DefaultKeyLength,
+		saltLength: DefaultSaltLength,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// argon2Hash is a decoded argon2id hash.
+type argon2Hash struct {
+	time    uint32
+	memory  uint32
+	threads uint8
+	salt    []byte
+	key     []byte
+}
+
+// Hash hashes `password` with a random salt according to `opts`.
+func Hash(password string, opts ...Option) (string, error) {
+	o := buildOptions(opts)
+	switch o.algorithm {
+	case Bcrypt:
+		if o.cost < bcrypt.MinCost || o.cost > bcrypt.MaxCost {
+			return "", errors.NewCodef(code.CodeInvalidParameter, "password: invalid bcrypt cost %d", o.cost)
+		}
+		hash, err := bcrypt.GenerateFromPassword([]byte(password), o.cost)
+		if err != nil {
+			return "", errors.WrapCode(code.CodeInvalidParameter, err, "password")
+		}
+		return string(hash), nil
+	case Argon2id:
+		if o.time < 1 || o.threads < 1 || o.memory < 8*uint32(o.threads) || o.keyLength < 16 || o.saltLength < 8 {
+			return "", errors.NewCode(code.CodeInvalidParameter, "password: invalid argon2id parameters")
+		}
+		salt := make([]byte, o.saltLength)
+		if _, err := rand.Read(salt); err != nil {
+			return "", errors.WrapCode(code.CodeInternalError, err, "password: generating salt")
+		}
+		key := argon2.IDKey([]byte(password), salt, o.time, o.memory, o.threads, o.keyLength)
+		return fmt.Sprintf(
+			"$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+			argon2.Version, o.memory, o.time, o.threads,
+			base64.RawStdEncoding.EncodeToString(salt),
+			base64.RawStdEncoding.EncodeToString(key),
+		), nil
+	}
+	return "", errors.NewCodef(code.CodeNotSupported, "password: unknown algorithm %q", o.algorithm)
+}
+
+// Verify reports whether `password` matches `hash`, made by Hash with any
+// policy. The error is only set when `hash` is malformed or unsupported.
+func Verify(password, hash string) (bool, error) {
+	switch AlgorithmOf(hash) {
+	case Bcrypt:
+		err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+		if err == nil {
+			return true, nil
+		}
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return false, nil
+		}
+		return false, errors.WrapCode(code.CodeInvalidParameter, err, "password: malformed bcrypt hash")
+	case Argon2id:
+		h, err := parseArgon2(hash)
+		if err != nil {
+			return false, err
+		}
+		key := argon2.IDKey([]byte(password), h.salt, h.time, h.memory, h.threads, uint32(len(h.key)))
+		return subtle.ConstantTimeCompare(key, h.key) == 1, nil
+	}
+	return false, errors.NewCode(code.CodeNotSupported, "password: unrecognized hash format")
+}
+
+// NeedsRehash reports whether `hash` was made with another algorithm or with
+// parameters other than those of `opts`. Malformed hashes need rehashing.
+func NeedsRehash(hash string, opts ...Option) bool {
+	o := buildOptions(opts)
+	if AlgorithmOf(hash) != o.algorithm {
+		return true
+	}
+	switch o.algorithm {
+	case Bcrypt:
+		cost, err := bcrypt.Cost([]byte(hash))
+		return err != nil || cost != o.cost
+	case Argon2id:
+		h, err := parseArgon2(hash)
+		return err != nil ||
+			h.time != o.time || h.memory != o.memory || h.threads != o.threads ||
+			len(h.salt) != o.saltLength || uint32(len(h.key)) != o.keyLength
+	}
+	return true
+}
+
+// AlgorithmOf returns the algorithm of `hash`, or an empty Algorithm if it
+// is not recognized.
+func AlgorithmOf(hash string) Algorithm {
+	switch {
+	case strings.HasPrefix(hash, "$argon2id$"):
+		return Argon2id
+	case strings.HasPrefix(hash, "$2a$"), strings.HasPrefix(hash, "$2b$"), strings.HasPrefix(hash, "$2y$"):
+		return Bcrypt
+	}
+	return ""
+}
+
+// parseArgon2 decodes the PHC string `hash` of argon2id.
+func parseArgon2(hash string) (*argon2Hash, error) {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 {
+		return nil, errors.NewCode(code.CodeInvalidParameter, "password: malformed argon2id hash")
+	}
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return nil, errors.WrapCode(code.CodeInvalidParameter, err, "password: malformed argon2id version")
+	}
+	if version != argon2.Version {
+		return nil, errors.NewCodef(code.CodeNotSupported, "password: unsupported argon2id version %d", version)
+	}
+	h := &argon2Hash{}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &h.memory, &h.time, &h.threads); err != nil {
+		return nil, errors.WrapCode(code.CodeInvalidParameter, err, "password: malformed argon2id parameters")
+	}
+	var err error
+	if h.salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return nil, errors.WrapCode(code.CodeInvalidParameter, err, "password: malformed argon2id salt")
+	}
+	if h.key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil {
+		return nil, errors.WrapCode(code.CodeInvalidParameter, err, "password: malformed argon2id key")
+	}
+	if h.time < 1 || h.threads < 1 || len(h.key) == 0 {
+		return nil, errors.NewCode(code.CodeInvalidParameter, "password: invalid argon2id parameters")
+	}
+	return h, nil
+}
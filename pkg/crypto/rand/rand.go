@@ -0,0 +1,122 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package rand provides random values from the operating system's
+// cryptographically secure generator.
+//
+// Unlike math/rand, the values are unpredictable, so they are suitable for
+// secrets such as tokens, session identifiers, one-time codes and salts.
+// Integers and characters are drawn uniformly, without modulo bias. Reading
+// the system generator never fails on supported platforms, so no function
+// returns an error.
+package rand
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"math/bits"
+)
+
+// Character sets for Str.
+const (
+	Digits       = "0123456789"
+	Lowercase    = "abcdefghijklmnopqrstuvwxyz"
+	Uppercase    = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	Letters      = Lowercase + Uppercase
+	Alphanumeric = Digits + Letters
+)
+
+// Bytes returns `n` random bytes.
+func Bytes(n int) []byte {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+	return b
+}
+
+// Uint64 returns a random 64-bit unsigned integer.
+func Uint64() uint64 {
+	var b [8]byte
+	_, _ = rand.Read(b[:])
+	return binary.LittleEndian.Uint64(b[:])
+}
+
+// Uint64n returns a random integer in [0, `n`). It panics if `n` is zero.
+func Uint64n(n uint64) uint64 {
+	if n == 0 {
+		panic("rand: Uint64n with zero n")
+	}
+	// Lemire's multiply-shift, rejecting the biased low products
+	hi, lo := bits.Mul64(Uint64(), n)
+	if lo < n {
+		threshold := -n % n
+		for lo < threshold {
+			hi, lo = bits.Mul64(Uint64(), n)
+		}
+	}
+	return hi
+}
+
+// Intn returns a random integer in [0, `n`). It panics if `n` is not
+// positive.
+func Intn(n int) int {
+	if n <= 0 {
+		panic("rand: Intn with non-positive n")
+	}
+	return int(Uint64n(uint64(n)))
+}
+
+// Int returns a random integer in [`min`, `max`]. The bounds are swapped if
+// `min` is greater than `max`.
+func Int(min, max int) int {
+	if min > max {
+		min, max = max, min
+	}
+	span := uint64(max) - uint64(min) + 1
+	if span == 0 {
+		// The range covers every int
+		return int(Uint64())
+	}
+	return min + int(Uint64n(span))
+}
+
+// Str returns `n` characters drawn from `charset`, Alphanumeric if empty.
+// The characters of `charset` are runes, so it may hold any Unicode text.
+func Str(n int, charset ...string) string {
+	chars := Alphanumeric
+	if len(charset) > 0 && charset[0] != "" {
+		chars = charset[0]
+	}
+	runes := []rune(chars)
+	out := make([]rune, n)
+	for i := range out {
+		out[i] = runes[Intn(len(runes))]
+	}
+	return string(out)
+}
+
+// Digit returns a numeric code of `n` digits, as used for one-time codes.
+// The code may start with zeros.
+func Digit(n int) string {
+	return Str(n, Digits)
+}
+
+// Token returns a URL-safe, unpadded base64 encoding of `n` random bytes,
+// suitable for session identifiers and API keys. 32 bytes give 256 bits of
+// entropy.
+func Token(n int) string {
+	return base64.RawURLEncoding.EncodeToString(Bytes(n))
+}
+
+// TokenHex returns the hex encoding of `n` random bytes.
+func TokenHex(n int) string {
+	return hex.EncodeToString(Bytes(n))
+}
+
+// Text returns a base32 string with at least 128 bits of entropy, as
+// returned by crypto/rand.Text.
+func Text() string {
+	return rand.Text()
+}
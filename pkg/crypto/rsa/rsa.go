@@ -0,0 +1,262 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package rsa provides RSA key handling, encryption and signatures.
+//
+// Keys are loaded from PEM or DER data, in PKCS#1 or PKCS#8 form for private
+// keys and PKIX or PKCS#1 form for public keys, and a public key can also be
+// taken from an X.509 certificate. Encryption uses OAEP and signatures use
+// PSS by default, with PKCS#1 v1.5 available for interoperability; SHA-256 is
+// the default hash. Errors carry codes of pkg/errors/code: unusable keys and
+// inputs are code.CodeInvalidParameter, failed decryption and verification
+// are code.CodeSecurityReason.
+package rsa
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+
+	// Register the SHA-2 hashes selectable by WithHash.
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+
+	"github.com/focela/aegis/pkg/errors"
+	"github.com/focela/aegis/pkg/errors/code"
+)
+
+// MinKeyBits is the smallest key size accepted by GenerateKey.
+const MinKeyBits = 2048
+
+// Scheme is a signature scheme.
+type Scheme int
+
+// Signature schemes.
+const (
+	// SchemePSS is RSASSA-PSS, the default.
+	SchemePSS Scheme = iota
+
+	// SchemePKCS1v15 is RSASSA-PKCS1-v1_5.
+	SchemePKCS1v15
+)
+
+// options holds the settings of an operation.
+type options struct {
+	// hash is the digest of signatures and of OAEP.
+	hash crypto.Hash
+
+	// scheme is the signature scheme.
+	scheme Scheme
+
+	// label is the OAEP label.
+	label []byte
+}
+
+// Option configures an operation.
+type Option func(*options)
+
+// WithHash selects the hash used to digest signed data and by OAEP, SHA-256
+// by default. The hash package must be linked in; SHA-256, SHA-384 and
+// SHA-512 always are.
+func WithHash(hash crypto.Hash) Option {
+	return func(o *options) {
+		o.hash = hash
+	}
+}
+
+// WithScheme selects the signature scheme, SchemePSS by default.
+func WithScheme(scheme Scheme) Option {
+	return func(o *options) {
+		o.scheme = scheme
+	}
+}
+
+// WithLabel sets the OAEP label, which decryption must be given too.
+func WithLabel(label []byte) Option {
+	return func(o *options) {
+		o.label = label
+	}
+}
+
+// buildOptions returns the options set by `opts`, checking the hash.
+func buildOptions(opts []Option) (*options, error) {
+	o := &options{hash: crypto.SHA256}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if !o.hash.Available() {
+		return nil, errors.NewCodef(code.CodeNotSupported, "rsa: hash %d is not available", o.hash)
+	}
+	return o, nil
+}
+
+// GenerateKey generates a private key of `bits` bits, at least MinKeyBits.
+func GenerateKey(bits int) (*rsa.PrivateKey, error) {
+	if bits < MinKeyBits {
+		return nil, errors.NewCodef(code.CodeInvalidParameter, "rsa: key size %d is below %d bits", bits, MinKeyBits)
+	}
+	key, err := rsa.GenerateKey(rand.Reader, bits)
+	if err != nil {
+		return nil, errors.WrapCode(code.CodeInternalError, err, "rsa: generating key")
+	}
+	return key, nil
+}
+
+// EncodePrivateKey returns the PKCS#8 PEM encoding of `key`.
+func EncodePrivateKey(key *rsa.PrivateKey) ([]byte, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, errors.WrapCode(code.CodeInvalidParameter, err, "rsa: encoding private key")
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
+
+// EncodePublicKey returns the PKIX PEM encoding of `key`.
+func EncodePublicKey(key *rsa.PublicKey) ([]byte, error) {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		return nil, errors.WrapCode(code.CodeInvalidParameter, err, "rsa: encoding public key")
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), nil
+}
+
+// ParsePrivateKey parses a PKCS#1 or PKCS#8 private key, PEM or DER encoded.
+func ParsePrivateKey(data []byte) (*rsa.PrivateKey, error) {
+	der := decodePEM(data)
+	if key, err := x509.ParsePKCS1PrivateKey(der); err == nil {
+		return key, nil
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, errors.WrapCode(code.CodeInvalidParameter, err, "rsa: parsing private key")
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.NewCodef(code.CodeInvalidParameter, "rsa: private key is %T, not RSA", parsed)
+	}
+	return key, nil
+}
+
+// ParsePublicKey parses a PKIX or PKCS#1 public key, or the public key of an
+// X.509 certificate, PEM or DER encoded.
+func ParsePublicKey(data []byte) (*rsa.PublicKey, error) {
+	der := decodePEM(data)
+	if key, err := x509.ParsePKCS1PublicKey(der); err == nil {
+		return key, nil
+	}
+	parsed, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		cert, certErr := x509.ParseCertificate(der)
+		if certErr != nil {
+			return nil, errors.WrapCode(code.CodeInvalidParameter, err, "rsa: parsing public key")
+		}
+		parsed = cert.PublicKey
+	}
+	key, ok := parsed.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.NewCodef(code.CodeInvalidParameter, "rsa: public key is %T, not RSA", parsed)
+	}
+	return key, nil
+}
+
+// LoadPrivateKey reads and parses the private key file `path`.
+func LoadPrivateKey(path string) (*rsa.PrivateKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.WrapCodef(code.CodeOperationFailed, err, "rsa: reading %s", path)
+	}
+	return ParsePrivateKey(data)
+}
+
+// LoadPublicKey reads and parses the public key or certificate file `path`.
+func LoadPublicKey(path string) (*rsa.PublicKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.WrapCodef(code.CodeOperationFailed, err, "rsa: reading %s", path)
+	}
+	return ParsePublicKey(data)
+}
+
+// Encrypt encrypts `data` for `key` with OAEP.
+func Encrypt(data []byte, key *rsa.PublicKey, opts ...Option) ([]byte, error) {
+	o, err := buildOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	out, err := rsa.EncryptOAEP(o.hash.New(), rand.Reader, key, data, o.label)
+	if err != nil {
+		return nil, errors.WrapCode(code.CodeInvalidParameter, err, "rsa: encryption failed")
+	}
+	return out, nil
+}
+
+// Decrypt decrypts `data`, encrypted by Encrypt with the same options.
+func Decrypt(data []byte, key *rsa.PrivateKey, opts ...Option) ([]byte, error) {
+	o, err := buildOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	out, err := rsa.DecryptOAEP(o.hash.New(), nil, key, data, o.label)
+	if err != nil {
+		return nil, errors.WrapCode(code.CodeSecurityReason, err, "rsa: decryption failed")
+	}
+	return out, nil
+}
+
+// Sign signs the digest of `data` with `key`.
+func Sign(data []byte, key *rsa.PrivateKey, opts ...Option) ([]byte, error) {
+	o, err := buildOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	digest := sum(o.hash, data)
+	var sig []byte
+	if o.scheme == SchemePKCS1v15 {
+		sig, err = rsa.SignPKCS1v15(nil, key, o.hash, digest)
+	} else {
+		sig, err = rsa.SignPSS(rand.Reader, key, o.hash, digest, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+	}
+	if err != nil {
+		return nil, errors.WrapCode(code.CodeInvalidParameter, err, "rsa: signing failed")
+	}
+	return sig, nil
+}
+
+// Verify checks that `sig` is a signature of `data` by `key`, made by Sign
+// with the same options. PSS signatures with any salt length are accepted.
+func Verify(data, sig []byte, key *rsa.PublicKey, opts ...Option) error {
+	o, err := buildOptions(opts)
+	if err != nil {
+		return err
+	}
+	digest := sum(o.hash, data)
+	if o.scheme == SchemePKCS1v15 {
+		err = rsa.VerifyPKCS1v15(key, o.hash, digest, sig)
+	} else {
+		err = rsa.VerifyPSS(key, o.hash, digest, sig, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthAuto})
+	}
+	if err != nil {
+		return errors.WrapCode(code.CodeSecurityReason, err, "rsa: verification failed")
+	}
+	return nil
+}
+
+// sum returns the `hash` digest of `data`.
+func sum(hash crypto.Hash, data []byte) []byte {
+	h := hash.New()
+	h.Write(data)
+	return h.Sum(nil)
+}
+
+// decodePEM returns the content of the first PEM block of `data`, or `data`
+// itself when it is not PEM encoded.
+func decodePEM(data []byte) []byte {
+	if block, _ := pem.Decode(data); block != nil {
+		return block.Bytes
+	}
+	return data
+}
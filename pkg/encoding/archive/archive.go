@@ -0,0 +1,282 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package archive packs directories into zip and gzipped tar archives and
+// extracts them.
+//
+// Entries are named by their slash-separated path relative to the packed
+// directory. Include and exclude globs, in the syntax of path.Match, are
+// matched against that path and against the base name; an excluded directory
+// is skipped with its whole content. Extraction rejects absolute names, names
+// escaping the destination through "..", entries below symbolic links and
+// symbolic links pointing outside the destination, so archives from untrusted
+// sources cannot write outside of it.
+package archive
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// ProgressFunc is called after each entry is packed or extracted, with the
+// entry name and the number of entries and of content bytes processed so far.
+type ProgressFunc func(name string, entries int, bytes int64)
+
+// options holds the settings of a packing or extraction.
+type options struct {
+	// include holds the globs of which one must match, if any.
+	include []string
+
+	// exclude holds the globs of which none must match.
+	exclude []string
+
+	// progress is called after each entry.
+	progress ProgressFunc
+}
+
+// Option configures a packing or extraction.
+type Option func(*options)
+
+// WithInclude restricts the files processed to those matching one of
+// `patterns`. Directories are always traversed.
+func WithInclude(patterns ...string) Option {
+	return func(o *options) {
+		o.include = append(o.include, patterns...)
+	}
+}
+
+// WithExclude skips the files and directories matching one of `patterns`.
+func WithExclude(patterns ...string) Option {
+	return func(o *options) {
+		o.exclude = append(o.exclude, patterns...)
+	}
+}
+
+// WithProgress sets the callback reporting progress.
+func WithProgress(f ProgressFunc) Option {
+	return func(o *options) {
+		o.progress = f
+	}
+}
+
+// buildOptions returns the options set by `opts`, with the globs checked.
+func buildOptions(opts []Option) (*options, error) {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	for _, pattern := range append(o.include, o.exclude...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("archive: invalid pattern %q: %w", pattern, err)
+		}
+	}
+	return o, nil
+}
+
+// match reports whether `name` or its base name matches one of `patterns`.
+func match(patterns []string, name string) bool {
+	base := path.Base(name)
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+		if ok, _ := path.Match(pattern, base); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// excluded reports whether the entry `name` is excluded.
+func (o *options) excluded(name string) bool {
+	return match(o.exclude, name)
+}
+
+// included reports whether the file `name` is processed.
+func (o *options) included(name string) bool {
+	return !o.excluded(name) && (len(o.include) == 0 || match(o.include, name))
+}
+
+// tracker counts processed entries and reports them to the progress callback.
+type tracker struct {
+	// progress is the callback, possibly nil.
+	progress ProgressFunc
+
+	// entries is the number of entries processed.
+	entries int
+
+	// bytes is the number of content bytes processed.
+	bytes int64
+}
+
+// done records the entry `name` with `size` content bytes.
+func (t *tracker) done(name string, size int64) {
+	t.entries++
+	t.bytes += size
+	if t.progress != nil {
+		t.progress(name, t.entries, t.bytes)
+	}
+}
+
+// walkFunc is called by walk for each entry to pack, with its archive name.
+type walkFunc func(file, name string, info fs.FileInfo) error
+
+// walk calls `fn` for the entries of the directory `root` selected by `o`,
+// in lexical order. Directories are reported before their content and only
+// when not excluded.
+func walk(root string, o *options, fn walkFunc) error {
+	return filepath.WalkDir(root, func(file string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(root, file)
+		if err != nil || rel == "." {
+			return err
+		}
+		name := filepath.ToSlash(rel)
+		if d.IsDir() {
+			if o.excluded(name) {
+				return filepath.SkipDir
+			}
+		} else if !o.included(name) {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		return fn(file, name, info)
+	})
+}
+
+// safeJoin returns the path of the entry `name` below the directory `root`,
+// or an error if the entry would be written outside of it.
+func safeJoin(root, name string) (string, error) {
+	name = strings.ReplaceAll(name, "\\", "/")
+	if path.IsAbs(name) || filepath.IsAbs(name) || filepath.VolumeName(name) != "" {
+		return "", fmt.Errorf("archive: entry %q has an absolute path", name)
+	}
+	clean := path.Clean(name)
+	if clean == ".." || strings.HasPrefix(clean, "../") {
+		return "", fmt.Errorf("archive: entry %q escapes the destination", name)
+	}
+	// Writing through a link extracted earlier could escape the destination,
+	// so no parent of the entry may be a link
+	dir := root
+	for _, part := range strings.Split(path.Dir(clean), "/") {
+		if part == "." {
+			break
+		}
+		dir = filepath.Join(dir, part)
+		if info, err := os.Lstat(dir); err == nil && info.Mode()&fs.ModeSymlink != 0 {
+			return "", fmt.Errorf("archive: entry %q is below a symbolic link", name)
+		}
+	}
+	return filepath.Join(root, filepath.FromSlash(clean)), nil
+}
+
+// checkLink returns an error if the symbolic link `file`, below the directory
+// `root`, has a `target` outside of `root`. The system resolves ".." after
+// following the links before it, so a ".." is only accepted at the start of
+// the target, where it climbs real directories: otherwise a link extracted
+// before or after could redirect the target outside of `root`.
+func checkLink(root, file, target string) error {
+	if filepath.IsAbs(target) || filepath.VolumeName(target) != "" {
+		return fmt.Errorf("archive: link %q has an absolute target %q", file, target)
+	}
+	named := false
+	for _, part := range strings.Split(filepath.ToSlash(target), "/") {
+		switch part {
+		case "", ".":
+		case "..":
+			if named {
+				return fmt.Errorf("archive: link %q has a target %q with \"..\" after a name", file, target)
+			}
+		default:
+			named = true
+		}
+	}
+	resolved := filepath.Join(filepath.Dir(file), target)
+	rel, err := filepath.Rel(root, resolved)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return fmt.Errorf("archive: link %q points outside the destination", file)
+	}
+	return nil
+}
+
+// createFile creates the file `file` with `mode`, creating its parent
+// directories, and writes the content of `write` to it. A symbolic link in
+// place of the file is replaced rather than followed.
+func createFile(file string, mode fs.FileMode, write func(f *os.File) (int64, error)) (int64, error) {
+	if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+		return 0, err
+	}
+	if info, err := os.Lstat(file); err == nil && info.Mode()&fs.ModeSymlink != 0 {
+		if err := os.Remove(file); err != nil {
+			return 0, err
+		}
+	}
+	f, err := os.OpenFile(file, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode.Perm()|0o200)
+	if err != nil {
+		return 0, err
+	}
+	n, err := write(f)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	return n, err
+}
+
+// createLink creates the symbolic link `file` to `target`, replacing any
+// existing file.
+func createLink(file, target string) error {
+	if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+		return err
+	}
+	os.Remove(file)
+	return os.Symlink(target, file)
+}
+
+// excludedParent reports whether a parent directory of the entry `name` is
+// excluded, for archives whose directories have no entries of their own.
+func excludedParent(o *options, name string) bool {
+	for dir := path.Dir(name); dir != "." && dir != "/"; dir = path.Dir(dir) {
+		if o.excluded(dir) {
+			return true
+		}
+	}
+	return false
+}
+
+// copyFile copies the content of the file `file` to `w`.
+func copyFile(w io.Writer, file string) (int64, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	return io.Copy(w, f)
+}
+
+// writeArchive creates the file `dst` and fills it with `write`, removing it
+// if `write` fails.
+func writeArchive(dst string, write func(w io.Writer) error) error {
+	f, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	err = write(f)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(dst)
+	}
+	return err
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package archive
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// TarGz packs the content of the directory `src` into the gzipped tar
+// archive file `dst`, which is removed if packing fails.
+func TarGz(src, dst string, opts ...Option) error {
+	return writeArchive(dst, func(w io.Writer) error {
+		gw := gzip.NewWriter(w)
+		if err := Tar(gw, src, opts...); err != nil {
+			gw.Close()
+			return err
+		}
+		return gw.Close()
+	})
+}
+
+// Tar writes the content of the directory `src` to `w` as a tar archive.
+func Tar(w io.Writer, src string, opts ...Option) error {
+	o, err := buildOptions(opts)
+	if err != nil {
+		return err
+	}
+	tw := tar.NewWriter(w)
+	t := &tracker{progress: o.progress}
+	err = walk(src, o, func(file, name string, info fs.FileInfo) error {
+		var link string
+		if info.Mode()&fs.ModeSymlink != 0 {
+			target, err := os.Readlink(file)
+			if err != nil {
+				return err
+			}
+			link = filepath.ToSlash(target)
+		}
+		header, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+		header.Name = name
+		if info.IsDir() {
+			header.Name += "/"
+		}
+		if err = tw.WriteHeader(header); err != nil {
+			return err
+		}
+		var n int64
+		if info.Mode().IsRegular() {
+			if n, err = copyFile(tw, file); err != nil {
+				return err
+			}
+		}
+		t.done(name, n)
+		return nil
+	})
+	if err != nil {
+		tw.Close()
+		return err
+	}
+	return tw.Close()
+}
+
+// UntarGz extracts the gzipped tar archive file `src` into the directory
+// `dst`.
+func UntarGz(src, dst string, opts ...Option) error {
+	f, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	gr, err := gzip.NewReader(f)
+	if err != nil {
+		return err
+	}
+	defer gr.Close()
+	return Untar(gr, dst, opts...)
+}
+
+// Untar extracts the tar archive read from `r` into the directory `dst`.
+// Entries other than directories, regular files and symbolic links are
+// ignored.
+func Untar(r io.Reader, dst string, opts ...Option) error {
+	o, err := buildOptions(opts)
+	if err != nil {
+		return err
+	}
+	tr := tar.NewReader(r)
+	t := &tracker{progress: o.progress}
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		name := strings.TrimSuffix(header.Name, "/")
+		file, err := safeJoin(dst, name)
+		if err != nil {
+			return err
+		}
+		if header.Typeflag == tar.TypeDir {
+			if o.excluded(name) {
+				continue
+			}
+			if err = os.MkdirAll(file, 0o755); err != nil {
+				return err
+			}
+			t.done(name, 0)
+			continue
+		}
+		if !o.included(name) || excludedParent(o, name) {
+			continue
+		}
+		var n int64
+		switch header.Typeflag {
+		case tar.TypeReg:
+			n, err = createFile(file, header.FileInfo().Mode(), func(w *os.File) (int64, error) {
+				return io.Copy(w, tr)
+			})
+
+		case tar.TypeSymlink:
+			link := filepath.FromSlash(header.Linkname)
+			if err = checkLink(dst, file, link); err == nil {
+				err = createLink(file, link)
+			}
+
+		default:
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		t.done(name, n)
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package archive
+
+import (
+	"archive/zip"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ZipDir packs the content of the directory `src` into the zip archive file
+// `dst`, which is removed if packing fails.
+func ZipDir(src, dst string, opts ...Option) error {
+	return writeArchive(dst, func(w io.Writer) error {
+		return Zip(w, src, opts...)
+	})
+}
+
+// Zip writes the content of the directory `src` to `w` as a zip archive.
+func Zip(w io.Writer, src string, opts ...Option) error {
+	o, err := buildOptions(opts)
+	if err != nil {
+		return err
+	}
+	zw := zip.NewWriter(w)
+	t := &tracker{progress: o.progress}
+	err = walk(src, o, func(file, name string, info fs.FileInfo) error {
+		header, err := zip.FileInfoHeader(info)
+		if err != nil {
+			return err
+		}
+		header.Name = name
+		if info.IsDir() {
+			header.Name += "/"
+		} else {
+			header.Method = zip.Deflate
+		}
+		entry, err := zw.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+		var n int64
+		switch {
+		case info.Mode()&fs.ModeSymlink != 0:
+			target, err := os.Readlink(file)
+			if err != nil {
+				return err
+			}
+			if _, err = io.WriteString(entry, filepath.ToSlash(target)); err != nil {
+				return err
+			}
+
+		case info.Mode().IsRegular():
+			if n, err = copyFile(entry, file); err != nil {
+				return err
+			}
+		}
+		t.done(name, n)
+		return nil
+	})
+	if err != nil {
+		zw.Close()
+		return err
+	}
+	return zw.Close()
+}
+
+// UnzipTo extracts the zip archive file `src` into the directory `dst`.
+func UnzipTo(src, dst string, opts ...Option) error {
+	o, err := buildOptions(opts)
+	if err != nil {
+		return err
+	}
+	zr, err := zip.OpenReader(src)
+	if err != nil {
+		return err
+	}
+	defer zr.Close()
+	t := &tracker{progress: o.progress}
+	for _, f := range zr.File {
+		name := strings.TrimSuffix(f.Name, "/")
+		file, err := safeJoin(dst, name)
+		if err != nil {
+			return err
+		}
+		mode := f.Mode()
+		if mode.IsDir() {
+			if o.excluded(name) {
+				continue
+			}
+			if err = os.MkdirAll(file, 0o755); err != nil {
+				return err
+			}
+			t.done(name, 0)
+			continue
+		}
+		if !o.included(name) || excludedParent(o, name) {
+			continue
+		}
+		n, err := extractZipFile(dst, file, f)
+		if err != nil {
+			return err
+		}
+		t.done(name, n)
+	}
+	return nil
+}
+
+// extractZipFile writes the entry `f` to `file` below the directory `root`.
+func extractZipFile(root, file string, f *zip.File) (int64, error) {
+	r, err := f.Open()
+	if err != nil {
+		return 0, err
+	}
+	defer r.Close()
+	if f.Mode()&fs.ModeSymlink != 0 {
+		target, err := io.ReadAll(r)
+		if err != nil {
+			return 0, err
+		}
+		link := filepath.FromSlash(string(target))
+		if err = checkLink(root, file, link); err != nil {
+			return 0, err
+		}
+		return 0, createLink(file, link)
+	}
+	return createFile(file, f.Mode(), func(w *os.File) (int64, error) {
+		return io.Copy(w, r)
+	})
+}
@@ -0,0 +1,225 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package avro provides Apache Avro schema parsing and binary encoding and
+// decoding of records.
+//
+// Records encode from and decode to structs, whose fields are named by the
+// `avro` tag, and maps. Schemas are identified by the CRC-64-AVRO fingerprint
+// of their parsing canonical form, computed with pkg/encoding/hash, which is
+// also the fingerprint of the single-object encoding written by
+// EncodeSingle, so consumers such as Kafka pipelines can resolve the schema
+// of each message.
+package avro
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/hamba/avro/v2"
+
+	"github.com/focela/aegis/internal/encoding/generic"
+	"github.com/focela/aegis/pkg/conv"
+	"github.com/focela/aegis/pkg/encoding/hash"
+)
+
+// singleObjectMagic starts the single-object encoding of the specification.
+var singleObjectMagic = []byte{0xc3, 0x01}
+
+// ErrNotSingleObject is returned when data lacks the single-object header.
+var ErrNotSingleObject = errors.New("avro: data is not in the single-object encoding")
+
+// Schema is a parsed Avro schema.
+type Schema struct {
+	// schema is the parsed schema.
+	schema avro.Schema
+
+	// fingerprint is the CRC-64-AVRO fingerprint of the canonical form.
+	fingerprint uint64
+}
+
+// Parse parses the JSON Avro schema `schema`.
+func Parse(schema string) (*Schema, error) {
+	s, err := avro.Parse(schema)
+	if err != nil {
+		return nil, fmt.Errorf("avro: %w", err)
+	}
+	return &Schema{
+		schema:      s,
+		fingerprint: hash.CRC64Avro([]byte(s.String())),
+	}, nil
+}
+
+// MustParse is like Parse but panics if the schema is invalid.
+func MustParse(schema string) *Schema {
+	s, err := Parse(schema)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// ParseFile reads and parses the Avro schema file at `path`.
+func ParseFile(path string) (*Schema, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(string(content))
+}
+
+// String returns the parsing canonical form of the schema.
+func (s *Schema) String() string {
+	return s.schema.String()
+}
+
+// Fingerprint returns the CRC-64-AVRO fingerprint of the parsing canonical
+// form of the schema.
+func (s *Schema) Fingerprint() uint64 {
+	return s.fingerprint
+}
+
+// FingerprintSHA256 returns the SHA-256 fingerprint of the parsing canonical
+// form of the schema, for registries requiring fewer collisions.
+func (s *Schema) FingerprintSHA256() [sha256.Size]byte {
+	return sha256.Sum256([]byte(s.schema.String()))
+}
+
+// Encode returns the binary encoding of `value`, a struct, a map or a value
+// matching the schema. Numbers in generic values, such as json.Number or
+// int64 for an Avro int, are converted to the types of the schema.
+func (s *Schema) Encode(value interface{}) ([]byte, error) {
+	return avro.Marshal(s.schema, coerce(s.schema, value))
+}
+
+// DecodeTo decodes the binary encoding `data` into `pointer`.
+func (s *Schema) DecodeTo(data []byte, pointer interface{}) error {
+	return avro.Unmarshal(s.schema, data, pointer)
+}
+
+// Decode decodes the binary encoding `data` into generic values. Records and
+// maps decode to map[string]interface{}, long to int64, int to int, and unions
+// to their value.
+func (s *Schema) Decode(data []byte) (interface{}, error) {
+	var value interface{}
+	if err := s.DecodeTo(data, &value); err != nil {
+		return nil, err
+	}
+	return generic.StringKeys(value), nil
+}
+
+// EncodeSingle returns the single-object encoding of `value`: a two-byte
+// marker, the little-endian fingerprint of the schema and the binary
+// encoding.
+func (s *Schema) EncodeSingle(value interface{}) ([]byte, error) {
+	body, err := s.Encode(value)
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]byte, 0, 10+len(body))
+	buf = append(buf, singleObjectMagic...)
+	buf = binary.LittleEndian.AppendUint64(buf, s.fingerprint)
+	return append(buf, body...), nil
+}
+
+// DecodeSingle decodes the single-object encoding `data` into `pointer`,
+// failing if it was written with another schema.
+func (s *Schema) DecodeSingle(data []byte, pointer interface{}) error {
+	fingerprint, body, err := SplitSingle(data)
+	if err != nil {
+		return err
+	}
+	if fingerprint != s.fingerprint {
+		return fmt.Errorf("avro: data written with schema %016x, not %016x", fingerprint, s.fingerprint)
+	}
+	return s.DecodeTo(body, pointer)
+}
+
+// SplitSingle returns the schema fingerprint and the binary encoding of the
+// single-object encoding `data`, so the schema can be looked up before
+// decoding.
+func SplitSingle(data []byte) (uint64, []byte, error) {
+	if len(data) < 10 || !bytes.HasPrefix(data, singleObjectMagic) {
+		return 0, nil, ErrNotSingleObject
+	}
+	return binary.LittleEndian.Uint64(data[2:10]), data[10:], nil
+}
+
+// coerce returns the generic value `value` with its numbers converted to the
+// Go types expected for `schema`. Other values are returned unchanged.
+func coerce(schema avro.Schema, value interface{}) interface{} {
+	switch sc := schema.(type) {
+	case *avro.RecordSchema:
+		if m, ok := value.(map[string]interface{}); ok {
+			out := make(map[string]interface{}, len(m))
+			for k, v := range m {
+				out[k] = v
+			}
+			for _, f := range sc.Fields() {
+				if v, ok := out[f.Name()]; ok {
+					out[f.Name()] = coerce(f.Type(), v)
+				}
+			}
+			return out
+		}
+
+	case *avro.MapSchema:
+		if m, ok := value.(map[string]interface{}); ok {
+			out := make(map[string]interface{}, len(m))
+			for k, v := range m {
+				out[k] = coerce(sc.Values(), v)
+			}
+			return out
+		}
+
+	case *avro.ArraySchema:
+		if list, ok := value.([]interface{}); ok {
+			out := make([]interface{}, len(list))
+			for i, v := range list {
+				out[i] = coerce(sc.Items(), v)
+			}
+			return out
+		}
+
+	case *avro.UnionSchema:
+		// Only optional values have an unambiguous branch
+		if types := sc.Types(); value != nil && len(types) == 2 && sc.Nullable() {
+			for _, t := range types {
+				if t.Type() != avro.Null {
+					return coerce(t, value)
+				}
+			}
+		}
+
+	case *avro.PrimitiveSchema:
+		if !isNumber(value) {
+			return value
+		}
+		switch sc.Type() {
+		case avro.Int:
+			return conv.Int(value)
+		case avro.Long:
+			return conv.Int64(value)
+		case avro.Float:
+			return conv.Float32(value)
+		case avro.Double:
+			return conv.Float64(value)
+		}
+	}
+	return value
+}
+
+// isNumber reports whether `value` is a number of a generic document.
+func isNumber(value interface{}) bool {
+	switch value.(type) {
+	case json.Number, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return true
+	}
+	return false
+}
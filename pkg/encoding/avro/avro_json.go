@@ -0,0 +1,24 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package avro
+
+import (
+	"github.com/focela/aegis/pkg/encoding/json"
+)
+
+// DecodeJson decodes the binary encoding `data` written with the schema and
+// returns it as a Json.
+func (s *Schema) DecodeJson(data []byte, safe ...bool) (*json.Json, error) {
+	value, err := s.Decode(data)
+	if err != nil {
+		return nil, err
+	}
+	return json.New(value, safe...), nil
+}
+
+// EncodeJson encodes the document `j` in the binary encoding of the schema.
+func (s *Schema) EncodeJson(j *json.Json) ([]byte, error) {
+	return s.Encode(j.Interface())
+}
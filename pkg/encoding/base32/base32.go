@@ -0,0 +1,136 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package base32 provides convenience wrappers around encoding/base32.
+//
+// Each Encoding pairs an alphabet, standard or extended hex, with a padding
+// policy. The alphabets use only upper-case letters and digits, so the output
+// is safe in URLs, file names and case-sensitive identifiers alike. Encoding
+// writes padding only for the padded encodings, while decoding accepts input
+// with or without padding. The package-level functions use StdEncoding.
+package base32
+
+import (
+	"encoding/base32"
+	"io"
+	"strings"
+)
+
+// Encoding is a base32 encoding with a fixed alphabet and padding policy.
+type Encoding struct {
+	// encoding writes the output, with or without padding.
+	encoding *base32.Encoding
+
+	// raw decodes input from which padding was removed.
+	raw *base32.Encoding
+}
+
+// Predefined encodings.
+var (
+	// StdEncoding is the standard encoding of RFC 4648, with padding.
+	StdEncoding = newEncoding(base32.StdEncoding)
+
+	// HexEncoding is the extended hex encoding of RFC 4648, with padding,
+	// whose output sorts in the same order as the input.
+	HexEncoding = newEncoding(base32.HexEncoding)
+
+	// RawStdEncoding is the standard encoding without padding.
+	RawStdEncoding = newEncoding(base32.StdEncoding.WithPadding(base32.NoPadding))
+
+	// RawHexEncoding is the extended hex encoding without padding.
+	RawHexEncoding = newEncoding(base32.HexEncoding.WithPadding(base32.NoPadding))
+)
+
+// newEncoding creates an Encoding writing with `encoding`.
+func newEncoding(encoding *base32.Encoding) *Encoding {
+	return &Encoding{encoding: encoding, raw: encoding.WithPadding(base32.NoPadding)}
+}
+
+// Encode returns the encoding of `src`.
+func (e *Encoding) Encode(src []byte) []byte {
+	dst := make([]byte, e.encoding.EncodedLen(len(src)))
+	e.encoding.Encode(dst, src)
+	return dst
+}
+
+// EncodeToString returns the encoding of `src` as a string.
+func (e *Encoding) EncodeToString(src []byte) string {
+	return e.encoding.EncodeToString(src)
+}
+
+// EncodeString returns the encoding of the string `s`.
+func (e *Encoding) EncodeString(s string) string {
+	return e.encoding.EncodeToString([]byte(s))
+}
+
+// Decode returns the bytes encoded by `src`, padded or not. Newlines are
+// ignored.
+func (e *Encoding) Decode(src []byte) ([]byte, error) {
+	return e.DecodeString(string(src))
+}
+
+// DecodeString returns the bytes encoded by `s`, padded or not. Newlines are
+// ignored.
+func (e *Encoding) DecodeString(s string) ([]byte, error) {
+	s = strings.NewReplacer("\r", "", "\n", "").Replace(s)
+	return e.raw.DecodeString(strings.TrimRight(s, "="))
+}
+
+// DecodeToString returns the string encoded by `s`, padded or not.
+func (e *Encoding) DecodeToString(s string) (string, error) {
+	b, err := e.DecodeString(s)
+	return string(b), err
+}
+
+// NewEncoder returns a stream encoder writing the encoding to `w`. The caller
+// must Close it to flush any partially written block.
+func (e *Encoding) NewEncoder(w io.Writer) io.WriteCloser {
+	return base32.NewEncoder(e.encoding, w)
+}
+
+// NewDecoder returns a stream decoder reading the encoding from `r`. Unlike
+// the Decode methods, the input must follow the padding policy of `e`.
+func (e *Encoding) NewDecoder(r io.Reader) io.Reader {
+	return base32.NewDecoder(e.encoding, r)
+}
+
+// Encode returns the standard encoding of `src`.
+func Encode(src []byte) []byte {
+	return StdEncoding.Encode(src)
+}
+
+// EncodeToString returns the standard encoding of `src` as a string.
+func EncodeToString(src []byte) string {
+	return StdEncoding.EncodeToString(src)
+}
+
+// EncodeString returns the standard encoding of the string `s`.
+func EncodeString(s string) string {
+	return StdEncoding.EncodeString(s)
+}
+
+// Decode returns the bytes encoded by the standard encoding `src`.
+func Decode(src []byte) ([]byte, error) {
+	return StdEncoding.Decode(src)
+}
+
+// DecodeString returns the bytes encoded by the standard encoding `s`.
+func DecodeString(s string) ([]byte, error) {
+	return StdEncoding.DecodeString(s)
+}
+
+// DecodeToString returns the string encoded by the standard encoding `s`.
+func DecodeToString(s string) (string, error) {
+	return StdEncoding.DecodeToString(s)
+}
+
+// NewEncoder returns a stream encoder writing the standard encoding to `w`.
+func NewEncoder(w io.Writer) io.WriteCloser {
+	return StdEncoding.NewEncoder(w)
+}
+
+// NewDecoder returns a stream decoder reading the standard encoding from `r`.
+func NewDecoder(r io.Reader) io.Reader {
+	return StdEncoding.NewDecoder(r)
+}
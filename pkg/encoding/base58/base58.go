@@ -0,0 +1,82 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package base58 implements base58 encoding with the Bitcoin alphabet, which
+// omits 0, O, I and l to avoid visually ambiguous characters, and the
+// Base58Check variant that adds a version byte and a checksum.
+package base58
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/focela/aegis/internal/encoding/radix"
+)
+
+// Alphabet is the Bitcoin base58 alphabet.
+const Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// Errors returned by CheckDecode.
+var (
+	// ErrChecksum is returned when the checksum does not match the payload.
+	ErrChecksum = errors.New("base58: checksum mismatch")
+
+	// ErrInvalidFormat is returned when the input is too short to hold a
+	// version byte and a checksum.
+	ErrInvalidFormat = errors.New("base58: invalid check encoding")
+)
+
+// alphabet converts to and from Alphabet.
+var alphabet = radix.NewAlphabet(Alphabet)
+
+// Encode returns the base58 encoding of `src`. Leading zero bytes are written
+// as '1'.
+func Encode(src []byte) string {
+	return alphabet.Encode(src)
+}
+
+// Decode returns the bytes represented by the base58 string `s`.
+func Decode(s string) ([]byte, error) {
+	b, err := alphabet.Decode(s)
+	if err != nil {
+		return nil, fmt.Errorf("base58: %w", err)
+	}
+	return b, nil
+}
+
+// CheckEncode returns the Base58Check encoding of `payload` prefixed by
+// `version`: the version byte, the payload and the first four bytes of the
+// double SHA-256 of both, base58 encoded.
+func CheckEncode(payload []byte, version byte) string {
+	b := make([]byte, 0, 1+len(payload)+4)
+	b = append(b, version)
+	b = append(b, payload...)
+	sum := checksum(b)
+	return Encode(append(b, sum[:]...))
+}
+
+// CheckDecode decodes the Base58Check string `s`, verifying its checksum, and
+// returns its payload and version byte.
+func CheckDecode(s string) (payload []byte, version byte, err error) {
+	b, err := Decode(s)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(b) < 5 {
+		return nil, 0, ErrInvalidFormat
+	}
+	sum := checksum(b[:len(b)-4])
+	if [4]byte(b[len(b)-4:]) != sum {
+		return nil, 0, ErrChecksum
+	}
+	return b[1 : len(b)-4], b[0], nil
+}
+
+// checksum returns the first four bytes of the double SHA-256 of `b`.
+func checksum(b []byte) [4]byte {
+	first := sha256.Sum256(b)
+	second := sha256.Sum256(first[:])
+	return [4]byte(second[:4])
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package base62 implements base62 encoding with the alphabet 0-9, A-Z, a-z,
+// whose output is safe in URLs, file names and identifiers without escaping.
+//
+// Byte strings are encoded as big-endian integers, with each leading zero byte
+// written as '0'. EncodeUint64 and DecodeUint64 encode numbers directly, which
+// suits short IDs derived from sequences.
+package base62
+
+import (
+	"fmt"
+
+	"github.com/focela/aegis/internal/encoding/radix"
+)
+
+// Alphabet is the base62 alphabet in ASCII order.
+const Alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// alphabet converts to and from Alphabet.
+var alphabet = radix.NewAlphabet(Alphabet)
+
+// Encode returns the base62 encoding of `src`.
+func Encode(src []byte) string {
+	return alphabet.Encode(src)
+}
+
+// Decode returns the bytes represented by the base62 string `s`.
+func Decode(s string) ([]byte, error) {
+	b, err := alphabet.Decode(s)
+	if err != nil {
+		return nil, fmt.Errorf("base62: %w", err)
+	}
+	return b, nil
+}
+
+// EncodeUint64 returns the base62 representation of `n`.
+func EncodeUint64(n uint64) string {
+	return alphabet.EncodeUint64(n)
+}
+
+// DecodeUint64 returns the number represented by the base62 string `s`.
+func DecodeUint64(s string) (uint64, error) {
+	n, err := alphabet.DecodeUint64(s)
+	if err != nil {
+		return 0, fmt.Errorf("base62: %w", err)
+	}
+	return n, nil
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package binary provides helpers to encode values to and decode values from
+// their binary representation.
+//
+// All fixed-size encodings are little-endian. Decoding functions are lenient
+// with short input: missing high-order bytes are treated as zero, so a value
+// encoded with a narrower type decodes unchanged. A Decoder reads a sequence
+// of values from a byte slice without copying it.
+package binary
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ErrInvalidUvarint is returned when a varint is truncated or overflows 64 bits.
+var ErrInvalidUvarint = errors.New("binary: invalid uvarint")
+
+// Encode concatenates the binary representation of `values`.
+//
+// Fixed-size numbers and booleans use their little-endian encoding, with int
+// and uint encoded as 64 bits. Strings and byte slices are written as is.
+// Any other value is written as its fmt.Sprint representation.
+func Encode(values ...interface{}) []byte {
+	buf := new(bytes.Buffer)
+	for _, value := range values {
+		switch v := value.(type) {
+		case nil:
+			continue
+		case int:
+			buf.Write(EncodeInt64(int64(v)))
+		case uint:
+			buf.Write(EncodeUint64(uint64(v)))
+		case string:
+			buf.WriteString(v)
+		case []byte:
+			buf.Write(v)
+		default:
+			if err := binary.Write(buf, binary.LittleEndian, v); err != nil {
+				buf.WriteString(fmt.Sprint(v))
+			}
+		}
+	}
+	return buf.Bytes()
+}
+
+// Decode reads the binary representation of `values` from `b`, in order.
+// Each value must be a pointer to a fixed-size type; *int and *uint read 64
+// bits, matching Encode.
+func Decode(b []byte, values ...interface{}) error {
+	r := bytes.NewReader(b)
+	for _, value := range values {
+		var err error
+		switch v := value.(type) {
+		case *int:
+			var n int64
+			err = binary.Read(r, binary.LittleEndian, &n)
+			*v = int(n)
+		case *uint:
+			var n uint64
+			err = binary.Read(r, binary.LittleEndian, &n)
+			*v = uint(n)
+		default:
+			err = binary.Read(r, binary.LittleEndian, v)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EncodeBool encodes `v` as a single byte, 1 for true and 0 for false.
+func EncodeBool(v bool) []byte {
+	if v {
+		return []byte{1}
+	}
+	return []byte{0}
+}
+
+// DecodeToBool reports whether `b` holds any non-zero byte.
+func DecodeToBool(b []byte) bool {
+	return len(bytes.Trim(b, "\x00")) > 0
+}
+
+// EncodeUint16 encodes `v` as 2 bytes.
+func EncodeUint16(v uint16) []byte {
+	return binary.LittleEndian.AppendUint16(nil, v)
+}
+
+// EncodeUint32 encodes `v` as 4 bytes.
+func EncodeUint32(v uint32) []byte {
+	return binary.LittleEndian.AppendUint32(nil, v)
+}
+
+// EncodeUint64 encodes `v` as 8 bytes.
+func EncodeUint64(v uint64) []byte {
+	return AppendUint64(nil, v)
+}
+
+// AppendUint64 appends the 8-byte encoding of `v` to `dst`.
+func AppendUint64(dst []byte, v uint64) []byte {
+	return binary.LittleEndian.AppendUint64(dst, v)
+}
+
+// EncodeInt64 encodes `v` as 8 bytes.
+func EncodeInt64(v int64) []byte {
+	return EncodeUint64(uint64(v))
+}
+
+// EncodeFloat64 encodes the IEEE 754 bits of `v` as 8 bytes.
+func EncodeFloat64(v float64) []byte {
+	return EncodeUint64(math.Float64bits(v))
+}
+
+// DecodeToUint16 decodes up to the first 2 bytes of `b`.
+func DecodeToUint16(b []byte) uint16 {
+	return binary.LittleEndian.Uint16(fill(b, 2))
+}
+
+// DecodeToUint32 decodes up to the first 4 bytes of `b`.
+func DecodeToUint32(b []byte) uint32 {
+	return binary.LittleEndian.Uint32(fill(b, 4))
+}
+
+// DecodeToUint64 decodes up to the first 8 bytes of `b`.
+func DecodeToUint64(b []byte) uint64 {
+	return binary.LittleEndian.Uint64(fill(b, 8))
+}
+
+// DecodeToInt64 decodes up to the first 8 bytes of `b`.
+func DecodeToInt64(b []byte) int64 {
+	return int64(DecodeToUint64(b))
+}
+
+// DecodeToFloat64 decodes up to the first 8 bytes of `b` as IEEE 754 bits.
+func DecodeToFloat64(b []byte) float64 {
+	return math.Float64frombits(DecodeToUint64(b))
+}
+
+// AppendUvarint appends the variable-length encoding of `v` to `dst`.
+// Small values take fewer bytes, which suits length prefixes.
+func AppendUvarint(dst []byte, v uint64) []byte {
+	return binary.AppendUvarint(dst, v)
+}
+
+// DecodeUvarint decodes a varint from the start of `b` and returns it with
+// the number of bytes read.
+func DecodeUvarint(b []byte) (v uint64, n int, err error) {
+	v, n = binary.Uvarint(b)
+	if n <= 0 {
+		return 0, 0, ErrInvalidUvarint
+	}
+	return v, n, nil
+}
+
+// fill returns `b` truncated or zero-padded to exactly `size` bytes.
+func fill(b []byte, size int) []byte {
+	if len(b) >= size {
+		return b[:size]
+	}
+	padded := make([]byte, size)
+	copy(padded, b)
+	return padded
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package binary
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ErrShortBuffer is returned by a Decoder reading past the end of its input.
+var ErrShortBuffer = errors.New("binary: short buffer")
+
+// Decoder reads little-endian values from a byte slice without copying it,
+// which suits parsing large memory-mapped files. Byte slices it returns
+// alias the input.
+//
+// Errors are sticky: once a read fails, later reads return zero values and
+// Err returns the first error, so a sequence of reads can be checked once.
+type Decoder struct {
+	// b is the input and off the offset of the next read.
+	b   []byte
+	off int
+
+	// err is the first error.
+	err error
+}
+
+// NewDecoder creates and returns a Decoder reading `b`.
+func NewDecoder(b []byte) *Decoder {
+	return &Decoder{b: b}
+}
+
+// Err returns the first error of the reads, or nil.
+func (d *Decoder) Err() error {
+	return d.err
+}
+
+// Offset returns the offset of the next read.
+func (d *Decoder) Offset() int {
+	return d.off
+}
+
+// Len returns the number of unread bytes.
+func (d *Decoder) Len() int {
+	return len(d.b) - d.off
+}
+
+// Seek moves the next read to the offset `off` and clears a read error.
+func (d *Decoder) Seek(off int) error {
+	if off < 0 || off > len(d.b) {
+		return fmt.Errorf("binary: offset %d out of range [0, %d]", off, len(d.b))
+	}
+	d.off, d.err = off, nil
+	return nil
+}
+
+// Skip skips `n` bytes.
+func (d *Decoder) Skip(n int) {
+	d.next(n)
+}
+
+// Bytes returns the next `n` bytes, aliasing the input.
+func (d *Decoder) Bytes(n int) []byte {
+	return d.next(n)
+}
+
+// String returns the next `n` bytes as a string.
+func (d *Decoder) String(n int) string {
+	return string(d.next(n))
+}
+
+// Uint8 reads a byte.
+func (d *Decoder) Uint8() uint8 {
+	if b := d.next(1); b != nil {
+		return b[0]
+	}
+	return 0
+}
+
+// Bool reads a byte, reporting whether it is not zero.
+func (d *Decoder) Bool() bool {
+	return d.Uint8() != 0
+}
+
+// Uint16 reads 2 bytes.
+func (d *Decoder) Uint16() uint16 {
+	if b := d.next(2); b != nil {
+		return binary.LittleEndian.Uint16(b)
+	}
+	return 0
+}
+
+// Uint32 reads 4 bytes.
+func (d *Decoder) Uint32() uint32 {
+	if b := d.next(4); b != nil {
+		return binary.LittleEndian.Uint32(b)
+	}
+	return 0
+}
+
+// Uint64 reads 8 bytes.
+func (d *Decoder) Uint64() uint64 {
+	if b := d.next(8); b != nil {
+		return binary.LittleEndian.Uint64(b)
+	}
+	return 0
+}
+
+// Int64 reads 8 bytes.
+func (d *Decoder) Int64() int64 {
+	return int64(d.Uint64())
+}
+
+// Float64 reads 8 bytes as IEEE 754 bits.
+func (d *Decoder) Float64() float64 {
+	return math.Float64frombits(d.Uint64())
+}
+
+// Uvarint reads a variable-length integer encoded by AppendUvarint.
+func (d *Decoder) Uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n, err := DecodeUvarint(d.b[d.off:])
+	if err != nil {
+		d.err = err
+		return 0
+	}
+	d.off += n
+	return v
+}
+
+// LengthPrefixed reads a uvarint length followed by that many bytes,
+// aliasing the input.
+func (d *Decoder) LengthPrefixed() []byte {
+	n := d.Uvarint()
+	if n > uint64(d.Len()) {
+		d.fail()
+		return nil
+	}
+	return d.next(int(n))
+}
+
+// next returns the next `n` bytes and advances past them, or records
+// ErrShortBuffer and returns nil.
+func (d *Decoder) next(n int) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if n < 0 || n > d.Len() {
+		d.fail()
+		return nil
+	}
+	b := d.b[d.off : d.off+n : d.off+n]
+	d.off += n
+	return b
+}
+
+// fail records a read past the end of the input.
+func (d *Decoder) fail() {
+	if d.err == nil {
+		d.err = fmt.Errorf("%w at offset %d", ErrShortBuffer, d.off)
+	}
+}
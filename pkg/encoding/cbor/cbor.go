@@ -0,0 +1,91 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package cbor provides CBOR (RFC 8949) encoding and decoding.
+//
+// Encode writes the preferred serialization of RFC 8949 section 4.1, while
+// EncodeDeterministic follows the core deterministic encoding requirements of
+// section 4.2, sorting map keys and forbidding indefinite lengths, so the same
+// value always produces the same bytes, as required when the output is signed,
+// e.g. with COSE. Struct fields are named by the `cbor` tag and otherwise by
+// the `json` tag. Decoded documents use the generic representation of the
+// dynamic Json container of pkg/encoding/json.
+package cbor
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/fxamacker/cbor/v2"
+
+	"github.com/focela/aegis/internal/encoding/generic"
+)
+
+var (
+	// encMode writes the preferred serialization.
+	encMode = mustEncMode(cbor.PreferredUnsortedEncOptions())
+
+	// detEncMode writes the core deterministic encoding.
+	detEncMode = mustEncMode(cbor.CoreDetEncOptions())
+
+	// decMode decodes untyped maps with any key type.
+	decMode = mustDecMode(cbor.DecOptions{
+		DefaultMapType: reflect.TypeOf(map[interface{}]interface{}(nil)),
+	})
+)
+
+// Encode returns the CBOR encoding of `value`. json.Number values are written
+// as CBOR numbers and time.Time values as RFC 3339 strings.
+func Encode(value interface{}) ([]byte, error) {
+	return encMode.Marshal(generic.Numbers(value))
+}
+
+// EncodeDeterministic returns the core deterministic CBOR encoding of `value`.
+func EncodeDeterministic(value interface{}) ([]byte, error) {
+	return detEncMode.Marshal(generic.Numbers(value))
+}
+
+// Decode decodes the CBOR value `data` into generic values. Maps decode to
+// map[string]interface{}, with non-string keys converted to strings, byte
+// strings to []byte, and integers to uint64 or int64.
+func Decode(data []byte) (interface{}, error) {
+	var value interface{}
+	if err := decMode.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	return generic.StringKeys(value), nil
+}
+
+// DecodeTo decodes the CBOR value `data` into `pointer`.
+func DecodeTo(data []byte, pointer interface{}) error {
+	return decMode.Unmarshal(data, pointer)
+}
+
+// ToJson converts the CBOR value `data` to JSON.
+func ToJson(data []byte) ([]byte, error) {
+	value, err := Decode(data)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(value)
+}
+
+// mustEncMode builds the encoding mode for `opts`, setting the time format.
+func mustEncMode(opts cbor.EncOptions) cbor.EncMode {
+	opts.Time = cbor.TimeRFC3339Nano
+	mode, err := opts.EncMode()
+	if err != nil {
+		panic(err)
+	}
+	return mode
+}
+
+// mustDecMode builds the decoding mode for `opts`.
+func mustDecMode(opts cbor.DecOptions) cbor.DecMode {
+	mode, err := opts.DecMode()
+	if err != nil {
+		panic(err)
+	}
+	return mode
+}
@@ -0,0 +1,27 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package cbor
+
+import (
+	"github.com/focela/aegis/pkg/encoding/json"
+)
+
+// DecodeJson decodes the CBOR value `data` and returns it as a Json.
+func DecodeJson(data []byte, safe ...bool) (*json.Json, error) {
+	value, err := Decode(data)
+	if err != nil {
+		return nil, err
+	}
+	return json.New(value, safe...), nil
+}
+
+// EncodeJson encodes the document `j` as CBOR, deterministically when
+// `deterministic` is true.
+func EncodeJson(j *json.Json, deterministic ...bool) ([]byte, error) {
+	if len(deterministic) > 0 && deterministic[0] {
+		return EncodeDeterministic(j.Interface())
+	}
+	return Encode(j.Interface())
+}
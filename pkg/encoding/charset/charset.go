@@ -0,0 +1,113 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package charset converts text between character sets, such as GBK, Big5
+// and Shift-JIS, and detects the character set of legacy text.
+//
+// Character sets are named by their WHATWG or IANA labels, case-insensitively,
+// so "gbk", "GB2312", "big5", "shift_jis", "Shift-JIS", "euc-kr" and
+// "windows-1252" are all accepted. Decoding replaces invalid bytes with
+// U+FFFD, while encoding fails on characters the target cannot represent.
+package charset
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/htmlindex"
+	"golang.org/x/text/encoding/ianaindex"
+	"golang.org/x/text/encoding/unicode"
+)
+
+// UTF8 is the name of the UTF-8 character set.
+const UTF8 = "utf-8"
+
+// errUndetected is returned by Normalize when no character set is likely.
+var errUndetected = errors.New("charset: cannot detect the character set")
+
+// Supported reports whether the character set `charset` is supported.
+func Supported(charset string) bool {
+	_, err := getEncoding(charset)
+	return err == nil
+}
+
+// Convert returns `data`, encoded in the character set `srcCharset`,
+// re-encoded in the character set `dstCharset`.
+func Convert(dstCharset, srcCharset string, data []byte) ([]byte, error) {
+	if isUTF8(dstCharset) && isUTF8(srcCharset) {
+		return data, nil
+	}
+	text, err := ToUTF8(srcCharset, data)
+	if err != nil {
+		return nil, err
+	}
+	return FromUTF8(dstCharset, text)
+}
+
+// ConvertString is like Convert for strings.
+func ConvertString(dstCharset, srcCharset string, s string) (string, error) {
+	b, err := Convert(dstCharset, srcCharset, []byte(s))
+	return string(b), err
+}
+
+// ToUTF8 returns `data`, encoded in the character set `srcCharset`, encoded
+// in UTF-8.
+func ToUTF8(srcCharset string, data []byte) ([]byte, error) {
+	enc, err := getEncoding(srcCharset)
+	if err != nil {
+		return nil, err
+	}
+	b, err := enc.NewDecoder().Bytes(data)
+	if err != nil {
+		return nil, fmt.Errorf("charset: decoding %s: %w", srcCharset, err)
+	}
+	return b, nil
+}
+
+// FromUTF8 returns the UTF-8 text `data` encoded in the character set
+// `dstCharset`.
+func FromUTF8(dstCharset string, data []byte) ([]byte, error) {
+	enc, err := getEncoding(dstCharset)
+	if err != nil {
+		return nil, err
+	}
+	b, err := enc.NewEncoder().Bytes(data)
+	if err != nil {
+		return nil, fmt.Errorf("charset: encoding %s: %w", dstCharset, err)
+	}
+	return b, nil
+}
+
+// getEncoding returns the encoding named `charset`.
+func getEncoding(charset string) (encoding.Encoding, error) {
+	name := strings.TrimSpace(charset)
+	if isUTF8(name) {
+		return unicode.UTF8, nil
+	}
+	enc, err := htmlindex.Get(name)
+	if err != nil || enc == nil {
+		enc, err = ianaindex.IANA.Encoding(name)
+	}
+	if err != nil || enc == nil {
+		return nil, fmt.Errorf("charset: unsupported character set %q", charset)
+	}
+	return enc, nil
+}
+
+// isUTF8 reports whether `charset` names UTF-8.
+func isUTF8(charset string) bool {
+	switch strings.ToLower(strings.TrimSpace(charset)) {
+	case "utf-8", "utf8", "":
+		return true
+	}
+	return false
+}
+
+// hasPrefix reports whether `data` starts with `prefix`.
+func hasPrefix(data []byte, prefix ...byte) bool {
+	return bytes.HasPrefix(data, prefix)
+}
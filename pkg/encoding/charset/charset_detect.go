@@ -0,0 +1,168 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package charset
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// candidate is a character set considered by Detect, with the runes that are
+// frequent in text written in it.
+type candidate struct {
+	// name is the character set name.
+	name string
+
+	// score weighs a decoded rune; text scoring higher is more likely.
+	score func(r rune) int
+}
+
+// Frequent characters of Chinese and Korean text, weighing more than other
+// ideographs and syllables, so text decoded in the wrong character set scores
+// lower than text decoded in the right one.
+const (
+	simplifiedCommon  = "的一是不了人我在有他这中大来上个们到说国和地也子时道出而要于就下得可你年生自会那后能对着事其里所去行过家十用发天如然作方成者多日都三小军二无同么经法当起与好看学进种将还分此心前面又定见只主没公从已"
+	traditionalCommon = "的一是不了人我在有他這中大來上個們到說國和地也子時道出而要於就下得可你年生自會那後能對著事其裡所去行過家十用發天如然作方成者多日都三小軍二無同麼經法當起與好看學進種將還分此心前面又定見只主沒公從已"
+	koreanCommon      = "이다는의에가고하을를한지서로기사리도자어수게대나으인정부시아보있것해들요니적주전상면만일세스국오원우그습과학말"
+)
+
+// candidates are the character sets considered by Detect, in order of
+// preference when they score equally.
+var candidates = []candidate{
+	{name: "gbk", score: chineseScore(simplifiedCommon)},
+	{name: "big5", score: chineseScore(traditionalCommon)},
+	{name: "shift_jis", score: japaneseScore},
+	{name: "euc-jp", score: japaneseScore},
+	{name: "euc-kr", score: koreanScore},
+}
+
+// chineseScore returns a scoring function favoring the characters of `common`.
+func chineseScore(common string) func(r rune) int {
+	return func(r rune) int {
+		switch {
+		case strings.ContainsRune(common, r):
+			return 3
+		case isIdeograph(r) || isCJKPunct(r):
+			return 1
+		}
+		return 0
+	}
+}
+
+// japaneseScore scores kana above ideographs, as kana are frequent in any
+// Japanese text.
+func japaneseScore(r rune) int {
+	switch {
+	case unicode.In(r, unicode.Hiragana, unicode.Katakana):
+		return 3
+	case isIdeograph(r) || isCJKPunct(r):
+		return 1
+	}
+	return 0
+}
+
+// koreanScore scores frequent Hangul syllables above other ones.
+func koreanScore(r rune) int {
+	switch {
+	case strings.ContainsRune(koreanCommon, r):
+		return 3
+	case unicode.Is(unicode.Hangul, r) || isCJKPunct(r):
+		return 1
+	}
+	return 0
+}
+
+// isIdeograph reports whether `r` is a CJK unified ideograph of the basic
+// block, the one covering everyday text.
+func isIdeograph(r rune) bool {
+	return r >= 0x4E00 && r <= 0x9FFF
+}
+
+// isCJKPunct reports whether `r` is CJK or full-width punctuation.
+func isCJKPunct(r rune) bool {
+	return (r >= 0x3000 && r <= 0x303F) || (r >= 0xFF00 && r <= 0xFFEF)
+}
+
+// Detect returns the most likely character set of `data`, or an empty string
+// if none is likely. A byte order mark selects UTF-8 or UTF-16, valid UTF-8
+// is reported as UTF8, and other text is tried against GBK, Big5, Shift-JIS,
+// EUC-JP and EUC-KR. Detection is heuristic and is more reliable on longer
+// texts.
+func Detect(data []byte) string {
+	switch {
+	case hasPrefix(data, 0xEF, 0xBB, 0xBF):
+		return UTF8
+	case hasPrefix(data, 0xFF, 0xFE):
+		return "utf-16le"
+	case hasPrefix(data, 0xFE, 0xFF):
+		return "utf-16be"
+	case utf8.Valid(data):
+		return UTF8
+	}
+	var (
+		best      string
+		bestScore float64
+	)
+	for _, c := range candidates {
+		if score, ok := scoreAs(data, c); ok && score > bestScore {
+			best, bestScore = c.name, score
+		}
+	}
+	if bestScore < 1 {
+		return ""
+	}
+	return best
+}
+
+// scoreAs returns the average score of the non-ASCII runes of `data` decoded
+// in the character set of `c`, and false if `data` is not valid in it.
+func scoreAs(data []byte, c candidate) (float64, bool) {
+	text, err := ToUTF8(c.name, data)
+	if err != nil {
+		return 0, false
+	}
+	var total, count int
+	for _, r := range string(text) {
+		if r == utf8.RuneError || (unicode.IsControl(r) && !unicode.IsSpace(r)) {
+			return 0, false
+		}
+		if r >= utf8.RuneSelf {
+			total += c.score(r)
+			count++
+		}
+	}
+	if count == 0 {
+		return 0, false
+	}
+	return float64(total) / float64(count), true
+}
+
+// Normalize returns `data` converted to UTF-8 from its detected character
+// set, along with that character set. UTF-8 input is returned without its
+// byte order mark.
+func Normalize(data []byte) ([]byte, string, error) {
+	charset := Detect(data)
+	switch charset {
+	case "":
+		return nil, "", errUndetected
+
+	case UTF8:
+		return trimBOM(data), UTF8, nil
+	}
+	text, err := ToUTF8(charset, data)
+	if err != nil {
+		return nil, charset, err
+	}
+	return trimBOM(text), charset, nil
+}
+
+// trimBOM returns `data` without a leading UTF-8 byte order mark.
+func trimBOM(data []byte) []byte {
+	if hasPrefix(data, 0xEF, 0xBB, 0xBF) {
+		return data[3:]
+	}
+	return data
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package compress provides one-shot, streaming and file helpers for the
+// gzip, zlib, raw deflate, zstd and snappy formats.
+//
+// Every format is a Compressor, and each has functions operating on byte
+// slices, such as Gzip and UnGzip, on streams, such as GzipStream, and on
+// files, such as GzipFile. Compressing functions take an optional level, one
+// of the level constants, defaulting to DefaultCompression. Formats are also
+// selectable by name through Get, and further formats can be added with
+// Register. All codecs are implemented in Go.
+package compress
+
+import (
+	"bytes"
+	"compress/flate"
+	"fmt"
+	"io"
+	"os"
+)
+
+// Compression levels, shared by all formats.
+const (
+	NoCompression      = flate.NoCompression
+	BestSpeed          = flate.BestSpeed
+	BestCompression    = flate.BestCompression
+	DefaultCompression = flate.DefaultCompression
+	HuffmanOnly        = flate.HuffmanOnly
+)
+
+// Compressor compresses and decompresses data in one format.
+type Compressor interface {
+	// Compress returns the compressed form of `data`.
+	Compress(data []byte) ([]byte, error)
+
+	// Decompress returns the data compressed in `data`.
+	Decompress(data []byte) ([]byte, error)
+
+	// NewWriter returns a writer compressing to `w`. Closing it flushes the
+	// compressed data but does not close `w`.
+	NewWriter(w io.Writer) (io.WriteCloser, error)
+
+	// NewReader returns a reader decompressing from `r`.
+	NewReader(r io.Reader) (io.ReadCloser, error)
+}
+
+// levelOf returns the first of `level`, or DefaultCompression.
+func levelOf(level []int) int {
+	if len(level) > 0 {
+		return level[0]
+	}
+	return DefaultCompression
+}
+
+// compress returns `data` compressed by `c`.
+func compress(c Compressor, data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := compressStream(c, &buf, bytes.NewReader(data)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// decompress returns `data` decompressed by `c`.
+func decompress(c Compressor, data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := decompressStream(c, &buf, bytes.NewReader(data)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// compressStream copies `src` to `dst`, compressed by `c`.
+func compressStream(c Compressor, dst io.Writer, src io.Reader) error {
+	w, err := c.NewWriter(dst)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(w, src); err != nil {
+		w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+// decompressStream copies `src` to `dst`, decompressed by `c`.
+func decompressStream(c Compressor, dst io.Writer, src io.Reader) error {
+	r, err := c.NewReader(src)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	_, err = io.Copy(dst, r)
+	return err
+}
+
+// convertFile writes the file `src`, converted by `convert`, to the file
+// `dst`, which is removed if the conversion fails.
+func convertFile(src, dst string, convert func(dst io.Writer, src io.Reader) error) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if err = convert(out, in); err == nil {
+		err = out.Sync()
+	}
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(dst)
+		return fmt.Errorf("compress: converting %q to %q: %w", src, dst, err)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package compress
+
+import (
+	"compress/flate"
+	"io"
+)
+
+// deflateCompressor is the Compressor of the raw deflate format.
+type deflateCompressor struct {
+	// level is the compression level.
+	level int
+}
+
+// DeflateCompressor returns the Compressor of the raw deflate format,
+// compressing at the optional `level`.
+func DeflateCompressor(level ...int) Compressor {
+	return deflateCompressor{level: levelOf(level)}
+}
+
+// Compress implements Compressor.
+func (c deflateCompressor) Compress(data []byte) ([]byte, error) {
+	return compress(c, data)
+}
+
+// Decompress implements Compressor.
+func (c deflateCompressor) Decompress(data []byte) ([]byte, error) {
+	return decompress(c, data)
+}
+
+// NewWriter implements Compressor.
+func (c deflateCompressor) NewWriter(w io.Writer) (io.WriteCloser, error) {
+	return flate.NewWriter(w, c.level)
+}
+
+// NewReader implements Compressor.
+func (c deflateCompressor) NewReader(r io.Reader) (io.ReadCloser, error) {
+	return flate.NewReader(r), nil
+}
+
+// Deflate returns `data` compressed in the raw deflate format at the optional
+// `level`.
+func Deflate(data []byte, level ...int) ([]byte, error) {
+	return DeflateCompressor(level...).Compress(data)
+}
+
+// UnDeflate returns the data compressed in the raw deflate format in `data`.
+func UnDeflate(data []byte) ([]byte, error) {
+	return DeflateCompressor().Decompress(data)
+}
+
+// DeflateStream copies `src` to `dst`, compressed in the raw deflate format at
+// the optional `level`.
+func DeflateStream(dst io.Writer, src io.Reader, level ...int) error {
+	return compressStream(DeflateCompressor(level...), dst, src)
+}
+
+// UnDeflateStream copies `src`, compressed in the raw deflate format, to `dst`.
+func UnDeflateStream(dst io.Writer, src io.Reader) error {
+	return decompressStream(DeflateCompressor(), dst, src)
+}
+
+// DeflateFile writes the file `src` to the file `dst`, compressed in the raw
+// deflate format at the optional `level`.
+func DeflateFile(src, dst string, level ...int) error {
+	return convertFile(src, dst, func(w io.Writer, r io.Reader) error {
+		return DeflateStream(w, r, level...)
+	})
+}
+
+// UnDeflateFile writes the file `src`, compressed in the raw deflate format, to
+// the file `dst`.
+func UnDeflateFile(src, dst string) error {
+	return convertFile(src, dst, UnDeflateStream)
+}
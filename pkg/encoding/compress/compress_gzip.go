@@ -0,0 +1,77 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package compress
+
+import (
+	"compress/gzip"
+	"io"
+)
+
+// gzipCompressor is the Compressor of the gzip format.
+type gzipCompressor struct {
+	// level is the compression level.
+	level int
+}
+
+// GzipCompressor returns the Compressor of the gzip format, compressing at the
+// optional `level`.
+func GzipCompressor(level ...int) Compressor {
+	return gzipCompressor{level: levelOf(level)}
+}
+
+// Compress implements Compressor.
+func (c gzipCompressor) Compress(data []byte) ([]byte, error) {
+	return compress(c, data)
+}
+
+// Decompress implements Compressor.
+func (c gzipCompressor) Decompress(data []byte) ([]byte, error) {
+	return decompress(c, data)
+}
+
+// NewWriter implements Compressor.
+func (c gzipCompressor) NewWriter(w io.Writer) (io.WriteCloser, error) {
+	return gzip.NewWriterLevel(w, c.level)
+}
+
+// NewReader implements Compressor.
+func (c gzipCompressor) NewReader(r io.Reader) (io.ReadCloser, error) {
+	return gzip.NewReader(r)
+}
+
+// Gzip returns `data` compressed in the gzip format at the optional `level`.
+func Gzip(data []byte, level ...int) ([]byte, error) {
+	return GzipCompressor(level...).Compress(data)
+}
+
+// UnGzip returns the data compressed in the gzip format in `data`.
+func UnGzip(data []byte) ([]byte, error) {
+	return GzipCompressor().Decompress(data)
+}
+
+// GzipStream copies `src` to `dst`, compressed in the gzip format at the
+// optional `level`.
+func GzipStream(dst io.Writer, src io.Reader, level ...int) error {
+	return compressStream(GzipCompressor(level...), dst, src)
+}
+
+// UnGzipStream copies `src`, compressed in the gzip format, to `dst`.
+func UnGzipStream(dst io.Writer, src io.Reader) error {
+	return decompressStream(GzipCompressor(), dst, src)
+}
+
+// GzipFile writes the file `src` to the file `dst`, compressed in the gzip
+// format at the optional `level`.
+func GzipFile(src, dst string, level ...int) error {
+	return convertFile(src, dst, func(w io.Writer, r io.Reader) error {
+		return GzipStream(w, r, level...)
+	})
+}
+
+// UnGzipFile writes the file `src`, compressed in the gzip format, to the file
+// `dst`.
+func UnGzipFile(src, dst string) error {
+	return convertFile(src, dst, UnGzipStream)
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package compress
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Names of the built-in formats.
+const (
+	NameGzip    = "gzip"
+	NameZlib    = "zlib"
+	NameDeflate = "deflate"
+	NameZstd    = "zstd"
+	NameSnappy  = "snappy"
+)
+
+// Factory creates a Compressor compressing at the optional `level`.
+type Factory func(level ...int) Compressor
+
+// registry holds the factories by lower-cased name.
+var registry = struct {
+	sync.RWMutex
+	factories map[string]Factory
+}{
+	factories: map[string]Factory{
+		NameGzip:    GzipCompressor,
+		NameZlib:    ZlibCompressor,
+		NameDeflate: DeflateCompressor,
+		NameZstd:    ZstdCompressor,
+		NameSnappy:  SnappyCompressor,
+	},
+}
+
+// Register makes the format created by `factory` available by `name`, which
+// is case-insensitive, replacing any format of the same name.
+func Register(name string, factory Factory) {
+	registry.Lock()
+	defer registry.Unlock()
+	registry.factories[strings.ToLower(name)] = factory
+}
+
+// Get returns the Compressor of the format named `name`, such as "gzip" or
+// "zstd", compressing at the optional `level`.
+func Get(name string, level ...int) (Compressor, error) {
+	registry.RLock()
+	factory, ok := registry.factories[strings.ToLower(name)]
+	registry.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("compress: unknown format %q", name)
+	}
+	return factory(level...), nil
+}
+
+// Names returns the names of the registered formats in sorted order.
+func Names() []string {
+	registry.RLock()
+	defer registry.RUnlock()
+	names := make([]string, 0, len(registry.factories))
+	for name := range registry.factories {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
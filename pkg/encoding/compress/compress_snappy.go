@@ -0,0 +1,74 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package compress
+
+import (
+	"io"
+
+	"github.com/golang/snappy"
+)
+
+// snappyCompressor is the Compressor of the snappy framing format.
+type snappyCompressor struct{}
+
+// SnappyCompressor returns the Compressor of the snappy framing format. Snappy
+// has a single compression level, so `level` is ignored; it is accepted for
+// symmetry with the other formats.
+func SnappyCompressor(level ...int) Compressor {
+	return snappyCompressor{}
+}
+
+// Compress implements Compressor.
+func (c snappyCompressor) Compress(data []byte) ([]byte, error) {
+	return compress(c, data)
+}
+
+// Decompress implements Compressor.
+func (c snappyCompressor) Decompress(data []byte) ([]byte, error) {
+	return decompress(c, data)
+}
+
+// NewWriter implements Compressor.
+func (c snappyCompressor) NewWriter(w io.Writer) (io.WriteCloser, error) {
+	return snappy.NewBufferedWriter(w), nil
+}
+
+// NewReader implements Compressor.
+func (c snappyCompressor) NewReader(r io.Reader) (io.ReadCloser, error) {
+	return io.NopCloser(snappy.NewReader(r)), nil
+}
+
+// Snappy returns `data` compressed in the snappy framing format.
+func Snappy(data []byte) ([]byte, error) {
+	return SnappyCompressor().Compress(data)
+}
+
+// UnSnappy returns the data compressed in the snappy framing format in `data`.
+func UnSnappy(data []byte) ([]byte, error) {
+	return SnappyCompressor().Decompress(data)
+}
+
+// SnappyStream copies `src` to `dst`, compressed in the snappy framing format.
+func SnappyStream(dst io.Writer, src io.Reader) error {
+	return compressStream(SnappyCompressor(), dst, src)
+}
+
+// UnSnappyStream copies `src`, compressed in the snappy framing format, to
+// `dst`.
+func UnSnappyStream(dst io.Writer, src io.Reader) error {
+	return decompressStream(SnappyCompressor(), dst, src)
+}
+
+// SnappyFile writes the file `src` to the file `dst`, compressed in the
+// snappy framing format.
+func SnappyFile(src, dst string) error {
+	return convertFile(src, dst, SnappyStream)
+}
+
+// UnSnappyFile writes the file `src`, compressed in the snappy framing format,
+// to the file `dst`.
+func UnSnappyFile(src, dst string) error {
+	return convertFile(src, dst, UnSnappyStream)
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package compress
+
+import (
+	"compress/zlib"
+	"io"
+)
+
+// zlibCompressor is the Compressor of the zlib format.
+type zlibCompressor struct {
+	// level is the compression level.
+	level int
+}
+
+// ZlibCompressor returns the Compressor of the zlib format, compressing at the
+// optional `level`.
+func ZlibCompressor(level ...int) Compressor {
+	return zlibCompressor{level: levelOf(level)}
+}
+
+// Compress implements Compressor.
+func (c zlibCompressor) Compress(data []byte) ([]byte, error) {
+	return compress(c, data)
+}
+
+// Decompress implements Compressor.
+func (c zlibCompressor) Decompress(data []byte) ([]byte, error) {
+	return decompress(c, data)
+}
+
+// NewWriter implements Compressor.
+func (c zlibCompressor) NewWriter(w io.Writer) (io.WriteCloser, error) {
+	return zlib.NewWriterLevel(w, c.level)
+}
+
+// NewReader implements Compressor.
+func (c zlibCompressor) NewReader(r io.Reader) (io.ReadCloser, error) {
+	return zlib.NewReader(r)
+}
+
+// Zlib returns `data` compressed in the zlib format at the optional `level`.
+func Zlib(data []byte, level ...int) ([]byte, error) {
+	return ZlibCompressor(level...).Compress(data)
+}
+
+// UnZlib returns the data compressed in the zlib format in `data`.
+func UnZlib(data []byte) ([]byte, error) {
+	return ZlibCompressor().Decompress(data)
+}
+
+// ZlibStream copies `src` to `dst`, compressed in the zlib format at the
+// optional `level`.
+func ZlibStream(dst io.Writer, src io.Reader, level ...int) error {
+	return compressStream(ZlibCompressor(level...), dst, src)
+}
+
+// UnZlibStream copies `src`, compressed in the zlib format, to `dst`.
+func UnZlibStream(dst io.Writer, src io.Reader) error {
+	return decompressStream(ZlibCompressor(), dst, src)
+}
+
+// ZlibFile writes the file `src` to the file `dst`, compressed in the zlib
+// format at the optional `level`.
+func ZlibFile(src, dst string, level ...int) error {
+	return convertFile(src, dst, func(w io.Writer, r io.Reader) error {
+		return ZlibStream(w, r, level...)
+	})
+}
+
+// UnZlibFile writes the file `src`, compressed in the zlib format, to the file
+// `dst`.
+func UnZlibFile(src, dst string) error {
+	return convertFile(src, dst, UnZlibStream)
+}
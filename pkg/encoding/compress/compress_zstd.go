@@ -0,0 +1,96 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package compress
+
+import (
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// zstdCompressor is the Compressor of the zstd format.
+type zstdCompressor struct {
+	// level is the encoder level.
+	level zstd.EncoderLevel
+}
+
+// ZstdCompressor returns the Compressor of the zstd format, compressing at
+// the optional `level`. BestSpeed and NoCompression select the fastest zstd
+// level, BestCompression the best, and other levels are read as zstd levels
+// from 1 to 22.
+func ZstdCompressor(level ...int) Compressor {
+	var l zstd.EncoderLevel
+	switch lv := levelOf(level); lv {
+	case DefaultCompression, HuffmanOnly:
+		l = zstd.SpeedDefault
+	case NoCompression, BestSpeed:
+		l = zstd.SpeedFastest
+	case BestCompression:
+		l = zstd.SpeedBestCompression
+	default:
+		l = zstd.EncoderLevelFromZstd(lv)
+	}
+	return zstdCompressor{level: l}
+}
+
+// Compress implements Compressor.
+func (c zstdCompressor) Compress(data []byte) ([]byte, error) {
+	return compress(c, data)
+}
+
+// Decompress implements Compressor.
+func (c zstdCompressor) Decompress(data []byte) ([]byte, error) {
+	return decompress(c, data)
+}
+
+// NewWriter implements Compressor.
+func (c zstdCompressor) NewWriter(w io.Writer) (io.WriteCloser, error) {
+	return zstd.NewWriter(w, zstd.WithEncoderLevel(c.level))
+}
+
+// NewReader implements Compressor. The reader decodes on the calling
+// goroutine, so abandoning it without Close leaks nothing.
+func (c zstdCompressor) NewReader(r io.Reader) (io.ReadCloser, error) {
+	d, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+	if err != nil {
+		return nil, err
+	}
+	return d.IOReadCloser(), nil
+}
+
+// Zstd returns `data` compressed in the zstd format at the optional `level`.
+func Zstd(data []byte, level ...int) ([]byte, error) {
+	return ZstdCompressor(level...).Compress(data)
+}
+
+// UnZstd returns the data compressed in the zstd format in `data`.
+func UnZstd(data []byte) ([]byte, error) {
+	return ZstdCompressor().Decompress(data)
+}
+
+// ZstdStream copies `src` to `dst`, compressed in the zstd format at the
+// optional `level`.
+func ZstdStream(dst io.Writer, src io.Reader, level ...int) error {
+	return compressStream(ZstdCompressor(level...), dst, src)
+}
+
+// UnZstdStream copies `src`, compressed in the zstd format, to `dst`.
+func UnZstdStream(dst io.Writer, src io.Reader) error {
+	return decompressStream(ZstdCompressor(), dst, src)
+}
+
+// ZstdFile writes the file `src` to the file `dst`, compressed in the zstd
+// format at the optional `level`.
+func ZstdFile(src, dst string, level ...int) error {
+	return convertFile(src, dst, func(w io.Writer, r io.Reader) error {
+		return ZstdStream(w, r, level...)
+	})
+}
+
+// UnZstdFile writes the file `src`, compressed in the zstd format, to the
+// file `dst`.
+func UnZstdFile(src, dst string) error {
+	return convertFile(src, dst, UnZstdStream)
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package csv reads and writes CSV files with a header row, binding rows to
+// structs.
+//
+// Columns are matched to struct fields by name: the `csv` tag is consulted
+// first, then the tags of conv.StructTagPriority, then the Go field name, and
+// finally the fuzzy matching of conv.Struct, so a "user_name" column fills a
+// UserName field. Cells are converted to the field types through pkg/conv.
+// A tag value of "-" excludes a field.
+package csv
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/focela/aegis/pkg/conv"
+)
+
+// TagName is the struct tag consulted first for column names.
+const TagName = "csv"
+
+// field describes a struct field bound to a column.
+type field struct {
+	// index is the index sequence for reflect.Value.FieldByIndex.
+	index []int
+
+	// column is the column name.
+	column string
+
+	// goName is the Go field name.
+	goName string
+}
+
+// fieldCache caches []field by reflect.Type.
+var fieldCache sync.Map
+
+// Unmarshal decodes the CSV document `data`, whose first row is the header,
+// into the slice of structs, or of struct pointers, pointed to by `pointer`.
+func Unmarshal(data []byte, pointer interface{}) error {
+	rv := reflect.ValueOf(pointer)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("csv: destination must be a pointer to a slice, got %T", pointer)
+	}
+	slice := rv.Elem()
+	elemType := slice.Type().Elem()
+	r := NewReader(bytes.NewReader(data))
+	for {
+		item := reflect.New(structType(elemType))
+		if err := r.Scan(item.Interface()); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if elemType.Kind() == reflect.Ptr {
+			slice.Set(reflect.Append(slice, item))
+		} else {
+			slice.Set(reflect.Append(slice, item.Elem()))
+		}
+	}
+}
+
+// Marshal encodes `value`, a slice of structs or struct pointers, as a CSV
+// document with a header row.
+func Marshal(value interface{}) ([]byte, error) {
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil, fmt.Errorf("csv: value must be a slice, got %T", value)
+	}
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	if err := w.WriteHeader(reflect.New(structType(rv.Type().Elem())).Interface()); err != nil {
+		return nil, err
+	}
+	for i := 0; i < rv.Len(); i++ {
+		if err := w.Write(rv.Index(i).Interface()); err != nil {
+			return nil, err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// structType returns `t` with pointers removed, nil if `t` is nil.
+func structType(t reflect.Type) reflect.Type {
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
+
+// cachedFields returns the fields of struct type `t`, computing and caching
+// them on first use.
+func cachedFields(t reflect.Type) []field {
+	if v, ok := fieldCache.Load(t); ok {
+		return v.([]field)
+	}
+	fields := collectFields(t, nil)
+	fieldCache.Store(t, fields)
+	return fields
+}
+
+// collectFields walks `t`, flattening untagged embedded structs.
+func collectFields(t reflect.Type, parentIndex []int) []field {
+	var fields []field
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		index := append(append([]int(nil), parentIndex...), i)
+
+		column := lookupColumn(f)
+		if column == "-" {
+			continue
+		}
+		if f.Anonymous && column == "" && f.Type.Kind() == reflect.Struct {
+			fields = append(fields, collectFields(f.Type, index)...)
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if column == "" {
+			column = f.Name
+		}
+		fields = append(fields, field{index: index, column: column, goName: f.Name})
+	}
+	return fields
+}
+
+// lookupColumn returns the column name from the first tag present on `f`,
+// `csv` first and then those of conv.StructTagPriority.
+func lookupColumn(f reflect.StructField) string {
+	for _, tagName := range append([]string{TagName}, conv.StructTagPriority...) {
+		if tag, ok := f.Tag.Lookup(tagName); ok {
+			name, _, _ := strings.Cut(tag, ",")
+			return name
+		}
+	}
+	return ""
+}
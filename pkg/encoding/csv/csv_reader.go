@@ -0,0 +1,128 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package csv
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"iter"
+	"reflect"
+
+	"github.com/focela/aegis/pkg/conv"
+)
+
+// Reader reads rows from a CSV source whose first row is the header.
+type Reader struct {
+	// reader parses the records.
+	reader *csv.Reader
+
+	// header holds the column names, nil until read.
+	header []string
+}
+
+// NewReader creates and returns a Reader reading from `r`. Rows may have fewer
+// or more cells than the header; missing cells are empty and extra cells are
+// ignored.
+func NewReader(r io.Reader) *Reader {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.ReuseRecord = true
+	return &Reader{reader: reader}
+}
+
+// SetComma changes the field delimiter, ',' by default.
+func (r *Reader) SetComma(comma rune) {
+	r.reader.Comma = comma
+}
+
+// Header returns the column names, reading the header row if needed.
+func (r *Reader) Header() ([]string, error) {
+	if r.header != nil {
+		return r.header, nil
+	}
+	record, err := r.reader.Read()
+	if err != nil {
+		return nil, err
+	}
+	r.header = append([]string(nil), record...)
+	return r.header, nil
+}
+
+// Read returns the next row keyed by column name. It returns io.EOF after the
+// last row.
+func (r *Reader) Read() (map[string]string, error) {
+	header, err := r.Header()
+	if err != nil {
+		return nil, err
+	}
+	record, err := r.reader.Read()
+	if err != nil {
+		return nil, err
+	}
+	row := make(map[string]string, len(header))
+	for i, column := range header {
+		if i < len(record) {
+			row[column] = record[i]
+		} else {
+			row[column] = ""
+		}
+	}
+	return row, nil
+}
+
+// Scan reads the next row into the struct pointed to by `pointer`, converting
+// cells through pkg/conv. It returns io.EOF after the last row.
+func (r *Reader) Scan(pointer interface{}) error {
+	rv := reflect.ValueOf(pointer)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("csv: destination must be a non-nil pointer, got %T", pointer)
+	}
+	row, err := r.Read()
+	if err != nil {
+		return err
+	}
+
+	// Key the cells bound by name to their Go field, leaving the others to
+	// the fuzzy matching of conv.Struct
+	columns := make(map[string]string)
+	for _, f := range cachedFields(structType(rv.Type())) {
+		columns[f.column] = f.goName
+	}
+	params := make(map[string]interface{}, len(row))
+	for column, cell := range row {
+		if goName, ok := columns[column]; ok {
+			params[goName] = cell
+		} else if _, taken := params[column]; !taken {
+			params[column] = cell
+		}
+	}
+	if err = conv.Struct(params, pointer); err != nil {
+		return fmt.Errorf("csv: line %d: %w", r.line(), err)
+	}
+	return nil
+}
+
+// All returns an iterator over the remaining rows, keyed by column name. It
+// stops after yielding the first error other than io.EOF.
+func (r *Reader) All() iter.Seq2[map[string]string, error] {
+	return func(yield func(map[string]string, error) bool) {
+		for {
+			row, err := r.Read()
+			if err == io.EOF {
+				return
+			}
+			if !yield(row, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// line returns the line of the last record read.
+func (r *Reader) line() int {
+	line, _ := r.reader.FieldPos(0)
+	return line
+}
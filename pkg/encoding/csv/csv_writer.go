@@ -0,0 +1,82 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package csv
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/focela/aegis/pkg/conv"
+)
+
+// Writer writes structs as CSV rows.
+type Writer struct {
+	// writer formats the records.
+	writer *csv.Writer
+
+	// headerWritten reports whether the header row was written.
+	headerWritten bool
+}
+
+// NewWriter creates and returns a Writer writing to `w`. Output is buffered
+// until Flush.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{writer: csv.NewWriter(w)}
+}
+
+// SetComma changes the field delimiter, ',' by default.
+func (w *Writer) SetComma(comma rune) {
+	w.writer.Comma = comma
+}
+
+// WriteHeader writes the column names of the struct `value`, or of the struct
+// it points to. Write calls it automatically before the first row.
+func (w *Writer) WriteHeader(value interface{}) error {
+	t := structType(reflect.TypeOf(value))
+	if t == nil || t.Kind() != reflect.Struct {
+		return fmt.Errorf("csv: value must be a struct, got %T", value)
+	}
+	fields := cachedFields(t)
+	header := make([]string, len(fields))
+	for i, f := range fields {
+		header[i] = f.column
+	}
+	w.headerWritten = true
+	return w.writer.Write(header)
+}
+
+// Write writes the struct `value`, or the struct it points to, as a row.
+// Cells are formatted with conv.String; a nil pointer writes empty cells.
+func (w *Writer) Write(value interface{}) error {
+	if !w.headerWritten {
+		if err := w.WriteHeader(value); err != nil {
+			return err
+		}
+	}
+	t := structType(reflect.TypeOf(value))
+	if t == nil || t.Kind() != reflect.Struct {
+		return fmt.Errorf("csv: value must be a struct, got %T", value)
+	}
+	fields := cachedFields(t)
+	rv := reflect.ValueOf(value)
+	for rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	record := make([]string, len(fields))
+	if rv.Kind() == reflect.Struct {
+		for i, f := range fields {
+			record[i] = conv.String(rv.FieldByIndex(f.index).Interface())
+		}
+	}
+	return w.writer.Write(record)
+}
+
+// Flush writes any buffered data and returns the first error encountered.
+func (w *Writer) Flush() error {
+	w.writer.Flush()
+	return w.writer.Error()
+}
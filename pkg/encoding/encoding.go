@@ -0,0 +1,243 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package encoding provides a registry of the document codecs of loom,
+// looked up by name, content type, file extension or content.
+//
+// The built-in codecs are "json", "json5", "yaml", "toml", "xml", "msgpack",
+// "cbor", "ini" and "properties", each backed by the package of the same name below
+// pkg/encoding. Further codecs are added with Register and mapped to content
+// types and extensions with MapContentType and MapExtension, so configuration
+// loaders and HTTP handlers can decode any supported format without switching
+// on it themselves. Decoding into structs follows the struct tags of the
+// package of each format, such as `xml` tags for XML.
+package encoding
+
+import (
+	"fmt"
+	"mime"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/focela/aegis/pkg/conv"
+	"github.com/focela/aegis/pkg/encoding/cbor"
+	"github.com/focela/aegis/pkg/encoding/ini"
+	"github.com/focela/aegis/pkg/encoding/json"
+	"github.com/focela/aegis/pkg/encoding/json5"
+	"github.com/focela/aegis/pkg/encoding/msgpack"
+	"github.com/focela/aegis/pkg/encoding/properties"
+	"github.com/focela/aegis/pkg/encoding/toml"
+	"github.com/focela/aegis/pkg/encoding/xml"
+	"github.com/focela/aegis/pkg/encoding/yaml"
+)
+
+// Codec encodes values to and decodes values from one document format.
+type Codec interface {
+	// Encode returns the encoding of `value`.
+	Encode(value interface{}) ([]byte, error)
+
+	// Decode decodes the document `data` into `pointer`.
+	Decode(data []byte, pointer interface{}) error
+}
+
+// Names of the built-in codecs.
+const (
+	NameJson       = "json"
+	NameJson5      = "json5"
+	NameYaml       = "yaml"
+	NameToml       = "toml"
+	NameXml        = "xml"
+	NameMsgpack    = "msgpack"
+	NameCbor       = "cbor"
+	NameIni        = "ini"
+	NameProperties = "properties"
+)
+
+// funcCodec is a Codec made of two functions.
+type funcCodec struct {
+	encode func(value interface{}) ([]byte, error)
+	decode func(data []byte, pointer interface{}) error
+}
+
+// Encode implements Codec.
+func (c funcCodec) Encode(value interface{}) ([]byte, error) {
+	return c.encode(value)
+}
+
+// Decode implements Codec.
+func (c funcCodec) Decode(data []byte, pointer interface{}) error {
+	return c.decode(data, pointer)
+}
+
+// NewCodec creates a Codec from the functions `encode` and `decode`.
+func NewCodec(
+	encode func(value interface{}) ([]byte, error),
+	decode func(data []byte, pointer interface{}) error,
+) Codec {
+	return funcCodec{encode: encode, decode: decode}
+}
+
+// viaMap returns a decoding function decoding documents to a map with
+// `decode`, for formats without struct binding. Structs are filled through
+// conv.Struct, so string values convert to the field types, and other
+// destinations through a JSON round trip.
+func viaMap(decode func(data []byte) (map[string]interface{}, error)) func(data []byte, pointer interface{}) error {
+	return func(data []byte, pointer interface{}) error {
+		m, err := decode(data)
+		if err != nil {
+			return err
+		}
+		if rv := reflect.ValueOf(pointer); rv.Kind() == reflect.Ptr && rv.Elem().Kind() == reflect.Struct {
+			return conv.Struct(m, pointer)
+		}
+		b, err := json.Encode(m)
+		if err != nil {
+			return err
+		}
+		return json.DecodeTo(b, pointer)
+	}
+}
+
+// registry holds the codecs and their mappings, all keyed in lower case.
+var registry = struct {
+	sync.RWMutex
+	codecs       map[string]Codec
+	contentTypes map[string]string
+	extensions   map[string]string
+}{
+	codecs: map[string]Codec{
+		NameJson:    NewCodec(json.Encode, json.DecodeTo),
+		NameJson5:   NewCodec(json.Encode, json5.DecodeTo),
+		NameYaml:    NewCodec(yaml.Encode, yaml.DecodeTo),
+		NameToml:    NewCodec(toml.Encode, toml.DecodeTo),
+		NameMsgpack: NewCodec(msgpack.Marshal, msgpack.Unmarshal),
+		NameCbor:    NewCodec(cbor.Encode, cbor.DecodeTo),
+		NameXml: NewCodec(func(value interface{}) ([]byte, error) {
+			return xml.Encode(conv.Map(value))
+		}, xml.DecodeTo),
+		NameIni: NewCodec(func(value interface{}) ([]byte, error) {
+			return ini.Encode(conv.Map(value))
+		}, viaMap(ini.Decode)),
+		NameProperties: NewCodec(func(value interface{}) ([]byte, error) {
+			return properties.Encode(conv.Map(value)), nil
+		}, viaMap(properties.Decode)),
+	},
+	contentTypes: map[string]string{
+		"application/json":              NameJson,
+		"text/json":                     NameJson,
+		"application/yaml":              NameYaml,
+		"application/x-yaml":            NameYaml,
+		"text/yaml":                     NameYaml,
+		"text/x-yaml":                   NameYaml,
+		"application/toml":              NameToml,
+		"application/xml":               NameXml,
+		"text/xml":                      NameXml,
+		"application/msgpack":           NameMsgpack,
+		"application/x-msgpack":         NameMsgpack,
+		"application/vnd.msgpack":       NameMsgpack,
+		"application/cbor":              NameCbor,
+		"text/x-java-properties":        NameProperties,
+		"application/x-java-properties": NameProperties,
+	},
+	extensions: map[string]string{
+		".json":       NameJson,
+		".json5":      NameJson5,
+		".yaml":       NameYaml,
+		".yml":        NameYaml,
+		".toml":       NameToml,
+		".xml":        NameXml,
+		".msgpack":    NameMsgpack,
+		".mpk":        NameMsgpack,
+		".cbor":       NameCbor,
+		".ini":        NameIni,
+		".properties": NameProperties,
+	},
+}
+
+// Register makes `codec` available by `name`, which is case-insensitive,
+// replacing any codec of the same name.
+func Register(name string, codec Codec) {
+	registry.Lock()
+	defer registry.Unlock()
+	registry.codecs[strings.ToLower(name)] = codec
+}
+
+// MapContentType maps the media type of `contentType` to the codec `name`.
+func MapContentType(contentType, name string) {
+	registry.Lock()
+	defer registry.Unlock()
+	registry.contentTypes[mediaType(contentType)] = strings.ToLower(name)
+}
+
+// MapExtension maps the file extension `ext`, with or without its leading
+// dot, to the codec `name`.
+func MapExtension(ext, name string) {
+	registry.Lock()
+	defer registry.Unlock()
+	registry.extensions[normalizeExt(ext)] = strings.ToLower(name)
+}
+
+// ByName returns the codec named `name`.
+func ByName(name string) (Codec, error) {
+	registry.RLock()
+	codec, ok := registry.codecs[strings.ToLower(name)]
+	registry.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("encoding: unknown codec %q", name)
+	}
+	return codec, nil
+}
+
+// ByContentType returns the codec of the content type `contentType`, such as
+// "application/json; charset=utf-8". Structured syntax suffixes are
+// recognized, so "application/problem+json" selects the JSON codec.
+func ByContentType(contentType string) (Codec, error) {
+	mt := mediaType(contentType)
+	registry.RLock()
+	name, ok := registry.contentTypes[mt]
+	if !ok {
+		if i := strings.LastIndexByte(mt, '+'); i >= 0 {
+			_, ok = registry.codecs[mt[i+1:]]
+			name = mt[i+1:]
+		}
+	}
+	registry.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("encoding: no codec for content type %q", contentType)
+	}
+	return ByName(name)
+}
+
+// ByExtension returns the codec of the file extension of `file`, a file name
+// or an extension such as ".yaml" or "yaml".
+func ByExtension(file string) (Codec, error) {
+	ext := filepath.Ext(file)
+	if ext == "" {
+		ext = file
+	}
+	registry.RLock()
+	name, ok := registry.extensions[normalizeExt(ext)]
+	registry.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("encoding: no codec for extension of %q", file)
+	}
+	return ByName(name)
+}
+
+// mediaType returns the lower-cased media type of `contentType`, without
+// parameters.
+func mediaType(contentType string) string {
+	if mt, _, err := mime.ParseMediaType(contentType); err == nil {
+		return mt
+	}
+	mt, _, _ := strings.Cut(contentType, ";")
+	return strings.ToLower(strings.TrimSpace(mt))
+}
+
+// normalizeExt returns the lower-cased `ext` with a leading dot.
+func normalizeExt(ext string) string {
+	return "." + strings.ToLower(strings.TrimPrefix(ext, "."))
+}
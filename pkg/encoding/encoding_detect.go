@@ -0,0 +1,90 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package encoding
+
+import (
+	"bytes"
+	"errors"
+	"unicode/utf8"
+
+	"github.com/focela/aegis/pkg/encoding/cbor"
+	"github.com/focela/aegis/pkg/encoding/json"
+	"github.com/focela/aegis/pkg/encoding/json5"
+	"github.com/focela/aegis/pkg/encoding/msgpack"
+	"github.com/focela/aegis/pkg/encoding/toml"
+	"github.com/focela/aegis/pkg/encoding/yaml"
+)
+
+// errUndetected is returned by Detect for content of no known format.
+var errUndetected = errors.New("encoding: cannot detect the format of the content")
+
+// Detect returns the codec of the document `data`, recognizing the JSON,
+// JSON5, XML, TOML and YAML text formats and the MessagePack and CBOR binary
+// formats when the document is a map. INI and properties documents, which
+// also parse as TOML or YAML, are not detected.
+func Detect(data []byte) (Codec, error) {
+	name := DetectName(data)
+	if name == "" {
+		return nil, errUndetected
+	}
+	return ByName(name)
+}
+
+// DetectName returns the name of the codec of the document `data`, as
+// Detect, or an empty string if the format is not recognized.
+func DetectName(data []byte) string {
+	if !utf8.Valid(data) {
+		return detectBinary(data)
+	}
+	text := bytes.TrimSpace(bytes.TrimPrefix(data, []byte("\xEF\xBB\xBF")))
+	if len(text) == 0 {
+		return ""
+	}
+	switch text[0] {
+	case '{', '[':
+		if json.Valid(text) {
+			return NameJson
+		}
+		if _, err := json5.Decode(text); err == nil {
+			return NameJson5
+		}
+	case '<':
+		return NameXml
+	}
+	if _, err := toml.Decode(text); err == nil {
+		return NameToml
+	}
+	if value, err := yaml.Decode(text); err == nil {
+		// Plain text parses as a YAML scalar, so only maps and lists count
+		switch value.(type) {
+		case map[string]interface{}, []interface{}:
+			return NameYaml
+		}
+	}
+	return ""
+}
+
+// detectBinary returns the name of the binary codec of `data`, or an empty
+// string. Only maps are recognized, since the first byte of other values is
+// ambiguous between MessagePack and CBOR.
+func detectBinary(data []byte) string {
+	if len(data) == 0 {
+		return ""
+	}
+	switch b := data[0]; {
+	case b >= 0x80 && b <= 0x8f, b == 0xde, b == 0xdf:
+		var value map[string]interface{}
+		if msgpack.Unmarshal(data, &value) == nil {
+			return NameMsgpack
+		}
+
+	case b >= 0xa0 && b <= 0xbb, b == 0xbf, b == 0xd9:
+		// 0xd9 starts the self-described CBOR tag 55799
+		if _, err := cbor.Decode(data); err == nil {
+			return NameCbor
+		}
+	}
+	return ""
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package hash provides fast non-cryptographic hash functions.
+//
+// The functions are deterministic across processes and platforms, which makes
+// them suitable for sharding, bucketing and consistent placement. They must
+// not be used where resistance to deliberate collisions matters.
+package hash
+
+// FNV-1a parameters.
+const (
+	fnvOffset32 uint32 = 2166136261
+	fnvPrime32  uint32 = 16777619
+	fnvOffset64 uint64 = 14695981039346656037
+	fnvPrime64  uint64 = 1099511628211
+)
+
+// rabinEmpty is the CRC-64-AVRO fingerprint of empty input, also used as
+// the polynomial of its table.
+const rabinEmpty uint64 = 0xc15d213aa4d7a795
+
+// rabinTable is the lookup table of CRC64Avro.
+var rabinTable = func() (table [256]uint64) {
+	for i := range table {
+		fp := uint64(i)
+		for range 8 {
+			fp = (fp >> 1) ^ (rabinEmpty & -(fp & 1))
+		}
+		table[i] = fp
+	}
+	return table
+}()
+
+// BKDR calculates the 32-bit BKDR hash of `data`.
+func BKDR(data []byte) uint32 {
+	var h uint32
+	for _, b := range data {
+		h = h*131 + uint32(b)
+	}
+	return h
+}
+
+// BKDR64 calculates the 64-bit BKDR hash of `data`.
+func BKDR64(data []byte) uint64 {
+	var h uint64
+	for _, b := range data {
+		h = h*131 + uint64(b)
+	}
+	return h
+}
+
+// SDBM calculates the 32-bit SDBM hash of `data`.
+func SDBM(data []byte) uint32 {
+	var h uint32
+	for _, b := range data {
+		h = uint32(b) + (h << 6) + (h << 16) - h
+	}
+	return h
+}
+
+// SDBM64 calculates the 64-bit SDBM hash of `data`.
+func SDBM64(data []byte) uint64 {
+	var h uint64
+	for _, b := range data {
+		h = uint64(b) + (h << 6) + (h << 16) - h
+	}
+	return h
+}
+
+// DJB calculates the 32-bit DJB hash of `data`.
+func DJB(data []byte) uint32 {
+	h := uint32(5381)
+	for _, b := range data {
+		h += (h << 5) + uint32(b)
+	}
+	return h
+}
+
+// DJB64 calculates the 64-bit DJB hash of `data`.
+func DJB64(data []byte) uint64 {
+	h := uint64(5381)
+	for _, b := range data {
+		h += (h << 5) + uint64(b)
+	}
+	return h
+}
+
+// FNV1a calculates the 32-bit FNV-1a hash of `data`.
+func FNV1a(data []byte) uint32 {
+	h := fnvOffset32
+	for _, b := range data {
+		h ^= uint32(b)
+		h *= fnvPrime32
+	}
+	return h
+}
+
+// FNV1a64 calculates the 64-bit FNV-1a hash of `data`.
+func FNV1a64(data []byte) uint64 {
+	h := fnvOffset64
+	for _, b := range data {
+		h ^= uint64(b)
+		h *= fnvPrime64
+	}
+	return h
+}
+
+// FNV1aString64 is like FNV1a64 for a string, without converting it to bytes.
+func FNV1aString64(s string) uint64 {
+	h := fnvOffset64
+	for i := 0; i < len(s); i++ {
+		h ^= uint64(s[i])
+		h *= fnvPrime64
+	}
+	return h
+}
+
+// Mix64 scrambles the bits of `x` with the SplitMix64 finalizer, turning
+// sequential integers into well-distributed hashes.
+func Mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+// CRC64Avro calculates the 64-bit Rabin fingerprint of `data` defined by the
+// Avro specification, used to identify schemas by their parsing canonical
+// form.
+func CRC64Avro(data []byte) uint64 {
+	fp := rabinEmpty
+	for _, b := range data {
+		fp = (fp >> 8) ^ rabinTable[byte(fp)^b]
+	}
+	return fp
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package html provides HTML escaping, entity decoding and an allowlist
+// sanitizer for user-generated content.
+//
+// The sanitizer parses its input with golang.org/x/net/html and keeps only the
+// tags and attributes allowed by a Policy. Disallowed tags are dropped while
+// their text is kept, except for elements such as script and style whose
+// content is dropped as well. URL attributes are checked against the allowed
+// schemes, and all text and attribute values are re-escaped on output.
+package html
+
+import (
+	"html"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	xhtml "golang.org/x/net/html"
+)
+
+// Escape escapes the characters <, >, &, ' and " of `s`.
+func Escape(s string) string {
+	return html.EscapeString(s)
+}
+
+// Unescape decodes the named and numeric character references of `s`, such
+// as "&lt;", "&eacute;" and "&#x41;".
+func Unescape(s string) string {
+	return html.UnescapeString(s)
+}
+
+// EscapeEntities escapes `s` like Escape and additionally writes every
+// non-ASCII character as a numeric character reference, for output whose
+// charset is not UTF-8.
+func EscapeEntities(s string) string {
+	s = html.EscapeString(s)
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range s {
+		if r < utf8.RuneSelf {
+			b.WriteRune(r)
+			continue
+		}
+		b.WriteString("&#")
+		b.WriteString(strconv.Itoa(int(r)))
+		b.WriteByte(';')
+	}
+	return b.String()
+}
+
+// StripTags removes all tags, comments and the content of script and style
+// elements from `s` and returns its text with character references decoded.
+func StripTags(s string) string {
+	var b strings.Builder
+	z := xhtml.NewTokenizer(strings.NewReader(s))
+	skip := 0
+	for {
+		switch z.Next() {
+		case xhtml.ErrorToken:
+			return b.String()
+
+		case xhtml.TextToken:
+			if skip == 0 {
+				b.Write(z.Text())
+			}
+
+		case xhtml.StartTagToken:
+			if name, _ := z.TagName(); rawTextTags[string(name)] {
+				skip++
+			}
+
+		case xhtml.EndTagToken:
+			if name, _ := z.TagName(); rawTextTags[string(name)] && skip > 0 {
+				skip--
+			}
+		}
+	}
+}
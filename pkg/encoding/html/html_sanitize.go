@@ -0,0 +1,241 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package html
+
+import (
+	"net/url"
+	"strings"
+
+	xhtml "golang.org/x/net/html"
+)
+
+// rawTextTags are the elements whose content is dropped along with the tag
+// when they are not allowed.
+var rawTextTags = map[string]bool{
+	"script":   true,
+	"style":    true,
+	"iframe":   true,
+	"noscript": true,
+	"object":   true,
+	"template": true,
+	"textarea": true,
+	"title":    true,
+	"xmp":      true,
+}
+
+// voidTags are the elements that have no end tag.
+var voidTags = map[string]bool{
+	"area": true, "base": true, "br": true, "col": true, "embed": true,
+	"hr": true, "img": true, "input": true, "link": true, "meta": true,
+	"source": true, "track": true, "wbr": true,
+}
+
+// urlAttrs are the attributes whose values are URLs checked against the
+// allowed schemes.
+var urlAttrs = map[string]bool{
+	"action":     true,
+	"background": true,
+	"cite":       true,
+	"formaction": true,
+	"href":       true,
+	"longdesc":   true,
+	"poster":     true,
+	"src":        true,
+}
+
+// Policy is an allowlist of tags, attributes and URL schemes used to sanitize
+// HTML. A Policy is built with its Allow methods and is safe for concurrent
+// use by Sanitize once built.
+type Policy struct {
+	// tags holds the allowed tags.
+	tags map[string]bool
+
+	// attrs holds, by tag, the attributes allowed on that tag.
+	attrs map[string]map[string]bool
+
+	// globalAttrs holds the attributes allowed on every allowed tag.
+	globalAttrs map[string]bool
+
+	// schemes holds the allowed URL schemes. Relative URLs are always allowed.
+	schemes map[string]bool
+}
+
+// NewPolicy creates a Policy allowing no tags, so that Sanitize returns only
+// the escaped text of its input, and the URL schemes http, https and mailto.
+func NewPolicy() *Policy {
+	return &Policy{
+		tags:        make(map[string]bool),
+		attrs:       make(map[string]map[string]bool),
+		globalAttrs: make(map[string]bool),
+		schemes:     map[string]bool{"http": true, "https": true, "mailto": true},
+	}
+}
+
+// UGCPolicy creates a Policy suited to user-generated content: text
+// formatting, lists, tables, quotes, code, links and images, without classes,
+// styles or event handlers.
+func UGCPolicy() *Policy {
+	return NewPolicy().
+		AllowTags(
+			"a", "abbr", "b", "blockquote", "br", "caption", "code", "del",
+			"dd", "div", "dl", "dt", "em", "h1", "h2", "h3", "h4", "h5", "h6",
+			"hr", "i", "img", "ins", "kbd", "li", "mark", "ol", "p", "pre", "q",
+			"s", "small", "span", "strong", "sub", "sup", "table", "tbody",
+			"td", "tfoot", "th", "thead", "tr", "u", "ul",
+		).
+		AllowAttrs("a", "href", "title").
+		AllowAttrs("img", "src", "alt", "title", "width", "height").
+		AllowAttrs("blockquote", "cite").
+		AllowAttrs("q", "cite").
+		AllowAttrs("td", "colspan", "rowspan").
+		AllowAttrs("th", "colspan", "rowspan", "scope").
+		AllowAttrs("ol", "start")
+}
+
+// AllowTags allows the tags `tags`.
+func (p *Policy) AllowTags(tags ...string) *Policy {
+	for _, tag := range tags {
+		p.tags[strings.ToLower(tag)] = true
+	}
+	return p
+}
+
+// AllowAttrs allows the attributes `attrs` on `tag`, and allows `tag`.
+func (p *Policy) AllowAttrs(tag string, attrs ...string) *Policy {
+	tag = strings.ToLower(tag)
+	p.tags[tag] = true
+	if p.attrs[tag] == nil {
+		p.attrs[tag] = make(map[string]bool)
+	}
+	for _, attr := range attrs {
+		p.attrs[tag][strings.ToLower(attr)] = true
+	}
+	return p
+}
+
+// AllowGlobalAttrs allows the attributes `attrs` on every allowed tag.
+func (p *Policy) AllowGlobalAttrs(attrs ...string) *Policy {
+	for _, attr := range attrs {
+		p.globalAttrs[strings.ToLower(attr)] = true
+	}
+	return p
+}
+
+// AllowURLSchemes replaces the allowed URL schemes with `schemes`.
+func (p *Policy) AllowURLSchemes(schemes ...string) *Policy {
+	p.schemes = make(map[string]bool, len(schemes))
+	for _, scheme := range schemes {
+		p.schemes[strings.ToLower(scheme)] = true
+	}
+	return p
+}
+
+// Sanitize returns `s` with the tags and attributes not allowed by the policy
+// removed. Comments, doctypes and processing instructions are always removed,
+// and end tags without a matching start tag are dropped.
+func (p *Policy) Sanitize(s string) string {
+	var (
+		b    strings.Builder
+		z    = xhtml.NewTokenizer(strings.NewReader(s))
+		open []string
+		skip int
+	)
+	for {
+		tt := z.Next()
+		switch tt {
+		case xhtml.ErrorToken:
+			// Close the elements left open, so the output nests properly
+			for i := len(open) - 1; i >= 0; i-- {
+				b.WriteString("</" + open[i] + ">")
+			}
+			return b.String()
+
+		case xhtml.TextToken:
+			if skip == 0 {
+				b.WriteString(xhtml.EscapeString(string(z.Text())))
+			}
+
+		case xhtml.StartTagToken, xhtml.SelfClosingTagToken:
+			token := z.Token()
+			name := token.Data
+			if !p.tags[name] {
+				if rawTextTags[name] && tt == xhtml.StartTagToken {
+					skip++
+				}
+				continue
+			}
+			if skip > 0 {
+				continue
+			}
+			b.WriteString("<" + name)
+			for _, attr := range token.Attr {
+				if value, ok := p.attr(name, attr); ok {
+					b.WriteString(" " + attr.Key + `="` + xhtml.EscapeString(value) + `"`)
+				}
+			}
+			b.WriteString(">")
+			if tt == xhtml.StartTagToken && !voidTags[name] {
+				open = append(open, name)
+			}
+
+		case xhtml.EndTagToken:
+			name, _ := z.TagName()
+			tag := string(name)
+			if !p.tags[tag] {
+				if rawTextTags[tag] && skip > 0 {
+					skip--
+				}
+				continue
+			}
+			// Close the innermost matching element along with those nested in it
+			for i := len(open) - 1; i >= 0; i-- {
+				if open[i] != tag {
+					continue
+				}
+				for j := len(open) - 1; j >= i; j-- {
+					b.WriteString("</" + open[j] + ">")
+				}
+				open = open[:i]
+				break
+			}
+		}
+	}
+}
+
+// attr returns the value of `attr` on `tag` and whether the policy allows it.
+func (p *Policy) attr(tag string, attr xhtml.Attribute) (string, bool) {
+	if attr.Namespace != "" || (!p.globalAttrs[attr.Key] && !p.attrs[tag][attr.Key]) {
+		return "", false
+	}
+	if urlAttrs[attr.Key] && !p.allowURL(attr.Val) {
+		return "", false
+	}
+	return attr.Val, true
+}
+
+// allowURL reports whether the URL `raw` is relative or has an allowed scheme.
+func (p *Policy) allowURL(raw string) bool {
+	// Browsers ignore control characters and spaces inside schemes, as in
+	// "java\tscript:", so they are removed before parsing
+	raw = strings.Map(func(r rune) rune {
+		if r <= ' ' {
+			return -1
+		}
+		return r
+	}, raw)
+	u, err := url.Parse(raw)
+	if err != nil {
+		return false
+	}
+	return u.Scheme == "" || p.schemes[strings.ToLower(u.Scheme)]
+}
+
+// defaultPolicy is the policy used by the package-level Sanitize.
+var defaultPolicy = UGCPolicy()
+
+// Sanitize sanitizes `s` with UGCPolicy.
+func Sanitize(s string) string {
+	return defaultPolicy.Sanitize(s)
+}
@@ -0,0 +1,169 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package ini provides INI encoding and decoding.
+//
+// A document is a list of `key = value` lines, optionally grouped under
+// `[section]` headers. Lines starting with ';' or '#' are comments, `:` may
+// be used instead of `=`, and values may be wrapped in double or single
+// quotes. Keys that appear before the first section are global.
+package ini
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/focela/aegis/pkg/conv"
+)
+
+// Parse decodes the INI document `data` into its sections. Global keys are
+// stored under the section named "". A repeated key keeps its last value.
+func Parse(data []byte) (map[string]map[string]string, error) {
+	sections := make(map[string]map[string]string)
+	current := ""
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if lineNo == 1 {
+			line = strings.TrimPrefix(line, "\ufeff")
+		}
+		if line == "" || line[0] == ';' || line[0] == '#' {
+			continue
+		}
+		if line[0] == '[' {
+			if !strings.HasSuffix(line, "]") {
+				return nil, fmt.Errorf("ini: line %d: unterminated section header", lineNo)
+			}
+			current = strings.TrimSpace(line[1 : len(line)-1])
+			if sections[current] == nil {
+				sections[current] = make(map[string]string)
+			}
+			continue
+		}
+		i := strings.IndexAny(line, "=:")
+		if i <= 0 {
+			return nil, fmt.Errorf("ini: line %d: expected key = value", lineNo)
+		}
+		if sections[current] == nil {
+			sections[current] = make(map[string]string)
+		}
+		key := strings.TrimSpace(line[:i])
+		sections[current][key] = unquote(strings.TrimSpace(line[i+1:]))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return sections, nil
+}
+
+// Decode decodes the INI document `data` into generic values: global keys at
+// the top level and each section as a nested map of strings.
+func Decode(data []byte) (map[string]interface{}, error) {
+	sections, err := Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	m := make(map[string]interface{}, len(sections))
+	for name, entries := range sections {
+		values := make(map[string]interface{}, len(entries))
+		for k, v := range entries {
+			values[k] = v
+		}
+		if name == "" {
+			for k, v := range values {
+				m[k] = v
+			}
+			continue
+		}
+		m[name] = values
+	}
+	return m, nil
+}
+
+// Encode encodes `value` as an INI document. Top-level scalars become global
+// keys and top-level maps become sections; values are converted with
+// conv.String. Sections and keys are written in sorted order. Maps nested
+// below a section cannot be represented and are rejected.
+func Encode(value map[string]interface{}) ([]byte, error) {
+	sections := map[string]map[string]string{"": {}}
+	for k, v := range value {
+		nested, ok := v.(map[string]interface{})
+		if !ok {
+			sections[""][k] = conv.String(v)
+			continue
+		}
+		entries := make(map[string]string, len(nested))
+		for nk, nv := range nested {
+			if _, ok := nv.(map[string]interface{}); ok {
+				return nil, fmt.Errorf("ini: %s.%s: nested sections are not supported", k, nk)
+			}
+			entries[nk] = conv.String(nv)
+		}
+		sections[k] = entries
+	}
+	return EncodeSections(sections), nil
+}
+
+// EncodeSections encodes `sections` as an INI document, the section named ""
+// holding the global keys. Sections and keys are written in sorted order.
+func EncodeSections(sections map[string]map[string]string) []byte {
+	var buf bytes.Buffer
+	writeEntries(&buf, sections[""])
+	names := make([]string, 0, len(sections))
+	for name := range sections {
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		if buf.Len() > 0 {
+			buf.WriteByte('\n')
+		}
+		fmt.Fprintf(&buf, "[%s]\n", name)
+		writeEntries(&buf, sections[name])
+	}
+	return buf.Bytes()
+}
+
+// ToJson converts the INI document `data` to JSON.
+func ToJson(data []byte) ([]byte, error) {
+	value, err := Decode(data)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(value)
+}
+
+// writeEntries writes `entries` as sorted key = value lines.
+func writeEntries(buf *bytes.Buffer, entries map[string]string) {
+	keys := make([]string, 0, len(entries))
+	for k := range entries {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	for _, k := range keys {
+		fmt.Fprintf(buf, "%s = %s\n", k, quote(entries[k]))
+	}
+}
+
+// unquote removes matching double or single quotes around `s`.
+func unquote(s string) string {
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
+
+// quote wraps `s` in double quotes when it would not survive Parse as is.
+func quote(s string) string {
+	if s != strings.TrimSpace(s) || (len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0]) {
+		return `"` + s + `"`
+	}
+	return s
+}
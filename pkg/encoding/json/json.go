@@ -0,0 +1,225 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package json provides JSON encoding helpers and Json, a dynamic container
+// for documents of unknown shape.
+//
+// A Json holds a decoded document made of maps, slices and scalars and gives
+// access to nested values by path, e.g. Get("users.0.name"), instead of chains
+// of type assertions on map[string]interface{}. Values are returned as
+// *gvar.Var and convert lazily through pkg/conv. Numbers are decoded as
+// json.Number so that large integers keep their precision.
+package json
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+
+	"github.com/focela/aegis/internal/concurrency/lock"
+	"github.com/focela/aegis/internal/core/deepcopy"
+	"github.com/focela/aegis/pkg/container/gvar"
+	"github.com/focela/aegis/pkg/conv"
+)
+
+// DefaultSeparator separates the segments of paths.
+const DefaultSeparator = "."
+
+// Json is a dynamic JSON document.
+type Json struct {
+	// mu guards value; it performs no locking in unsafe mode.
+	mu lock.RWMutex
+
+	// value is the root of the document.
+	value interface{}
+
+	// separator separates the segments of paths.
+	separator string
+}
+
+// New creates and returns a Json holding `data`.
+//
+// A string or []byte is decoded as JSON when it holds a JSON object or array,
+// and kept as a scalar otherwise. Maps and slices of generic values are used
+// directly; any other value, such as a struct, is converted through its JSON
+// encoding so that it can be navigated by path.
+//
+// Parameters:
+//   - data: The document content
+//   - safe: Optional boolean enabling concurrent safety
+//
+// Returns:
+//   - A pointer to a newly created Json
+func New(data interface{}, safe ...bool) *Json {
+	j := &Json{
+		mu:        lock.CreateRWMutex(safe...),
+		separator: DefaultSeparator,
+	}
+	j.value = normalize(data)
+	return j
+}
+
+// LoadContent decodes the JSON document `content`, a string or []byte, and
+// returns it as a Json.
+func LoadContent(content interface{}, safe ...bool) (*Json, error) {
+	value, err := Decode(conv.Bytes(content))
+	if err != nil {
+		return nil, err
+	}
+	return newFromValue(value, safe...), nil
+}
+
+// Load reads and decodes the JSON file at `path`.
+func Load(path string, safe ...bool) (*Json, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return LoadContent(content, safe...)
+}
+
+// newFromValue wraps the already decoded `value` without normalizing it.
+func newFromValue(value interface{}, safe ...bool) *Json {
+	return &Json{
+		mu:        lock.CreateRWMutex(safe...),
+		value:     value,
+		separator: DefaultSeparator,
+	}
+}
+
+// normalize converts `data` to the generic representation of a document.
+func normalize(data interface{}) interface{} {
+	switch v := data.(type) {
+	case nil, bool, json.Number, map[string]interface{}, []interface{}:
+		return v
+	case *Json:
+		return v.Interface()
+	case string:
+		return normalizeBytes([]byte(v), v)
+	case []byte:
+		return normalizeBytes(v, v)
+	}
+	b, err := json.Marshal(data)
+	if err != nil {
+		return data
+	}
+	value, err := Decode(b)
+	if err != nil {
+		return data
+	}
+	return value
+}
+
+// normalizeBytes decodes `b` if it holds a JSON object or array, and returns
+// `original` otherwise.
+func normalizeBytes(b []byte, original interface{}) interface{} {
+	trimmed := bytes.TrimSpace(b)
+	if len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[') {
+		if value, err := Decode(trimmed); err == nil {
+			return value
+		}
+	}
+	return original
+}
+
+// SetSeparator changes the separator of path segments, "." by default.
+func (j *Json) SetSeparator(separator string) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.separator = separator
+}
+
+// Interface returns the root value of the document.
+func (j *Json) Interface() interface{} {
+	if j == nil {
+		return nil
+	}
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+	return j.value
+}
+
+// Var returns the root value of the document as a *gvar.Var.
+func (j *Json) Var() *gvar.Var {
+	return gvar.New(j.Interface())
+}
+
+// IsNil reports whether the document is empty.
+func (j *Json) IsNil() bool {
+	return j.Interface() == nil
+}
+
+// Map returns the root value converted to map[string]interface{}.
+func (j *Json) Map() map[string]interface{} {
+	return conv.Map(j.Interface())
+}
+
+// Array returns the root value converted to []interface{}.
+func (j *Json) Array() []interface{} {
+	return conv.Interfaces(j.Interface())
+}
+
+// Scan converts the value at `pattern`, or the whole document when `pattern`
+// is empty, into the struct pointed to by `pointer`.
+func (j *Json) Scan(pointer interface{}, pattern ...string) error {
+	value := j.Interface()
+	if len(pattern) > 0 {
+		value = j.Get(pattern[0]).Val()
+	}
+	return conv.Struct(value, pointer)
+}
+
+// Clone returns a deep copy of the document in the same safety mode.
+func (j *Json) Clone() *Json {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+	return &Json{
+		mu:        lock.CreateRWMutex(j.mu.IsSafe()),
+		value:     deepcopy.Copy(j.value),
+		separator: j.separator,
+	}
+}
+
+// ToJson encodes the document as JSON.
+func (j *Json) ToJson() ([]byte, error) {
+	return Encode(j.Interface())
+}
+
+// ToJsonString encodes the document as a JSON string.
+func (j *Json) ToJsonString() (string, error) {
+	b, err := j.ToJson()
+	return string(b), err
+}
+
+// ToJsonIndent encodes the document as indented JSON.
+func (j *Json) ToJsonIndent() ([]byte, error) {
+	return json.MarshalIndent(j.Interface(), "", "\t")
+}
+
+// String returns the document encoded as JSON, or an empty string if it
+// cannot be encoded.
+func (j *Json) String() string {
+	s, _ := j.ToJsonString()
+	return s
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (j *Json) MarshalJSON() ([]byte, error) {
+	return j.ToJson()
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, replacing the document.
+func (j *Json) UnmarshalJSON(b []byte) error {
+	value, err := Decode(b)
+	if err != nil {
+		return err
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.separator == "" {
+		j.separator = DefaultSeparator
+	}
+	j.value = value
+	return nil
+}
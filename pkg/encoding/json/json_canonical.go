@@ -0,0 +1,167 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package json
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"unicode/utf16"
+)
+
+// EncodeCanonical encodes `value` in the JSON Canonicalization Scheme of
+// RFC 8785, so equal documents encode to the same bytes and can be hashed or
+// signed reproducibly.
+//
+// Object members are sorted by the UTF-16 code units of their names, no
+// insignificant whitespace is written, strings escape only the characters
+// JSON requires, and numbers are written in the shortest form that round-trips
+// through a float64, as in ECMAScript. Integers beyond 2^53 therefore lose
+// precision, and NaN and infinities are an error.
+func EncodeCanonical(value interface{}) ([]byte, error) {
+	var generic interface{}
+	switch v := value.(type) {
+	case *Json:
+		generic = v.Interface()
+	default:
+		b, err := Encode(value)
+		if err != nil {
+			return nil, err
+		}
+		if generic, err = Decode(b); err != nil {
+			return nil, err
+		}
+	}
+	var buf bytes.Buffer
+	if err := writeCanonical(&buf, generic); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// ToJsonCanonical encodes the document in the JSON Canonicalization Scheme;
+// see EncodeCanonical.
+func (j *Json) ToJsonCanonical() ([]byte, error) {
+	return EncodeCanonical(j)
+}
+
+// writeCanonical writes the canonical encoding of the generic `value`.
+func writeCanonical(buf *bytes.Buffer, value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		buf.WriteString("null")
+
+	case bool:
+		buf.WriteString(strconv.FormatBool(v))
+
+	case string:
+		writeCanonicalString(buf, v)
+
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		slices.SortFunc(keys, compareUTF16)
+		buf.WriteByte('{')
+		for i, k := range keys {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			writeCanonicalString(buf, k)
+			buf.WriteByte(':')
+			if err := writeCanonical(buf, v[k]); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+
+	case []interface{}:
+		buf.WriteByte('[')
+		for i, item := range v {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := writeCanonical(buf, item); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+
+	default:
+		// Numbers, as json.Number after decoding or as Go numbers in a Json
+		f, err := strconv.ParseFloat(fmt.Sprint(v), 64)
+		if err != nil {
+			return fmt.Errorf("json: cannot canonicalize %T value %v", value, value)
+		}
+		s, err := formatCanonicalNumber(f)
+		if err != nil {
+			return err
+		}
+		buf.WriteString(s)
+	}
+	return nil
+}
+
+// formatCanonicalNumber formats `f` as ECMAScript's Number.prototype.toString.
+func formatCanonicalNumber(f float64) (string, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return "", fmt.Errorf("json: cannot canonicalize %v", f)
+	}
+	if f == 0 {
+		return "0", nil
+	}
+	format := byte('f')
+	if abs := math.Abs(f); abs < 1e-6 || abs >= 1e21 {
+		format = 'e'
+	}
+	b := strconv.AppendFloat(nil, f, format, -1, 64)
+	if format == 'e' {
+		// Drop the leading zero of two-digit exponents, "1e-07" to "1e-7"
+		if n := len(b); n >= 4 && b[n-4] == 'e' && b[n-2] == '0' {
+			b[n-2] = b[n-1]
+			b = b[:n-1]
+		}
+	}
+	return string(b), nil
+}
+
+// writeCanonicalString writes `s` as a JSON string escaping only quotes,
+// backslashes and control characters. Invalid UTF-8 is written as U+FFFD.
+func writeCanonicalString(buf *bytes.Buffer, s string) {
+	buf.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			buf.WriteString(`\"`)
+		case '\\':
+			buf.WriteString(`\\`)
+		case '\b':
+			buf.WriteString(`\b`)
+		case '\f':
+			buf.WriteString(`\f`)
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\t':
+			buf.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				fmt.Fprintf(buf, `\u%04x`, r)
+			} else {
+				buf.WriteRune(r)
+			}
+		}
+	}
+	buf.WriteByte('"')
+}
+
+// compareUTF16 compares `a` and `b` by their UTF-16 code units.
+func compareUTF16(a, b string) int {
+	return slices.Compare(utf16.Encode([]rune(a)), utf16.Encode([]rune(b)))
+}
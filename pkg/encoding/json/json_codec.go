@@ -0,0 +1,53 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package json
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+)
+
+// Encode encodes `value` as JSON.
+func Encode(value interface{}) ([]byte, error) {
+	return json.Marshal(value)
+}
+
+// EncodeString encodes `value` as a JSON string.
+func EncodeString(value interface{}) (string, error) {
+	b, err := Encode(value)
+	return string(b), err
+}
+
+// Decode decodes the JSON document `data` into generic values: maps, slices,
+// strings, booleans, nil and json.Number.
+func Decode(data []byte) (interface{}, error) {
+	var value interface{}
+	if err := DecodeTo(data, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// DecodeTo decodes the JSON document `data` into `pointer`. Numbers stored in
+// interface{} values are decoded as json.Number. Data after the document,
+// other than white space, is an error.
+func DecodeTo(data []byte, pointer interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(pointer); err != nil {
+		return err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return errors.New("json: unexpected data after top-level value")
+	}
+	return nil
+}
+
+// Valid reports whether `data` is a valid JSON document.
+func Valid(data []byte) bool {
+	return json.Valid(data)
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package json
+
+import (
+	"github.com/focela/aegis/pkg/conv"
+	"github.com/focela/aegis/pkg/encoding/ini"
+	"github.com/focela/aegis/pkg/encoding/json5"
+	"github.com/focela/aegis/pkg/encoding/properties"
+	"github.com/focela/aegis/pkg/encoding/toml"
+	"github.com/focela/aegis/pkg/encoding/xml"
+	"github.com/focela/aegis/pkg/encoding/yaml"
+)
+
+// LoadYaml decodes the YAML document `content`, a string or []byte, and
+// returns it as a Json.
+func LoadYaml(content interface{}, safe ...bool) (*Json, error) {
+	value, err := yaml.Decode(conv.Bytes(content))
+	if err != nil {
+		return nil, err
+	}
+	return newFromValue(value, safe...), nil
+}
+
+// ToYaml encodes the document as YAML.
+func (j *Json) ToYaml() ([]byte, error) {
+	return yaml.Encode(j.Interface())
+}
+
+// LoadToml decodes the TOML document `content`, a string or []byte, and
+// returns it as a Json.
+func LoadToml(content interface{}, safe ...bool) (*Json, error) {
+	value, err := toml.Decode(conv.Bytes(content))
+	if err != nil {
+		return nil, err
+	}
+	return newFromValue(value, safe...), nil
+}
+
+// ToToml encodes the document, which must be an object, as TOML.
+func (j *Json) ToToml() ([]byte, error) {
+	return toml.Encode(j.Interface())
+}
+
+// LoadIni decodes the INI document `content`, a string or []byte, and
+// returns it as a Json whose sections are nested objects.
+func LoadIni(content interface{}, safe ...bool) (*Json, error) {
+	value, err := ini.Decode(conv.Bytes(content))
+	if err != nil {
+		return nil, err
+	}
+	return newFromValue(value, safe...), nil
+}
+
+// ToIni encodes the document, an object of global keys and sections, as INI.
+func (j *Json) ToIni() ([]byte, error) {
+	return ini.Encode(j.Map())
+}
+
+// LoadXml decodes the XML document `content`, a string or []byte, and returns
+// it as a Json holding the root element. See pkg/encoding/xml for the mapping
+// of attributes and repeated elements.
+func LoadXml(content interface{}, safe ...bool) (*Json, error) {
+	value, err := xml.Decode(conv.Bytes(content))
+	if err != nil {
+		return nil, err
+	}
+	return newFromValue(value, safe...), nil
+}
+
+// ToXml encodes the document, an object, as XML, wrapped in `rootTag` when given.
+func (j *Json) ToXml(rootTag ...string) ([]byte, error) {
+	return xml.Encode(j.Map(), rootTag...)
+}
+
+// LoadProperties decodes the Java properties document `content`, a string or
+// []byte, and returns it as a Json in which dotted keys are nested objects.
+func LoadProperties(content interface{}, safe ...bool) (*Json, error) {
+	value, err := properties.Decode(conv.Bytes(content))
+	if err != nil {
+		return nil, err
+	}
+	return newFromValue(value, safe...), nil
+}
+
+// ToProperties encodes the document, an object, as a Java properties
+// document with dotted keys.
+func (j *Json) ToProperties() []byte {
+	return properties.Encode(j.Map())
+}
+
+// LoadJson5 decodes the JSON5 document `content`, a string or []byte, and
+// returns it as a Json. Comments, trailing commas and unquoted keys are
+// accepted; see pkg/encoding/json5.
+func LoadJson5(content interface{}, safe ...bool) (*Json, error) {
+	value, err := json5.Decode(conv.Bytes(content))
+	if err != nil {
+		return nil, err
+	}
+	return newFromValue(value, safe...), nil
+}
@@ -0,0 +1,447 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package json
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/focela/aegis/internal/core/deepcopy"
+	"github.com/focela/aegis/pkg/conv"
+)
+
+// Patch operations of RFC 6902.
+const (
+	OpAdd     = "add"
+	OpRemove  = "remove"
+	OpReplace = "replace"
+	OpMove    = "move"
+	OpCopy    = "copy"
+	OpTest    = "test"
+)
+
+// Operation is an operation of a JSON Patch. Paths are JSON Pointers
+// (RFC 6901), such as "/users/0/name", not the dotted paths of Get.
+type Operation struct {
+	// Op is the operation, one of the Op constants.
+	Op string `json:"op"`
+
+	// Path is the location the operation applies to.
+	Path string `json:"path"`
+
+	// From is the source location of move and copy operations.
+	From string `json:"from,omitempty"`
+
+	// Value is the value of add, replace and test operations.
+	Value interface{} `json:"value,omitempty"`
+}
+
+// MarshalJSON implements the json.Marshaler interface, writing the value of
+// add, replace and test operations even when it is null.
+func (o Operation) MarshalJSON() ([]byte, error) {
+	type operation Operation
+	if o.Value != nil || (o.Op != OpAdd && o.Op != OpReplace && o.Op != OpTest) {
+		return json.Marshal(operation(o))
+	}
+	return json.Marshal(struct {
+		operation
+		Value interface{} `json:"value"`
+	}{operation: operation(o)})
+}
+
+// Patch is a JSON Patch document (RFC 6902).
+type Patch []Operation
+
+// DecodePatch decodes the JSON Patch document `data`.
+func DecodePatch(data []byte) (Patch, error) {
+	var patch Patch
+	if err := DecodeTo(data, &patch); err != nil {
+		return nil, err
+	}
+	return patch, nil
+}
+
+// errPathNotFound is returned for operations on missing locations.
+var errPathNotFound = errors.New("path not found")
+
+// ApplyPatch applies the operations of `patch` to the document, in order.
+// The patch is atomic: if an operation fails, including a failed test, the
+// document is left unchanged.
+func (j *Json) ApplyPatch(patch Patch) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	root := deepcopy.Copy(j.value)
+	for i, op := range patch {
+		var err error
+		if root, err = applyOperation(root, op); err != nil {
+			return fmt.Errorf("json: patch operation %d (%s %q): %w", i, op.Op, op.Path, err)
+		}
+	}
+	j.value = root
+	return nil
+}
+
+// MergePatch applies the JSON Merge Patch (RFC 7386) `patch`, a string or
+// []byte holding JSON or a value such as a map: members of `patch` replace
+// those of the document, recursively for objects, and null members remove
+// them.
+func (j *Json) MergePatch(patch interface{}) error {
+	if b, ok := patch.([]byte); ok {
+		patch = string(b)
+	}
+	if s, ok := patch.(string); ok {
+		value, err := Decode([]byte(s))
+		if err != nil {
+			return fmt.Errorf("json: invalid merge patch: %w", err)
+		}
+		patch = value
+	}
+	patch = normalize(patch)
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.value = mergePatch(deepcopy.Copy(j.value), deepcopy.Copy(patch))
+	return nil
+}
+
+// Diff returns a patch turning the document `from` into the document `to`.
+// Objects and arrays are compared member by member; arrays of different
+// lengths gain or lose elements at their end.
+func Diff(from, to *Json) Patch {
+	var patch Patch
+	diffValues(&patch, "", from.Interface(), to.Interface())
+	return patch
+}
+
+// applyOperation applies `op` to `root` and returns the new root.
+func applyOperation(root interface{}, op Operation) (interface{}, error) {
+	path, err := parsePointer(op.Path)
+	if err != nil {
+		return nil, err
+	}
+	switch op.Op {
+	case OpAdd:
+		return addAt(root, path, normalize(op.Value))
+
+	case OpRemove:
+		root, _, err = removeAt(root, path)
+		return root, err
+
+	case OpReplace:
+		if len(path) == 0 {
+			return normalize(op.Value), nil
+		}
+		if root, _, err = removeAt(root, path); err != nil {
+			return nil, err
+		}
+		return addAt(root, path, normalize(op.Value))
+
+	case OpMove, OpCopy:
+		from, err := parsePointer(op.From)
+		if err != nil {
+			return nil, err
+		}
+		var value interface{}
+		if op.Op == OpMove {
+			if len(from) < len(path) && slices.Equal(from, path[:len(from)]) {
+				return nil, fmt.Errorf("cannot move %q into itself", op.From)
+			}
+			root, value, err = removeAt(root, from)
+		} else {
+			value, err = getAt(root, from)
+			value = deepcopy.Copy(value)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("from %q: %w", op.From, err)
+		}
+		return addAt(root, path, value)
+
+	case OpTest:
+		value, err := getAt(root, path)
+		if err != nil {
+			return nil, err
+		}
+		if !equalValues(value, normalize(op.Value)) {
+			return nil, errors.New("test failed")
+		}
+		return root, nil
+	}
+	return nil, fmt.Errorf("unknown operation %q", op.Op)
+}
+
+// parsePointer splits the JSON Pointer `pointer` into its unescaped tokens.
+func parsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if pointer[0] != '/' {
+		return nil, fmt.Errorf("invalid JSON pointer %q", pointer)
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+// escapeToken escapes `token` for use in a JSON Pointer.
+func escapeToken(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+// arrayIndex parses the array index `token` for an array of `length`
+// elements. "-" is accepted, as `length`, only when `allowEnd` is true.
+func arrayIndex(token string, length int, allowEnd bool) (int, error) {
+	if token == "-" && allowEnd {
+		return length, nil
+	}
+	i, err := strconv.Atoi(token)
+	if err != nil || i < 0 || (len(token) > 1 && token[0] == '0') {
+		return 0, fmt.Errorf("invalid array index %q", token)
+	}
+	limit := length - 1
+	if allowEnd {
+		limit = length
+	}
+	if i > limit {
+		return 0, fmt.Errorf("array index %d out of range", i)
+	}
+	return i, nil
+}
+
+// getAt returns the value at `path` below `node`.
+func getAt(node interface{}, path []string) (interface{}, error) {
+	for _, token := range path {
+		switch n := node.(type) {
+		case map[string]interface{}:
+			value, ok := n[token]
+			if !ok {
+				return nil, errPathNotFound
+			}
+			node = value
+
+		case []interface{}:
+			i, err := arrayIndex(token, len(n), false)
+			if err != nil {
+				return nil, err
+			}
+			node = n[i]
+
+		default:
+			return nil, errPathNotFound
+		}
+	}
+	return node, nil
+}
+
+// addAt adds `value` at `path` below `node`, inserting into arrays, and
+// returns the updated node.
+func addAt(node interface{}, path []string, value interface{}) (interface{}, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	token, last := path[0], len(path) == 1
+	switch n := node.(type) {
+	case map[string]interface{}:
+		if last {
+			n[token] = value
+			return n, nil
+		}
+		child, ok := n[token]
+		if !ok {
+			return nil, errPathNotFound
+		}
+		child, err := addAt(child, path[1:], value)
+		if err != nil {
+			return nil, err
+		}
+		n[token] = child
+		return n, nil
+
+	case []interface{}:
+		i, err := arrayIndex(token, len(n), last)
+		if err != nil {
+			return nil, err
+		}
+		if last {
+			return slices.Insert(n, i, value), nil
+		}
+		if n[i], err = addAt(n[i], path[1:], value); err != nil {
+			return nil, err
+		}
+		return n, nil
+	}
+	return nil, errPathNotFound
+}
+
+// removeAt removes the value at `path` below `node` and returns the updated
+// node and the removed value. The root itself cannot be removed.
+func removeAt(node interface{}, path []string) (interface{}, interface{}, error) {
+	if len(path) == 0 {
+		return nil, nil, errors.New("cannot remove the document root")
+	}
+	token, last := path[0], len(path) == 1
+	var removed interface{}
+	switch n := node.(type) {
+	case map[string]interface{}:
+		child, ok := n[token]
+		if !ok {
+			return nil, nil, errPathNotFound
+		}
+		if last {
+			delete(n, token)
+			return n, child, nil
+		}
+		child, removed, err := removeAt(child, path[1:])
+		if err != nil {
+			return nil, nil, err
+		}
+		n[token] = child
+		return n, removed, nil
+
+	case []interface{}:
+		i, err := arrayIndex(token, len(n), false)
+		if err != nil {
+			return nil, nil, err
+		}
+		if last {
+			removed = n[i]
+			return slices.Delete(n, i, i+1), removed, nil
+		}
+		if n[i], removed, err = removeAt(n[i], path[1:]); err != nil {
+			return nil, nil, err
+		}
+		return n, removed, nil
+	}
+	return nil, nil, errPathNotFound
+}
+
+// mergePatch applies the merge patch `patch` to `target` and returns the
+// result.
+func mergePatch(target, patch interface{}) interface{} {
+	p, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+	t, ok := target.(map[string]interface{})
+	if !ok {
+		t = make(map[string]interface{}, len(p))
+	}
+	for k, v := range p {
+		if v == nil {
+			delete(t, k)
+		} else {
+			t[k] = mergePatch(t[k], v)
+		}
+	}
+	return t
+}
+
+// diffValues appends to `patch` the operations turning `from` into `to`,
+// both located at `pointer`.
+func diffValues(patch *Patch, pointer string, from, to interface{}) {
+	switch f := from.(type) {
+	case map[string]interface{}:
+		t, ok := to.(map[string]interface{})
+		if !ok {
+			break
+		}
+		keys := make([]string, 0, len(f)+len(t))
+		for k := range f {
+			keys = append(keys, k)
+		}
+		for k := range t {
+			if _, ok := f[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		slices.Sort(keys)
+		for _, k := range keys {
+			child := pointer + "/" + escapeToken(k)
+			fv, inFrom := f[k]
+			tv, inTo := t[k]
+			switch {
+			case !inTo:
+				*patch = append(*patch, Operation{Op: OpRemove, Path: child})
+			case !inFrom:
+				*patch = append(*patch, Operation{Op: OpAdd, Path: child, Value: deepcopy.Copy(tv)})
+			default:
+				diffValues(patch, child, fv, tv)
+			}
+		}
+		return
+
+	case []interface{}:
+		t, ok := to.([]interface{})
+		if !ok {
+			break
+		}
+		common := min(len(f), len(t))
+		for i := 0; i < common; i++ {
+			diffValues(patch, pointer+"/"+strconv.Itoa(i), f[i], t[i])
+		}
+		// Remove from the end, so earlier indexes stay valid
+		for i := len(f) - 1; i >= common; i-- {
+			*patch = append(*patch, Operation{Op: OpRemove, Path: pointer + "/" + strconv.Itoa(i)})
+		}
+		for i := common; i < len(t); i++ {
+			*patch = append(*patch, Operation{Op: OpAdd, Path: pointer + "/-", Value: deepcopy.Copy(t[i])})
+		}
+		return
+	}
+	if !equalValues(from, to) {
+		*patch = append(*patch, Operation{Op: OpReplace, Path: pointer, Value: deepcopy.Copy(to)})
+	}
+}
+
+// equalValues reports whether the generic values `a` and `b` are equal,
+// comparing numbers by value, so 1 equals 1.0.
+func equalValues(a, b interface{}) bool {
+	switch x := a.(type) {
+	case map[string]interface{}:
+		y, ok := b.(map[string]interface{})
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for k, v := range x {
+			w, ok := y[k]
+			if !ok || !equalValues(v, w) {
+				return false
+			}
+		}
+		return true
+
+	case []interface{}:
+		y, ok := b.([]interface{})
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for i := range x {
+			if !equalValues(x[i], y[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	if fa, ok := number(a); ok {
+		fb, ok := number(b)
+		return ok && fa.Cmp(fb) == 0
+	}
+	return a == b
+}
+
+// number returns the numeric value of `value`, if it is a number.
+func number(value interface{}) (*big.Float, bool) {
+	switch value.(type) {
+	case json.Number, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		f, ok := new(big.Float).SetString(conv.String(value))
+		return f, ok
+	}
+	return nil, false
+}
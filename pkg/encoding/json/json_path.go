@@ -0,0 +1,235 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package json
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/focela/aegis/internal/core/deepcopy"
+	"github.com/focela/aegis/pkg/container/gvar"
+)
+
+// Get returns the value at `pattern`, a path such as "users.0.name" whose
+// numeric segments index arrays. An empty pattern or a lone separator selects
+// the whole document. If nothing is found, it returns `def` when given and a
+// Var holding nil otherwise.
+func (j *Json) Get(pattern string, def ...interface{}) *gvar.Var {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+	if value, ok := lookup(j.value, j.segments(pattern)); ok {
+		return gvar.New(value)
+	}
+	if len(def) > 0 {
+		return gvar.New(def[0])
+	}
+	return gvar.New(nil)
+}
+
+// GetJson returns a deep copy of the value at `pattern` as a Json in the
+// same safety mode, or nil if nothing is found. Writes to either document
+// do not affect the other.
+func (j *Json) GetJson(pattern string) *Json {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+	value, ok := lookup(j.value, j.segments(pattern))
+	if !ok {
+		return nil
+	}
+	sub := newFromValue(deepcopy.Copy(value), j.mu.IsSafe())
+	sub.separator = j.separator
+	return sub
+}
+
+// Contains reports whether a value exists at `pattern`.
+func (j *Json) Contains(pattern string) bool {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+	_, ok := lookup(j.value, j.segments(pattern))
+	return ok
+}
+
+// Len returns the number of elements of the array or object at `pattern`,
+// or -1 if there is none.
+func (j *Json) Len(pattern string) int {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+	value, _ := lookup(j.value, j.segments(pattern))
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return len(v)
+	case []interface{}:
+		return len(v)
+	default:
+		return -1
+	}
+}
+
+// Set stores `value` at `pattern`, creating missing objects and arrays along
+// the way: a missing container is an array when the next segment is numeric
+// and an object otherwise. An array index may be at most the length of the
+// array, which appends an element. Setting below a scalar is an error.
+func (j *Json) Set(pattern string, value interface{}) error {
+	value = normalize(value)
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	root, err := setPath(j.value, j.segments(pattern), value)
+	if err != nil {
+		return fmt.Errorf("json: cannot set %q: %w", pattern, err)
+	}
+	j.value = root
+	return nil
+}
+
+// Append adds `values` to the end of the array at `pattern`, creating the
+// array if nothing exists there.
+func (j *Json) Append(pattern string, values ...interface{}) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	segs := j.segments(pattern)
+	current, _ := lookup(j.value, segs)
+	array, ok := current.([]interface{})
+	if !ok && current != nil {
+		return fmt.Errorf("json: cannot append to %q: value is %T", pattern, current)
+	}
+	for _, v := range values {
+		array = append(array, normalize(v))
+	}
+	root, err := setPath(j.value, segs, array)
+	if err != nil {
+		return fmt.Errorf("json: cannot append to %q: %w", pattern, err)
+	}
+	j.value = root
+	return nil
+}
+
+// Remove deletes the value at `pattern` and reports whether it existed.
+// Removing an array element shifts the following elements down.
+func (j *Json) Remove(pattern string) bool {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	segs := j.segments(pattern)
+	if len(segs) == 0 {
+		removed := j.value != nil
+		j.value = nil
+		return removed
+	}
+	root, removed := removePath(j.value, segs)
+	j.value = root
+	return removed
+}
+
+// segments splits `pattern` into path segments.
+func (j *Json) segments(pattern string) []string {
+	if pattern == "" || pattern == j.separator {
+		return nil
+	}
+	return strings.Split(pattern, j.separator)
+}
+
+// lookup returns the value reached by following `segs` from `value`.
+func lookup(value interface{}, segs []string) (interface{}, bool) {
+	for _, seg := range segs {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			var ok bool
+			if value, ok = v[seg]; !ok {
+				return nil, false
+			}
+		case []interface{}:
+			i, err := strconv.Atoi(seg)
+			if err != nil || i < 0 || i >= len(v) {
+				return nil, false
+			}
+			value = v[i]
+		default:
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+// setPath stores `value` at `segs` below `container` and returns the
+// container, which is new when it had to be created or grown.
+func setPath(container interface{}, segs []string, value interface{}) (interface{}, error) {
+	if len(segs) == 0 {
+		return value, nil
+	}
+	seg, rest := segs[0], segs[1:]
+	if container == nil {
+		if _, err := strconv.Atoi(seg); err == nil {
+			container = []interface{}{}
+		} else {
+			container = map[string]interface{}{}
+		}
+	}
+	switch c := container.(type) {
+	case map[string]interface{}:
+		child, err := setPath(c[seg], rest, value)
+		if err != nil {
+			return nil, err
+		}
+		c[seg] = child
+		return c, nil
+
+	case []interface{}:
+		i, err := strconv.Atoi(seg)
+		if err != nil || i < 0 {
+			return nil, fmt.Errorf("invalid array index %q", seg)
+		}
+		// Indexes beyond the end would allocate up to the index
+		if i > len(c) {
+			return nil, fmt.Errorf("array index %d out of range [0:%d]", i, len(c))
+		}
+		if i == len(c) {
+			c = append(c, nil)
+		}
+		child, err := setPath(c[i], rest, value)
+		if err != nil {
+			return nil, err
+		}
+		c[i] = child
+		return c, nil
+
+	default:
+		return nil, fmt.Errorf("segment %q is below a %T", seg, container)
+	}
+}
+
+// removePath deletes the value at non-empty `segs` below `container` and
+// returns the possibly shrunk container.
+func removePath(container interface{}, segs []string) (interface{}, bool) {
+	seg, rest := segs[0], segs[1:]
+	switch c := container.(type) {
+	case map[string]interface{}:
+		child, ok := c[seg]
+		if !ok {
+			return c, false
+		}
+		if len(rest) == 0 {
+			delete(c, seg)
+			return c, true
+		}
+		child, removed := removePath(child, rest)
+		c[seg] = child
+		return c, removed
+
+	case []interface{}:
+		i, err := strconv.Atoi(seg)
+		if err != nil || i < 0 || i >= len(c) {
+			return c, false
+		}
+		if len(rest) == 0 {
+			return append(c[:i], c[i+1:]...), true
+		}
+		child, removed := removePath(c[i], rest)
+		c[i] = child
+		return c, removed
+
+	default:
+		return container, false
+	}
+}
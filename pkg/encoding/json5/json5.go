@@ -0,0 +1,424 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package json5 decodes JSON5, a lenient superset of JSON suited to
+// human-edited configuration files.
+//
+// On top of JSON, documents may contain line comments starting with "//" or
+// '#', block comments, trailing commas, unquoted identifier keys, strings in
+// single quotes, line continuations in strings, and hexadecimal numbers or
+// numbers with a leading '+' or a leading or trailing decimal point. Decoded
+// documents use the generic representation of the dynamic Json container of
+// pkg/encoding/json, with numbers as json.Number; Infinity and NaN, which
+// json.Number cannot hold, decode as float64.
+package json5
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// Decode decodes the JSON5 document `data` into generic values: maps,
+// slices, strings, booleans, nil and json.Number.
+func Decode(data []byte) (interface{}, error) {
+	p := &parser{data: data}
+	p.skipSpace()
+	value, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	if p.pos < len(p.data) {
+		return nil, p.errorf("unexpected %q after the document", p.data[p.pos])
+	}
+	return value, nil
+}
+
+// DecodeTo decodes the JSON5 document `data` into `pointer`, honoring `json`
+// struct tags.
+func DecodeTo(data []byte, pointer interface{}) error {
+	b, err := ToJson(data)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, pointer)
+}
+
+// ToJson converts the JSON5 document `data` to JSON.
+func ToJson(data []byte) ([]byte, error) {
+	value, err := Decode(data)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(value)
+}
+
+// parser is the state of the decoding of a document.
+type parser struct {
+	// data is the document.
+	data []byte
+
+	// pos is the offset of the next byte to read.
+	pos int
+}
+
+// errorf returns an error located at the current position.
+func (p *parser) errorf(format string, args ...interface{}) error {
+	line, col := 1, 1
+	for _, b := range p.data[:min(p.pos, len(p.data))] {
+		if b == '\n' {
+			line, col = line+1, 1
+		} else {
+			col++
+		}
+	}
+	return fmt.Errorf("json5: line %d, column %d: %s", line, col, fmt.Sprintf(format, args...))
+}
+
+// skipSpace skips white space and comments.
+func (p *parser) skipSpace() {
+	for p.pos < len(p.data) {
+		switch c := p.data[p.pos]; {
+		case c == '#' || (c == '/' && p.peek(1) == '/'):
+			for p.pos < len(p.data) && p.data[p.pos] != '\n' {
+				p.pos++
+			}
+
+		case c == '/' && p.peek(1) == '*':
+			end := strings.Index(string(p.data[p.pos+2:]), "*/")
+			if end < 0 {
+				p.pos = len(p.data)
+				return
+			}
+			p.pos += end + 4
+
+		case c < utf8.RuneSelf:
+			if !unicode.IsSpace(rune(c)) {
+				return
+			}
+			p.pos++
+
+		default:
+			r, size := utf8.DecodeRune(p.data[p.pos:])
+			if !unicode.IsSpace(r) && r != '\uFEFF' {
+				return
+			}
+			p.pos += size
+		}
+	}
+}
+
+// peek returns the byte `offset` bytes ahead, or zero past the end.
+func (p *parser) peek(offset int) byte {
+	if p.pos+offset < len(p.data) {
+		return p.data[p.pos+offset]
+	}
+	return 0
+}
+
+// parseValue parses the value at the current position.
+func (p *parser) parseValue() (interface{}, error) {
+	if p.pos >= len(p.data) {
+		return nil, p.errorf("unexpected end of document")
+	}
+	switch c := p.data[p.pos]; {
+	case c == '{':
+		return p.parseObject()
+	case c == '[':
+		return p.parseArray()
+	case c == '"' || c == '\'':
+		return p.parseString()
+	case c == '-' || c == '+' || c == '.' || (c >= '0' && c <= '9'):
+		return p.parseNumber()
+	}
+	word := p.identifier()
+	switch word {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	case "Infinity":
+		return math.Inf(1), nil
+	case "NaN":
+		return math.NaN(), nil
+	case "":
+		return nil, p.errorf("unexpected %q", p.data[p.pos])
+	}
+	p.pos -= len(word)
+	return nil, p.errorf("unexpected identifier %q", word)
+}
+
+// parseObject parses the object at the current position.
+func (p *parser) parseObject() (interface{}, error) {
+	p.pos++
+	m := make(map[string]interface{})
+	for {
+		p.skipSpace()
+		if p.pos >= len(p.data) {
+			return nil, p.errorf("unterminated object")
+		}
+		if p.data[p.pos] == '}' {
+			p.pos++
+			return m, nil
+		}
+		key, err := p.parseKey()
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpace()
+		if p.peek(0) != ':' {
+			return nil, p.errorf("expected ':' after key %q", key)
+		}
+		p.pos++
+		p.skipSpace()
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		m[key] = value
+		if err = p.separator('}'); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// parseArray parses the array at the current position.
+func (p *parser) parseArray() (interface{}, error) {
+	p.pos++
+	list := make([]interface{}, 0)
+	for {
+		p.skipSpace()
+		if p.pos >= len(p.data) {
+			return nil, p.errorf("unterminated array")
+		}
+		if p.data[p.pos] == ']' {
+			p.pos++
+			return list, nil
+		}
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, value)
+		if err = p.separator(']'); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// separator consumes the comma following a member, or checks that the
+// member is the last one, followed by `end`.
+func (p *parser) separator(end byte) error {
+	p.skipSpace()
+	switch p.peek(0) {
+	case ',':
+		p.pos++
+		return nil
+	case end:
+		return nil
+	}
+	if p.pos >= len(p.data) {
+		return p.errorf("unexpected end of document")
+	}
+	return p.errorf("expected ',' or %q, got %q", end, p.data[p.pos])
+}
+
+// parseKey parses an object key, quoted or an identifier.
+func (p *parser) parseKey() (string, error) {
+	if c := p.peek(0); c == '"' || c == '\'' {
+		return p.parseString()
+	}
+	key := p.identifier()
+	if key == "" {
+		return "", p.errorf("expected an object key, got %q", p.data[p.pos])
+	}
+	return key, nil
+}
+
+// identifier consumes and returns the identifier at the current position.
+func (p *parser) identifier() string {
+	start := p.pos
+	for p.pos < len(p.data) {
+		r, size := utf8.DecodeRune(p.data[p.pos:])
+		if r != '_' && r != '$' && !unicode.IsLetter(r) && (p.pos == start || !unicode.IsDigit(r)) {
+			break
+		}
+		p.pos += size
+	}
+	return string(p.data[start:p.pos])
+}
+
+// parseString parses the string, in single or double quotes, at the current
+// position.
+func (p *parser) parseString() (string, error) {
+	quote := p.data[p.pos]
+	p.pos++
+	var b strings.Builder
+	for {
+		if p.pos >= len(p.data) {
+			return "", p.errorf("unterminated string")
+		}
+		c := p.data[p.pos]
+		switch {
+		case c == quote:
+			p.pos++
+			return b.String(), nil
+
+		case c == '\n' || c == '\r':
+			return "", p.errorf("unescaped line break in string")
+
+		case c == '\\':
+			p.pos++
+			if err := p.parseEscape(&b); err != nil {
+				return "", err
+			}
+
+		default:
+			b.WriteByte(c)
+			p.pos++
+		}
+	}
+}
+
+// parseEscape parses the escape sequence following a backslash.
+func (p *parser) parseEscape(b *strings.Builder) error {
+	if p.pos >= len(p.data) {
+		return p.errorf("unterminated string")
+	}
+	c := p.data[p.pos]
+	p.pos++
+	switch c {
+	case 'b':
+		b.WriteByte('\b')
+	case 'f':
+		b.WriteByte('\f')
+	case 'n':
+		b.WriteByte('\n')
+	case 'r':
+		b.WriteByte('\r')
+		// A line continuation may end with CRLF
+		if p.peek(0) == '\n' {
+			p.pos++
+		}
+	case 't':
+		b.WriteByte('\t')
+	case 'v':
+		b.WriteByte('\v')
+	case '0':
+		b.WriteByte(0)
+	case '\n':
+		// Line continuation
+	case 'x':
+		n, err := p.hex(2)
+		if err != nil {
+			return err
+		}
+		b.WriteRune(rune(n))
+	case 'u':
+		n, err := p.hex(4)
+		if err != nil {
+			return err
+		}
+		r := rune(n)
+		if utf16.IsSurrogate(r) && p.peek(0) == '\\' && p.peek(1) == 'u' {
+			p.pos += 2
+			low, err := p.hex(4)
+			if err != nil {
+				return err
+			}
+			r = utf16.DecodeRune(r, rune(low))
+		}
+		b.WriteRune(r)
+	default:
+		b.WriteByte(c)
+	}
+	return nil
+}
+
+// hex parses `digits` hexadecimal digits.
+func (p *parser) hex(digits int) (uint64, error) {
+	if p.pos+digits > len(p.data) {
+		return 0, p.errorf("truncated escape sequence")
+	}
+	n, err := strconv.ParseUint(string(p.data[p.pos:p.pos+digits]), 16, 32)
+	if err != nil {
+		return 0, p.errorf("invalid escape sequence %q", p.data[p.pos:p.pos+digits])
+	}
+	p.pos += digits
+	return n, nil
+}
+
+// parseNumber parses the number at the current position.
+func (p *parser) parseNumber() (interface{}, error) {
+	start := p.pos
+	negative := false
+	if c := p.data[p.pos]; c == '+' || c == '-' {
+		negative = c == '-'
+		p.pos++
+	}
+	switch word := p.identifier(); word {
+	case "Infinity":
+		if negative {
+			return math.Inf(-1), nil
+		}
+		return math.Inf(1), nil
+	case "NaN":
+		return math.NaN(), nil
+	case "":
+	default:
+		return nil, p.errorf("invalid number %q", p.data[start:p.pos])
+	}
+	for p.pos < len(p.data) && strings.IndexByte("0123456789abcdefABCDEFxX.+-", p.data[p.pos]) >= 0 {
+		// A sign only continues the number after an exponent marker
+		if c := p.data[p.pos]; (c == '+' || c == '-') && !strings.ContainsRune("eE", rune(p.data[p.pos-1])) {
+			break
+		}
+		p.pos++
+	}
+	literal := string(p.data[start:p.pos])
+	number, ok := normalizeNumber(literal)
+	if !ok {
+		return nil, p.errorf("invalid number %q", literal)
+	}
+	return json.Number(number), nil
+}
+
+// normalizeNumber rewrites the JSON5 number `literal` as a JSON number.
+func normalizeNumber(literal string) (string, bool) {
+	sign := ""
+	body := literal
+	if body != "" && (body[0] == '+' || body[0] == '-') {
+		if body[0] == '-' {
+			sign = "-"
+		}
+		body = body[1:]
+	}
+	if len(body) > 2 && body[0] == '0' && (body[1] == 'x' || body[1] == 'X') {
+		n, ok := new(big.Int).SetString(body[2:], 16)
+		if !ok {
+			return "", false
+		}
+		return sign + n.String(), true
+	}
+	if strings.HasPrefix(body, ".") {
+		body = "0" + body
+	}
+	body = strings.Replace(body, ".e", ".0e", 1)
+	body = strings.Replace(body, ".E", ".0E", 1)
+	body = strings.TrimSuffix(body, ".")
+	number := sign + body
+	if !json.Valid([]byte(number)) {
+		return "", false
+	}
+	return number, true
+}
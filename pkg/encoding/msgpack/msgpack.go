@@ -0,0 +1,77 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package msgpack provides MessagePack encoding and decoding for compact
+// cross-language payloads.
+//
+// Struct fields are named by the `msgpack` tag when present and otherwise by
+// the `json` tag, so the structs already tagged for JSON serialize to the same
+// field names in MessagePack. Integers are written in their most compact form
+// and maps with string keys decode to map[string]interface{}.
+package msgpack
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// FallbackTag is the struct tag consulted when a field has no `msgpack` tag.
+const FallbackTag = "json"
+
+// Encoder writes MessagePack values to an output stream.
+type Encoder struct {
+	// encoder writes the values.
+	encoder *msgpack.Encoder
+}
+
+// Decoder reads MessagePack values from an input stream.
+type Decoder struct {
+	// decoder reads the values.
+	decoder *msgpack.Decoder
+}
+
+// Marshal returns the MessagePack encoding of `value`.
+func Marshal(value interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := NewEncoder(&buf).Encode(value); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Unmarshal decodes the MessagePack value `data` into `pointer`.
+func Unmarshal(data []byte, pointer interface{}) error {
+	return NewDecoder(bytes.NewReader(data)).Decode(pointer)
+}
+
+// NewEncoder creates and returns an Encoder writing to `w`. Map keys are
+// written in sorted order, so equal values encode to equal bytes.
+func NewEncoder(w io.Writer) *Encoder {
+	encoder := msgpack.NewEncoder(w)
+	encoder.SetCustomStructTag(FallbackTag)
+	encoder.SetSortMapKeys(true)
+	encoder.UseCompactInts(true)
+	return &Encoder{encoder: encoder}
+}
+
+// Encode writes the MessagePack encoding of `value`.
+func (e *Encoder) Encode(value interface{}) error {
+	return e.encoder.Encode(value)
+}
+
+// NewDecoder creates and returns a Decoder reading from `r`. The Decoder may
+// read beyond the values it decodes.
+func NewDecoder(r io.Reader) *Decoder {
+	decoder := msgpack.NewDecoder(r)
+	decoder.SetCustomStructTag(FallbackTag)
+	return &Decoder{decoder: decoder}
+}
+
+// Decode reads the next MessagePack value into `pointer`. It returns io.EOF
+// when the input is exhausted.
+func (d *Decoder) Decode(pointer interface{}) error {
+	return d.decoder.Decode(pointer)
+}
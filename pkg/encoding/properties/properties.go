@@ -0,0 +1,284 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package properties provides encoding and decoding of Java .properties files.
+//
+// The syntax follows java.util.Properties: '#' and '!' start comments, keys
+// are separated from values by '=', ':' or whitespace, a trailing backslash
+// continues a line, and backslash escapes including \uXXXX are recognized.
+// Documents decode either to a flat map or to nested maps split on '.', so
+// "server.port" becomes {"server": {"port": ...}}.
+package properties
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/focela/aegis/pkg/conv"
+)
+
+// Parse decodes the properties document `data` into a flat map. A repeated
+// key keeps its last value.
+func Parse(data []byte) (map[string]string, error) {
+	m := make(map[string]string)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	var logical strings.Builder
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := scanner.Text()
+		if logical.Len() == 0 {
+			line = strings.TrimLeft(line, " \t\f")
+			if line == "" || line[0] == '#' || line[0] == '!' {
+				continue
+			}
+		} else {
+			// Leading whitespace of continuation lines is dropped
+			line = strings.TrimLeft(line, " \t\f")
+		}
+		if continues(line) {
+			logical.WriteString(line[:len(line)-1])
+			continue
+		}
+		logical.WriteString(line)
+		key, value, err := parseLine(logical.String())
+		if err != nil {
+			return nil, fmt.Errorf("properties: line %d: %w", lineNo, err)
+		}
+		m[key] = value
+		logical.Reset()
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if logical.Len() > 0 {
+		key, value, err := parseLine(logical.String())
+		if err != nil {
+			return nil, fmt.Errorf("properties: %w", err)
+		}
+		m[key] = value
+	}
+	return m, nil
+}
+
+// Decode decodes the properties document `data` into nested maps, splitting
+// keys on '.'. A key that is both a value and a parent of other keys, such
+// as "a" and "a.b", is an error.
+func Decode(data []byte) (map[string]interface{}, error) {
+	flat, err := Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(flat))
+	for k := range flat {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	root := make(map[string]interface{})
+	for _, key := range keys {
+		segs := strings.Split(key, ".")
+		m := root
+		for i, seg := range segs[:len(segs)-1] {
+			switch child := m[seg].(type) {
+			case nil:
+				next := make(map[string]interface{})
+				m[seg] = next
+				m = next
+			case map[string]interface{}:
+				m = child
+			default:
+				return nil, fmt.Errorf("properties: key %q conflicts with key %q", key, strings.Join(segs[:i+1], "."))
+			}
+		}
+		last := segs[len(segs)-1]
+		if _, ok := m[last].(map[string]interface{}); ok {
+			return nil, fmt.Errorf("properties: key %q is also a parent of other keys", key)
+		}
+		m[last] = flat[key]
+	}
+	return root, nil
+}
+
+// Encode encodes `value` as a properties document, flattening nested maps
+// into dotted keys. Values are converted with conv.String and keys are
+// written in sorted order.
+func Encode(value map[string]interface{}) []byte {
+	flat := make(map[string]string)
+	flatten(flat, "", value)
+	return EncodeFlat(flat)
+}
+
+// EncodeFlat encodes `m` as a properties document with keys in sorted order.
+// Characters outside printable ASCII are written as \uXXXX escapes, so the
+// output is valid in any Java version.
+func EncodeFlat(m map[string]string) []byte {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	var buf bytes.Buffer
+	for _, k := range keys {
+		buf.WriteString(escape(k, true))
+		buf.WriteString(" = ")
+		buf.WriteString(escape(m[k], false))
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes()
+}
+
+// ToJson converts the properties document `data` to JSON, nesting dotted keys.
+func ToJson(data []byte) ([]byte, error) {
+	value, err := Decode(data)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(value)
+}
+
+// flatten stores the leaves of `value` into `flat` under dotted keys.
+func flatten(flat map[string]string, prefix string, value map[string]interface{}) {
+	for k, v := range value {
+		key := k
+		if prefix != "" {
+			key = prefix + "." + k
+		}
+		if nested, ok := v.(map[string]interface{}); ok {
+			flatten(flat, key, nested)
+			continue
+		}
+		flat[key] = conv.String(v)
+	}
+}
+
+// continues reports whether `line` ends with an odd number of backslashes.
+func continues(line string) bool {
+	n := 0
+	for i := len(line) - 1; i >= 0 && line[i] == '\\'; i-- {
+		n++
+	}
+	return n%2 == 1
+}
+
+// parseLine splits a logical line into its unescaped key and value.
+func parseLine(line string) (key, value string, err error) {
+	// The key ends at the first unescaped separator or whitespace
+	end := len(line)
+	for i := 0; i < len(line); i++ {
+		if line[i] == '\\' {
+			i++
+			continue
+		}
+		if strings.IndexByte("=: \t\f", line[i]) >= 0 {
+			end = i
+			break
+		}
+	}
+	rest := strings.TrimLeft(line[end:], " \t\f")
+	if rest != "" && (rest[0] == '=' || rest[0] == ':') {
+		rest = strings.TrimLeft(rest[1:], " \t\f")
+	}
+	if key, err = unescape(line[:end]); err != nil {
+		return "", "", err
+	}
+	if value, err = unescape(rest); err != nil {
+		return "", "", err
+	}
+	return key, value, nil
+}
+
+// unescape resolves the backslash escapes of `s`.
+func unescape(s string) (string, error) {
+	if !strings.Contains(s, `\`) {
+		return s, nil
+	}
+	var b strings.Builder
+	var pending []uint16
+	flush := func() {
+		if len(pending) > 0 {
+			b.WriteString(string(utf16.Decode(pending)))
+			pending = pending[:0]
+		}
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c != '\\' || i == len(s)-1 {
+			flush()
+			b.WriteByte(c)
+			continue
+		}
+		i++
+		if s[i] == 'u' {
+			if i+5 > len(s) {
+				return "", fmt.Errorf("malformed \\u escape in %q", s)
+			}
+			n, err := strconv.ParseUint(s[i+1:i+5], 16, 16)
+			if err != nil {
+				return "", fmt.Errorf("malformed \\u escape in %q", s)
+			}
+			// Surrogate pairs are collected and decoded together
+			pending = append(pending, uint16(n))
+			i += 4
+			continue
+		}
+		flush()
+		switch s[i] {
+		case 't':
+			b.WriteByte('\t')
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		case 'f':
+			b.WriteByte('\f')
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	flush()
+	return b.String(), nil
+}
+
+// escape escapes `s` for writing as a key, when `isKey` is set, or as a value.
+func escape(s string, isKey bool) string {
+	var b strings.Builder
+	for i, r := range s {
+		switch r {
+		case '\\':
+			b.WriteString(`\\`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\f':
+			b.WriteString(`\f`)
+		case '=', ':', '#', '!':
+			if isKey || i == 0 {
+				b.WriteByte('\\')
+			}
+			b.WriteRune(r)
+		case ' ':
+			if isKey || i == 0 {
+				b.WriteByte('\\')
+			}
+			b.WriteRune(r)
+		default:
+			if r < 0x20 || r > 0x7e {
+				for _, u := range utf16.Encode([]rune{r}) {
+					fmt.Fprintf(&b, `\u%04x`, u)
+				}
+				continue
+			}
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package protobuf converts protocol buffer messages to and from generic
+// maps.
+//
+// Conversions follow the canonical JSON mapping of protocol buffers: fields
+// are named by their json_name, 64-bit integers are strings, bytes are base64
+// strings, enums are names and well-known types use their special forms.
+// Maps use the generic representation of the dynamic Json container of
+// pkg/encoding/json, so payloads can be transformed by path and converted
+// back, or converted to and from Json directly with ToJson and FromJson.
+// Messages built from descriptors with FromMapDescriptor need no generated
+// code.
+package protobuf
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// options holds the settings of a conversion.
+type options struct {
+	// protoNames names fields by their proto name instead of json_name.
+	protoNames bool
+
+	// unpopulated writes fields holding their default value.
+	unpopulated bool
+
+	// discardUnknown ignores unknown fields instead of failing.
+	discardUnknown bool
+}
+
+// Option configures a conversion.
+type Option func(*options)
+
+// WithProtoNames names fields by their name in the .proto file, such as
+// "user_id", instead of their json_name, such as "userId". Both are accepted
+// when converting maps to messages.
+func WithProtoNames() Option {
+	return func(o *options) {
+		o.protoNames = true
+	}
+}
+
+// WithUnpopulated includes the fields holding their default value in maps.
+func WithUnpopulated() Option {
+	return func(o *options) {
+		o.unpopulated = true
+	}
+}
+
+// WithDiscardUnknown ignores map keys naming no field, instead of failing.
+func WithDiscardUnknown() Option {
+	return func(o *options) {
+		o.discardUnknown = true
+	}
+}
+
+// buildOptions returns the options set by `opts`.
+func buildOptions(opts []Option) *options {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// Encode returns the canonical JSON encoding of `msg`.
+func Encode(msg proto.Message, opts ...Option) ([]byte, error) {
+	o := buildOptions(opts)
+	return protojson.MarshalOptions{
+		UseProtoNames:   o.protoNames,
+		EmitUnpopulated: o.unpopulated,
+	}.Marshal(msg)
+}
+
+// Decode decodes the canonical JSON encoding `data` into `msg`.
+func Decode(data []byte, msg proto.Message, opts ...Option) error {
+	o := buildOptions(opts)
+	return protojson.UnmarshalOptions{DiscardUnknown: o.discardUnknown}.Unmarshal(data, msg)
+}
+
+// ToMap converts `msg` to a map of generic values, with numbers as
+// json.Number.
+func ToMap(msg proto.Message, opts ...Option) (map[string]interface{}, error) {
+	b, err := Encode(msg, opts...)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	if err = decoder.Decode(&m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// FromMap fills `msg` from `m`, a map or any value encoding to a JSON object,
+// such as a struct. Fields of `msg` absent from `m` are left unchanged; the
+// others are merged as by proto.Merge, so repeated fields are appended to.
+func FromMap(m interface{}, msg proto.Message, opts ...Option) error {
+	b, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	fresh := msg.ProtoReflect().New().Interface()
+	if err = Decode(b, fresh, opts...); err != nil {
+		return err
+	}
+	proto.Merge(msg, fresh)
+	return nil
+}
+
+// FromMapDescriptor creates a message of the type described by `desc` and
+// fills it from `m`, as FromMap.
+func FromMapDescriptor(m interface{}, desc protoreflect.MessageDescriptor, opts ...Option) (proto.Message, error) {
+	msg := dynamicpb.NewMessage(desc)
+	if err := FromMap(m, msg, opts...); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
@@ -0,0 +1,25 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package protobuf
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	"github.com/focela/aegis/pkg/encoding/json"
+)
+
+// ToJson converts `msg` to a Json, as ToMap.
+func ToJson(msg proto.Message, safe ...bool) (*json.Json, error) {
+	m, err := ToMap(msg)
+	if err != nil {
+		return nil, err
+	}
+	return json.New(m, safe...), nil
+}
+
+// FromJson fills `msg` from the document `j`, as FromMap.
+func FromJson(j *json.Json, msg proto.Message, opts ...Option) error {
+	return FromMap(j.Interface(), msg, opts...)
+}
@@ -0,0 +1,372 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package schema encodes and decodes binary frames described by a field
+// schema loaded at run time.
+//
+// A schema lists the fields of a frame in order, each with a name, a type, a
+// width in bytes and an optional byte order overriding the one of the schema,
+// little-endian by default as in pkg/encoding/binary. It is written in JSON
+// or YAML, for example:
+//
+//	endian: big
+//	fields:
+//	  - {name: version, type: uint8}
+//	  - {name: length, type: uint, width: 3}
+//	  - {name: temperature, type: float32, endian: little}
+//	  - {type: pad, width: 2}
+//	  - {name: label, type: string, width: 8}
+//	  - {name: payload, type: bytes}
+//
+// Integer types are "uint" and "int" with a width of 1 to 8 bytes, or the
+// sized "uint8" to "uint64" and "int8" to "int64". Other types are "float32",
+// "float64", "bool", "string", whose trailing NUL bytes are trimmed, "bytes"
+// and "pad", which skips its width. The last string or bytes field may omit
+// its width to take the rest of the frame. Frames decode to maps keyed by
+// field name, so protocols can be parsed from configuration alone.
+package schema
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"strings"
+
+	"github.com/focela/aegis/pkg/conv"
+	"github.com/focela/aegis/pkg/encoding/binary"
+	"github.com/focela/aegis/pkg/encoding/yaml"
+)
+
+// Byte orders.
+const (
+	LittleEndian = "little"
+	BigEndian    = "big"
+)
+
+// Field types.
+const (
+	TypeUint    = "uint"
+	TypeInt     = "int"
+	TypeUint8   = "uint8"
+	TypeUint16  = "uint16"
+	TypeUint32  = "uint32"
+	TypeUint64  = "uint64"
+	TypeInt8    = "int8"
+	TypeInt16   = "int16"
+	TypeInt32   = "int32"
+	TypeInt64   = "int64"
+	TypeFloat32 = "float32"
+	TypeFloat64 = "float64"
+	TypeBool    = "bool"
+	TypeString  = "string"
+	TypeBytes   = "bytes"
+	TypePad     = "pad"
+)
+
+// fixedWidths holds the width of the types that have one.
+var fixedWidths = map[string]int{
+	TypeUint8: 1, TypeUint16: 2, TypeUint32: 4, TypeUint64: 8,
+	TypeInt8: 1, TypeInt16: 2, TypeInt32: 4, TypeInt64: 8,
+	TypeFloat32: 4, TypeFloat64: 8,
+}
+
+// Field describes a field of a frame.
+type Field struct {
+	// Name is the key of the field in decoded maps. Pad fields need none.
+	Name string `json:"name" yaml:"name"`
+
+	// Type is the field type, one of the Type constants.
+	Type string `json:"type" yaml:"type"`
+
+	// Width is the size of the field in bytes. It defaults to the size of
+	// sized types and to 1 for bool.
+	Width int `json:"width" yaml:"width"`
+
+	// Endian is the byte order of the field, overriding the schema one.
+	Endian string `json:"endian" yaml:"endian"`
+}
+
+// Schema describes the layout of a frame.
+type Schema struct {
+	// Endian is the default byte order, LittleEndian when empty.
+	Endian string `json:"endian" yaml:"endian"`
+
+	// Fields are the fields of the frame, in order.
+	Fields []Field `json:"fields" yaml:"fields"`
+}
+
+// Load decodes the schema `data`, written in JSON or YAML, and validates it.
+func Load(data []byte) (*Schema, error) {
+	s := &Schema{}
+	if err := yaml.DecodeTo(data, s); err != nil {
+		return nil, fmt.Errorf("schema: %w", err)
+	}
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// LoadFile reads and decodes the schema file at `path`.
+func LoadFile(path string) (*Schema, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Load(data)
+}
+
+// Validate checks the schema and fills in the default widths and byte
+// orders of its fields.
+func (s *Schema) Validate() error {
+	s.Endian = strings.ToLower(s.Endian)
+	if s.Endian == "" {
+		s.Endian = LittleEndian
+	}
+	if !validEndian(s.Endian) {
+		return fmt.Errorf("schema: invalid byte order %q", s.Endian)
+	}
+	names := make(map[string]bool, len(s.Fields))
+	for i := range s.Fields {
+		f := &s.Fields[i]
+		f.Endian = strings.ToLower(f.Endian)
+		if f.Endian == "" {
+			f.Endian = s.Endian
+		}
+		if !validEndian(f.Endian) {
+			return fmt.Errorf("schema: field %q: invalid byte order %q", f.Name, f.Endian)
+		}
+		if f.Type != TypePad {
+			if f.Name == "" {
+				return fmt.Errorf("schema: field %d has no name", i)
+			}
+			if names[f.Name] {
+				return fmt.Errorf("schema: duplicate field %q", f.Name)
+			}
+			names[f.Name] = true
+		}
+		if err := f.validateWidth(i == len(s.Fields)-1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateWidth checks the width of the field, defaulting it when possible.
+// Only the `last` field may have no width.
+func (f *Field) validateWidth(last bool) error {
+	if width, ok := fixedWidths[f.Type]; ok {
+		if f.Width != 0 && f.Width != width {
+			return fmt.Errorf("schema: field %q: width %d does not match type %s", f.Name, f.Width, f.Type)
+		}
+		f.Width = width
+		return nil
+	}
+	switch f.Type {
+	case TypeUint, TypeInt:
+		if f.Width < 1 || f.Width > 8 {
+			return fmt.Errorf("schema: field %q: width of %s must be 1 to 8, got %d", f.Name, f.Type, f.Width)
+		}
+
+	case TypeBool:
+		if f.Width == 0 {
+			f.Width = 1
+		}
+
+	case TypeString, TypeBytes:
+		if f.Width == 0 && !last {
+			return fmt.Errorf("schema: field %q: only the last field may have no width", f.Name)
+		}
+
+	case TypePad:
+		if f.Width <= 0 {
+			return fmt.Errorf("schema: pad field needs a width")
+		}
+
+	default:
+		return fmt.Errorf("schema: field %q: unknown type %q", f.Name, f.Type)
+	}
+	if f.Width < 0 {
+		return fmt.Errorf("schema: field %q: negative width", f.Name)
+	}
+	return nil
+}
+
+// Size returns the size of the frames of the schema, or -1 if their last
+// field takes the rest of the frame.
+func (s *Schema) Size() int {
+	size := 0
+	for _, f := range s.Fields {
+		if f.Width == 0 {
+			return -1
+		}
+		size += f.Width
+	}
+	return size
+}
+
+// Decode decodes `frame` into a map keyed by field name. Integers decode to
+// uint64 or int64, floats to float64, strings to string and bytes to []byte.
+// Bytes beyond the fields of a fixed-size schema are ignored.
+func (s *Schema) Decode(frame []byte) (map[string]interface{}, error) {
+	m := make(map[string]interface{}, len(s.Fields))
+	offset := 0
+	for _, f := range s.Fields {
+		width := f.Width
+		if width == 0 {
+			width = len(frame) - offset
+		}
+		if offset+width > len(frame) {
+			return nil, fmt.Errorf("schema: frame of %d bytes too short for field %q", len(frame), f.Name)
+		}
+		if f.Type != TypePad {
+			m[f.Name] = f.decode(frame[offset : offset+width])
+		}
+		offset += width
+	}
+	return m, nil
+}
+
+// Encode encodes the values of `m`, keyed by field name, into a frame.
+// Values are converted to the field types through pkg/conv, missing values
+// are encoded as zero, and strings and bytes are truncated or NUL-padded to
+// the field width.
+func (s *Schema) Encode(m map[string]interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	for _, f := range s.Fields {
+		b, err := f.encode(m[f.Name])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(b)
+	}
+	return buf.Bytes(), nil
+}
+
+// ToJson decodes `frame` and returns it as a JSON object.
+func (s *Schema) ToJson(frame []byte) ([]byte, error) {
+	m, err := s.Decode(frame)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(m)
+}
+
+// FromJson encodes the JSON object `data` into a frame. Bytes fields are
+// read as base64 strings, as written by ToJson.
+func (s *Schema) FromJson(data []byte) ([]byte, error) {
+	var m map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&m); err != nil {
+		return nil, fmt.Errorf("schema: %w", err)
+	}
+	for _, f := range s.Fields {
+		if v, ok := m[f.Name].(string); ok && f.Type == TypeBytes {
+			b, err := base64.StdEncoding.DecodeString(v)
+			if err != nil {
+				return nil, fmt.Errorf("schema: field %q: %w", f.Name, err)
+			}
+			m[f.Name] = b
+		}
+	}
+	return s.Encode(m)
+}
+
+// decode decodes the field from `b`, which holds exactly its bytes.
+func (f *Field) decode(b []byte) interface{} {
+	switch f.Type {
+	case TypeString:
+		return string(bytes.TrimRight(b, "\x00"))
+	case TypeBytes:
+		return bytes.Clone(b)
+	case TypeBool:
+		return binary.DecodeToBool(b)
+	}
+	bits := binary.DecodeToUint64(f.littleEndian(b))
+	switch f.Type {
+	case TypeFloat32:
+		return float64(math.Float32frombits(uint32(bits)))
+	case TypeFloat64:
+		return math.Float64frombits(bits)
+	case TypeInt, TypeInt8, TypeInt16, TypeInt32, TypeInt64:
+		// Extend the sign bit of the field over the 64 bits
+		shift := 64 - 8*f.Width
+		return int64(bits<<shift) >> shift
+	}
+	return bits
+}
+
+// encode encodes `value` as the field.
+func (f *Field) encode(value interface{}) ([]byte, error) {
+	var bits uint64
+	switch f.Type {
+	case TypePad:
+		return make([]byte, f.Width), nil
+
+	case TypeString, TypeBytes:
+		b := conv.Bytes(value)
+		if f.Type == TypeString {
+			b = []byte(conv.String(value))
+		}
+		if f.Width == 0 {
+			return b, nil
+		}
+		out := make([]byte, f.Width)
+		copy(out, b)
+		return out, nil
+
+	case TypeBool:
+		out := make([]byte, f.Width)
+		if conv.Bool(value) {
+			out[0] = 1
+		}
+		return f.littleEndian(out), nil
+
+	case TypeFloat32:
+		bits = uint64(math.Float32bits(conv.Float32(value)))
+
+	case TypeFloat64:
+		bits = math.Float64bits(conv.Float64(value))
+
+	case TypeInt, TypeInt8, TypeInt16, TypeInt32, TypeInt64:
+		n := conv.Int64(value)
+		if limit := int64(1) << (8*f.Width - 1); f.Width < 8 && (n < -limit || n >= limit) {
+			return nil, fmt.Errorf("schema: field %q: %d overflows %d bytes", f.Name, n, f.Width)
+		}
+		bits = uint64(n)
+
+	default:
+		bits = conv.Uint64(value)
+		if f.Width < 8 && bits >= 1<<(8*f.Width) {
+			return nil, fmt.Errorf("schema: field %q: %d overflows %d bytes", f.Name, bits, f.Width)
+		}
+	}
+	return f.littleEndian(binary.EncodeUint64(bits)[:f.Width]), nil
+}
+
+// littleEndian returns `b` in little-endian order, reversing a copy of it
+// when the field is big-endian.
+func (f *Field) littleEndian(b []byte) []byte {
+	if f.Endian != BigEndian {
+		return b
+	}
+	reversed := make([]byte, len(b))
+	for i, c := range b {
+		reversed[len(b)-1-i] = c
+	}
+	return reversed
+}
+
+// validEndian reports whether `endian` names a byte order.
+func validEndian(endian string) bool {
+	switch endian {
+	case LittleEndian, BigEndian:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package toml provides TOML encoding and decoding.
+//
+// Decoded documents use the generic representation of the dynamic Json
+// container of pkg/encoding/json, so TOML configuration can be loaded and
+// navigated through the same API as JSON and YAML.
+package toml
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/BurntSushi/toml"
+
+	"github.com/focela/aegis/internal/encoding/generic"
+)
+
+// Encode encodes `value`, a map or struct, as a TOML document.
+// json.Number values are written as TOML numbers.
+func Encode(value interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := toml.NewEncoder(&buf).Encode(generic.Numbers(value)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Decode decodes the TOML document `data` into a map of generic values.
+// Integers decode as int64, floats as float64 and date-times as time.Time.
+// Arrays of tables decode as []interface{}, like other arrays.
+func Decode(data []byte) (map[string]interface{}, error) {
+	var value map[string]interface{}
+	if err := toml.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	return generic.StringKeys(value).(map[string]interface{}), nil
+}
+
+// DecodeTo decodes the TOML document `data` into `pointer`, honoring `toml`
+// struct tags.
+func DecodeTo(data []byte, pointer interface{}) error {
+	return toml.Unmarshal(data, pointer)
+}
+
+// ToJson converts the TOML document `data` to JSON.
+func ToJson(data []byte) ([]byte, error) {
+	value, err := Decode(data)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(value)
+}
@@ -0,0 +1,245 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package url provides URL escaping and a query-string codec supporting
+// nested values in bracket syntax.
+//
+// Nested maps and structs are written as "user[name]=x", slices of scalars as
+// "tags[]=a&tags[]=b" and slices of composites with indexes, as in
+// "items[0][id]=1". Parsing accepts the same syntax, so values built by
+// BuildQuery parse back to equivalent values, and struct binding goes through
+// pkg/conv with its usual field matching.
+package url
+
+import (
+	"encoding"
+	"fmt"
+	"net/url"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/focela/aegis/pkg/conv"
+)
+
+// Encode escapes `s` for use in a query string, encoding spaces as '+'.
+func Encode(s string) string {
+	return url.QueryEscape(s)
+}
+
+// Decode reverses Encode.
+func Decode(s string) (string, error) {
+	return url.QueryUnescape(s)
+}
+
+// RawEncode escapes `s` for use as a path segment, encoding spaces as "%20".
+func RawEncode(s string) string {
+	return url.PathEscape(s)
+}
+
+// RawDecode reverses RawEncode.
+func RawDecode(s string) (string, error) {
+	return url.PathUnescape(s)
+}
+
+// BuildQuery encodes `data`, a map or struct, as a query string with keys in
+// sorted order. Struct fields are named through conv.StructTagPriority, nil
+// values are written as empty values, and other scalars are formatted with
+// conv.String.
+func BuildQuery(data interface{}) string {
+	var pairs []string
+	buildPairs(&pairs, "", data)
+	return strings.Join(pairs, "&")
+}
+
+// ParseQuery decodes the query string `query`, with or without a leading '?',
+// into nested maps and slices of strings. A key repeated without brackets
+// collects its values into a slice, and maps whose keys are the indexes 0 to
+// n-1 become slices.
+func ParseQuery(query string) (map[string]interface{}, error) {
+	query = strings.TrimPrefix(query, "?")
+	root := make(map[string]interface{})
+	for pair := range strings.SplitSeq(query, "&") {
+		if pair == "" {
+			continue
+		}
+		rawKey, rawValue, _ := strings.Cut(pair, "=")
+		key, err := url.QueryUnescape(rawKey)
+		if err != nil {
+			return nil, fmt.Errorf("url: invalid query key %q: %w", rawKey, err)
+		}
+		value, err := url.QueryUnescape(rawValue)
+		if err != nil {
+			return nil, fmt.Errorf("url: invalid query value for %q: %w", key, err)
+		}
+		name, segs := splitKey(key)
+		if len(segs) == 0 {
+			// A repeated plain key collects its values
+			switch existing := root[name].(type) {
+			case nil:
+				root[name] = value
+			case []interface{}:
+				root[name] = append(existing, value)
+			default:
+				root[name] = []interface{}{existing, value}
+			}
+			continue
+		}
+		root[name] = assign(root[name], segs, value)
+	}
+	for k, v := range root {
+		root[k] = indexedToSlices(v)
+	}
+	return root, nil
+}
+
+// ParseQueryTo decodes the query string `query` into the struct pointed to by
+// `pointer` through conv.Struct.
+func ParseQueryTo(query string, pointer interface{}) error {
+	m, err := ParseQuery(query)
+	if err != nil {
+		return err
+	}
+	return conv.Struct(m, pointer)
+}
+
+// buildPairs appends the escaped pairs of `value`, named `key`, to `pairs`.
+func buildPairs(pairs *[]string, key string, value interface{}) {
+	rv := reflect.ValueOf(value)
+	for rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			break
+		}
+		rv = rv.Elem()
+	}
+	switch {
+	case !rv.IsValid() || isScalar(rv):
+		if key != "" {
+			*pairs = append(*pairs, url.QueryEscape(key)+"="+url.QueryEscape(scalarString(rv)))
+		}
+
+	case rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			item := rv.Index(i)
+			if isScalar(reflect.Indirect(item)) {
+				buildPairs(pairs, key+"[]", item.Interface())
+			} else {
+				buildPairs(pairs, key+"["+strconv.Itoa(i)+"]", item.Interface())
+			}
+		}
+
+	default:
+		m := conv.Map(rv.Interface())
+		keys := make([]string, 0, len(m))
+		for k := range m {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+		for _, k := range keys {
+			if key != "" {
+				buildPairs(pairs, key+"["+k+"]", m[k])
+			} else {
+				buildPairs(pairs, k, m[k])
+			}
+		}
+	}
+}
+
+// textMarshalerType is the reflect.Type of encoding.TextMarshaler.
+var textMarshalerType = reflect.TypeFor[encoding.TextMarshaler]()
+
+// isScalar reports whether `rv` is written as a single value. Byte slices and
+// types implementing encoding.TextMarshaler, such as time.Time, are scalars.
+func isScalar(rv reflect.Value) bool {
+	if !rv.IsValid() || rv.Type().Implements(textMarshalerType) {
+		return true
+	}
+	switch rv.Kind() {
+	case reflect.Map, reflect.Struct:
+		return false
+	case reflect.Slice, reflect.Array:
+		return rv.Type().Elem().Kind() == reflect.Uint8
+	}
+	return true
+}
+
+// scalarString formats the scalar `rv`, writing nil as an empty string.
+func scalarString(rv reflect.Value) string {
+	if !rv.IsValid() || (rv.Kind() == reflect.Ptr && rv.IsNil()) {
+		return ""
+	}
+	if m, ok := rv.Interface().(encoding.TextMarshaler); ok {
+		if b, err := m.MarshalText(); err == nil {
+			return string(b)
+		}
+	}
+	return conv.String(rv.Interface())
+}
+
+// splitKey splits "a[b][]" into "a" and ["b", ""]. Keys whose brackets are
+// unbalanced are returned whole.
+func splitKey(key string) (string, []string) {
+	open := strings.IndexByte(key, '[')
+	if open <= 0 {
+		return key, nil
+	}
+	var segs []string
+	rest := key[open:]
+	for rest != "" {
+		end := strings.IndexByte(rest, ']')
+		if rest[0] != '[' || end < 0 {
+			return key, nil
+		}
+		segs = append(segs, rest[1:end])
+		rest = rest[end+1:]
+	}
+	return key[:open], segs
+}
+
+// assign stores `value` at the path `segs` below `node` and returns the
+// updated node. An empty segment appends to a slice; a value conflicting with
+// the existing node replaces it.
+func assign(node interface{}, segs []string, value string) interface{} {
+	if len(segs) == 0 {
+		return value
+	}
+	if segs[0] == "" {
+		list, _ := node.([]interface{})
+		return append(list, assign(nil, segs[1:], value))
+	}
+	m, ok := node.(map[string]interface{})
+	if !ok {
+		m = make(map[string]interface{})
+	}
+	m[segs[0]] = assign(m[segs[0]], segs[1:], value)
+	return m
+}
+
+// indexedToSlices converts, recursively, the maps of `value` whose keys are
+// exactly the indexes 0 to n-1 into slices.
+func indexedToSlices(value interface{}) interface{} {
+	switch v := value.(type) {
+	case []interface{}:
+		for i, item := range v {
+			v[i] = indexedToSlices(item)
+		}
+		return v
+
+	case map[string]interface{}:
+		for k, item := range v {
+			v[k] = indexedToSlices(item)
+		}
+		list := make([]interface{}, len(v))
+		for k, item := range v {
+			i, err := strconv.Atoi(k)
+			if err != nil || i < 0 || i >= len(v) || strconv.Itoa(i) != k {
+				return v
+			}
+			list[i] = item
+		}
+		return list
+	}
+	return value
+}
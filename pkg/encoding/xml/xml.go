@@ -0,0 +1,219 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package xml converts between XML documents and generic maps.
+//
+// A document decodes to a map holding its root element. An element without
+// attributes or children becomes its text; any other element becomes a map in
+// which attributes are keys prefixed with AttrPrefix, the text content is
+// under TextKey, and child elements are keys holding either a value or, when
+// the element is repeated, a slice of values. Namespace prefixes are kept in
+// names, e.g. "soap:Body", and xmlns declarations are kept as attributes, so
+// a decoded document encodes back to an equivalent one.
+package xml
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/focela/aegis/pkg/conv"
+)
+
+// Keys used in decoded maps.
+const (
+	// AttrPrefix prefixes the keys of attributes.
+	AttrPrefix = "-"
+
+	// TextKey holds the text content of elements that also have attributes
+	// or children.
+	TextKey = "#text"
+
+	// DefaultRootTag wraps maps that do not consist of a single root element.
+	DefaultRootTag = "doc"
+)
+
+// Decode converts the XML document `data` to a map holding its root element.
+func Decode(data []byte) (map[string]interface{}, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		token, err := decoder.RawToken()
+		if err == io.EOF {
+			return nil, fmt.Errorf("xml: no root element")
+		}
+		if err != nil {
+			return nil, err
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			value, err := decodeElement(decoder, start)
+			if err != nil {
+				return nil, err
+			}
+			return map[string]interface{}{qualifiedName(start.Name): value}, nil
+		}
+	}
+}
+
+// DecodeTo decodes the XML document `data` into `pointer`, honoring `xml`
+// struct tags.
+func DecodeTo(data []byte, pointer interface{}) error {
+	return xml.Unmarshal(data, pointer)
+}
+
+// Encode converts `m` to an XML document. If `m` holds a single element and
+// no `rootTag` is given, that element is the root; otherwise the entries of `m`
+// are wrapped in `rootTag`, DefaultRootTag by default. Child elements are
+// written in sorted order.
+func Encode(m map[string]interface{}, rootTag ...string) ([]byte, error) {
+	var buf bytes.Buffer
+	if len(rootTag) == 0 && len(m) == 1 {
+		for name, value := range m {
+			if err := encodeElement(&buf, name, value); err != nil {
+				return nil, err
+			}
+		}
+		return buf.Bytes(), nil
+	}
+	root := DefaultRootTag
+	if len(rootTag) > 0 && rootTag[0] != "" {
+		root = rootTag[0]
+	}
+	if err := encodeElement(&buf, root, m); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// ToJson converts the XML document `data` to JSON.
+func ToJson(data []byte) ([]byte, error) {
+	m, err := Decode(data)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(m)
+}
+
+// decodeElement decodes the content of the element opened by `start`.
+func decodeElement(decoder *xml.Decoder, start xml.StartElement) (interface{}, error) {
+	m := make(map[string]interface{})
+	for _, attr := range start.Attr {
+		m[AttrPrefix+qualifiedName(attr.Name)] = attr.Value
+	}
+	var text strings.Builder
+	for {
+		token, err := decoder.RawToken()
+		if err != nil {
+			if err == io.EOF {
+				err = fmt.Errorf("xml: element %s is not closed", qualifiedName(start.Name))
+			}
+			return nil, err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			value, err := decodeElement(decoder, t)
+			if err != nil {
+				return nil, err
+			}
+			addChild(m, qualifiedName(t.Name), value)
+
+		case xml.CharData:
+			text.Write(t)
+
+		case xml.EndElement:
+			s := strings.TrimSpace(text.String())
+			if len(m) == 0 {
+				return s, nil
+			}
+			if s != "" {
+				m[TextKey] = s
+			}
+			return m, nil
+		}
+	}
+}
+
+// addChild stores `value` under `name`, turning repeated names into slices.
+func addChild(m map[string]interface{}, name string, value interface{}) {
+	existing, ok := m[name]
+	if !ok {
+		m[name] = value
+		return
+	}
+	if values, ok := existing.([]interface{}); ok {
+		m[name] = append(values, value)
+		return
+	}
+	m[name] = []interface{}{existing, value}
+}
+
+// qualifiedName returns `name` with its namespace prefix, if any.
+func qualifiedName(name xml.Name) string {
+	if name.Space == "" {
+		return name.Local
+	}
+	return name.Space + ":" + name.Local
+}
+
+// encodeElement writes the element `name` holding `value`.
+func encodeElement(buf *bytes.Buffer, name string, value interface{}) error {
+	if name == "" || strings.HasPrefix(name, AttrPrefix) || name == TextKey {
+		return fmt.Errorf("xml: invalid element name %q", name)
+	}
+	switch v := value.(type) {
+	case []interface{}:
+		for _, item := range v {
+			if err := encodeElement(buf, name, item); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+
+		buf.WriteString("<" + name)
+		for _, k := range keys {
+			if attr, ok := strings.CutPrefix(k, AttrPrefix); ok {
+				buf.WriteString(" " + attr + `="`)
+				escape(buf, conv.String(v[k]))
+				buf.WriteByte('"')
+			}
+		}
+		buf.WriteByte('>')
+		if text, ok := v[TextKey]; ok {
+			escape(buf, conv.String(text))
+		}
+		for _, k := range keys {
+			if strings.HasPrefix(k, AttrPrefix) || k == TextKey {
+				continue
+			}
+			if err := encodeElement(buf, k, v[k]); err != nil {
+				return err
+			}
+		}
+		buf.WriteString("</" + name + ">")
+		return nil
+
+	default:
+		buf.WriteString("<" + name + ">")
+		if value != nil {
+			escape(buf, conv.String(value))
+		}
+		buf.WriteString("</" + name + ">")
+		return nil
+	}
+}
+
+// escape writes `s` with the XML special characters escaped.
+func escape(buf *bytes.Buffer, s string) {
+	_ = xml.EscapeText(buf, []byte(s))
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package yaml provides YAML encoding and decoding.
+//
+// Decoded documents use the same generic representation as the dynamic Json
+// container of pkg/encoding/json: maps always have string keys, even when the
+// YAML mapping uses numbers or booleans as keys, so YAML configuration can be
+// navigated and converted exactly like JSON. Anchors, aliases and merge keys
+// are resolved while decoding.
+package yaml
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/focela/aegis/internal/encoding/generic"
+)
+
+// Encode encodes `value` as YAML with an indentation of two spaces.
+// json.Number values are written as YAML numbers.
+func Encode(value interface{}) ([]byte, error) {
+	return EncodeIndent(value, 2)
+}
+
+// EncodeIndent encodes `value` as YAML, indenting nested blocks by `spaces`.
+func EncodeIndent(value interface{}, spaces int) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(max(spaces, 1))
+	if err := encoder.Encode(generic.Numbers(value)); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Decode decodes the YAML document `data` into generic values. Mappings
+// become map[string]interface{}, whatever the type of their keys.
+func Decode(data []byte) (interface{}, error) {
+	var value interface{}
+	if err := yaml.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	return generic.StringKeys(value), nil
+}
+
+// DecodeTo decodes the YAML document `data` into `pointer`, honoring `yaml`
+// struct tags.
+func DecodeTo(data []byte, pointer interface{}) error {
+	return yaml.Unmarshal(data, pointer)
+}
+
+// ToJson converts the YAML document `data` to JSON.
+func ToJson(data []byte) ([]byte, error) {
+	value, err := Decode(data)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(value)
+}
@@ -0,0 +1,232 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package env reads typed environment variables and loads .env files.
+//
+// The typed getters return the given default, or the zero value, when the
+// variable is unset, empty or malformed, which suits optional settings; use
+// MustGet or the Lookup variants to tell these cases apart. A Reader
+// created with WithPrefix reads the variables of one component, such as
+// APP_PORT for the key PORT.
+package env
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Reader reads the environment variables whose names start with a prefix.
+type Reader struct {
+	prefix string
+}
+
+// defaultReader reads variables without prefix.
+var defaultReader = &Reader{}
+
+// WithPrefix returns a Reader prepending `prefix` to the keys it reads.
+func WithPrefix(prefix string) *Reader {
+	return &Reader{prefix: prefix}
+}
+
+// Prefix returns the prefix of the reader.
+func (r *Reader) Prefix() string {
+	return r.prefix
+}
+
+// WithPrefix returns a Reader whose prefix is `prefix` appended to the
+// prefix of `r`.
+func (r *Reader) WithPrefix(prefix string) *Reader {
+	return &Reader{prefix: r.prefix + prefix}
+}
+
+// Lookup returns the value of the variable `key` and whether it is set.
+func (r *Reader) Lookup(key string) (string, bool) {
+	return os.LookupEnv(r.prefix + key)
+}
+
+// Get returns the value of the variable `key`, or `def` if it is unset or
+// empty.
+func (r *Reader) Get(key string, def ...string) string {
+	if v, ok := r.Lookup(key); ok && v != "" {
+		return v
+	}
+	if len(def) > 0 {
+		return def[0]
+	}
+	return ""
+}
+
+// MustGet returns the value of the variable `key`, panicking if it is unset
+// or empty. It suits the required settings read at start-up.
+func (r *Reader) MustGet(key string) string {
+	v, ok := r.Lookup(key)
+	if !ok || v == "" {
+		panic(fmt.Sprintf("env: required variable %s is not set", r.prefix+key))
+	}
+	return v
+}
+
+// GetInt returns the variable `key` as an int, or `def` if it is unset,
+// empty or not an integer.
+func (r *Reader) GetInt(key string, def ...int) int {
+	return getParsed(r, key, def, strconv.Atoi)
+}
+
+// GetInt64 returns the variable `key` as an int64, or `def` if it is unset,
+// empty or not an integer.
+func (r *Reader) GetInt64(key string, def ...int64) int64 {
+	return getParsed(r, key, def, func(s string) (int64, error) {
+		return strconv.ParseInt(s, 10, 64)
+	})
+}
+
+// GetFloat returns the variable `key` as a float64, or `def` if it is
+// unset, empty or not a number.
+func (r *Reader) GetFloat(key string, def ...float64) float64 {
+	return getParsed(r, key, def, func(s string) (float64, error) {
+		return strconv.ParseFloat(s, 64)
+	})
+}
+
+// GetBool returns the variable `key` as a bool, or `def` if it is unset,
+// empty or not a boolean. True is spelled 1, t, true, y, yes or on and false
+// 0, f, false, n, no or off, in any case.
+func (r *Reader) GetBool(key string, def ...bool) bool {
+	return getParsed(r, key, def, parseBool)
+}
+
+// GetDuration returns the variable `key` as a duration such as "1m30s", or
+// `def` if it is unset, empty or malformed.
+func (r *Reader) GetDuration(key string, def ...time.Duration) time.Duration {
+	return getParsed(r, key, def, time.ParseDuration)
+}
+
+// GetSlice returns the variable `key` split by `sep`, with surrounding
+// spaces and empty elements removed, or `def` if it is unset or empty.
+func (r *Reader) GetSlice(key, sep string, def ...string) []string {
+	v := r.Get(key)
+	if v == "" {
+		return def
+	}
+	var out []string
+	for _, item := range strings.Split(v, sep) {
+		if item = strings.TrimSpace(item); item != "" {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+// Set sets the variable `key`.
+func (r *Reader) Set(key, value string) error {
+	return os.Setenv(r.prefix+key, value)
+}
+
+// Unset removes the variable `key`.
+func (r *Reader) Unset(key string) error {
+	return os.Unsetenv(r.prefix + key)
+}
+
+// Map returns a snapshot of the variables read by the reader, keyed by
+// their names without the prefix.
+func (r *Reader) Map() map[string]string {
+	m := make(map[string]string)
+	for _, kv := range os.Environ() {
+		key, value, _ := strings.Cut(kv, "=")
+		if name, ok := strings.CutPrefix(key, r.prefix); ok && name != "" {
+			m[name] = value
+		}
+	}
+	return m
+}
+
+// getParsed returns the variable `key` parsed by `parse`, or the default.
+func getParsed[T any](r *Reader, key string, def []T, parse func(s string) (T, error)) T {
+	if v := strings.TrimSpace(r.Get(key)); v != "" {
+		if parsed, err := parse(v); err == nil {
+			return parsed
+		}
+	}
+	if len(def) > 0 {
+		return def[0]
+	}
+	var zero T
+	return zero
+}
+
+// parseBool parses the boolean spellings accepted by GetBool.
+func parseBool(s string) (bool, error) {
+	switch strings.ToLower(s) {
+	case "1", "t", "true", "y", "yes", "on":
+		return true, nil
+	case "0", "f", "false", "n", "no", "off":
+		return false, nil
+	}
+	return false, fmt.Errorf("env: invalid boolean %q", s)
+}
+
+// Lookup returns the value of the variable `key` and whether it is set.
+func Lookup(key string) (string, bool) {
+	return defaultReader.Lookup(key)
+}
+
+// Get returns the value of the variable `key`, or `def` if it is unset or
+// empty.
+func Get(key string, def ...string) string {
+	return defaultReader.Get(key, def...)
+}
+
+// MustGet returns the value of the variable `key`, panicking if it is unset
+// or empty.
+func MustGet(key string) string {
+	return defaultReader.MustGet(key)
+}
+
+// GetInt returns the variable `key` as an int, or `def`.
+func GetInt(key string, def ...int) int {
+	return defaultReader.GetInt(key, def...)
+}
+
+// GetInt64 returns the variable `key` as an int64, or `def`.
+func GetInt64(key string, def ...int64) int64 {
+	return defaultReader.GetInt64(key, def...)
+}
+
+// GetFloat returns the variable `key` as a float64, or `def`.
+func GetFloat(key string, def ...float64) float64 {
+	return defaultReader.GetFloat(key, def...)
+}
+
+// GetBool returns the variable `key` as a bool, or `def`.
+func GetBool(key string, def ...bool) bool {
+	return defaultReader.GetBool(key, def...)
+}
+
+// GetDuration returns the variable `key` as a duration, or `def`.
+func GetDuration(key string, def ...time.Duration) time.Duration {
+	return defaultReader.GetDuration(key, def...)
+}
+
+// GetSlice returns the variable `key` split by `sep`, or `def`.
+func GetSlice(key, sep string, def ...string) []string {
+	return defaultReader.GetSlice(key, sep, def...)
+}
+
+// Set sets the variable `key`.
+func Set(key, value string) error {
+	return defaultReader.Set(key, value)
+}
+
+// Unset removes the variable `key`.
+func Unset(key string) error {
+	return defaultReader.Unset(key)
+}
+
+// Map returns a snapshot of all the variables.
+func Map() map[string]string {
+	return defaultReader.Map()
+}
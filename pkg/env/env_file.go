@@ -0,0 +1,285 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package env
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// DefaultFile is the file loaded when none is given.
+const DefaultFile = ".env"
+
+// Load sets the variables of the .env `files`, DefaultFile if none is
+// given, leaving the variables already set untouched so the real
+// environment wins. Later files do not override earlier ones.
+func Load(files ...string) error {
+	return load(false, files)
+}
+
+// Overload is like Load but overrides the variables already set, later
+// files overriding earlier ones.
+func Overload(files ...string) error {
+	return load(true, files)
+}
+
+// Read parses the .env `files`, DefaultFile if none is given, without
+// changing the environment. Later files override earlier ones.
+func Read(files ...string) (map[string]string, error) {
+	if len(files) == 0 {
+		files = []string{DefaultFile}
+	}
+	vars := make(map[string]string)
+	for _, file := range files {
+		m, err := readFile(file)
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range m {
+			vars[k] = v
+		}
+	}
+	return vars, nil
+}
+
+// Parse parses the content of a .env file.
+//
+// Each line holds KEY=VALUE, optionally preceded by "export". Blank lines
+// and lines starting with # are ignored. Values may be single-quoted, taken
+// literally, or double-quoted, in which \n, \r, \t, \" and \\ are unescaped
+// and which may span several lines. Unquoted values end at a # preceded by
+// a space. References to ${KEY} or $KEY in unquoted and double-quoted values
+// are replaced by the variable defined earlier in the file, or else by the
+// environment; \$ writes a literal dollar in double-quoted values.
+func Parse(r io.Reader) (map[string]string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{src: string(bytes.ReplaceAll(data, []byte("\r\n"), []byte("\n"))), vars: make(map[string]string)}
+	if err := p.parse(); err != nil {
+		return nil, err
+	}
+	return p.vars, nil
+}
+
+// load sets the variables of `files`, overriding set ones if `override`.
+func load(override bool, files []string) error {
+	if len(files) == 0 {
+		files = []string{DefaultFile}
+	}
+	for _, file := range files {
+		vars, err := readFile(file)
+		if err != nil {
+			return err
+		}
+		for k, v := range vars {
+			if _, set := os.LookupEnv(k); set && !override {
+				continue
+			}
+			if err := os.Setenv(k, v); err != nil {
+				return fmt.Errorf("env: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// readFile parses the .env file `file`.
+func readFile(file string) (map[string]string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	vars, err := Parse(bufio.NewReader(f))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+	return vars, nil
+}
+
+// parser parses the content of a .env file.
+type parser struct {
+	src  string
+	pos  int
+	line int
+	vars map[string]string
+}
+
+// parse parses all the lines.
+func (p *parser) parse() error {
+	p.line = 1
+	for p.pos < len(p.src) {
+		p.skipBlank()
+		if p.pos >= len(p.src) {
+			break
+		}
+		if p.src[p.pos] == '#' {
+			p.skipLine()
+			continue
+		}
+		line := p.line
+		if err := p.parseLine(); err != nil {
+			return fmt.Errorf("env: line %d: %w", line, err)
+		}
+	}
+	return nil
+}
+
+// parseLine parses one KEY=VALUE assignment.
+func (p *parser) parseLine() error {
+	rest := p.src[p.pos:]
+	if after, ok := strings.CutPrefix(rest, "export"); ok && len(after) > 0 && (after[0] == ' ' || after[0] == '\t') {
+		p.pos += len("export")
+		p.skipSpaces()
+	}
+	start := p.pos
+	for p.pos < len(p.src) && isKeyChar(p.src[p.pos]) {
+		p.pos++
+	}
+	key := p.src[start:p.pos]
+	if key == "" {
+		return fmt.Errorf("expected a variable name")
+	}
+	p.skipSpaces()
+	if p.pos >= len(p.src) || p.src[p.pos] != '=' {
+		return fmt.Errorf("expected = after %s", key)
+	}
+	p.pos++
+	p.skipSpaces()
+
+	value, err := p.parseValue()
+	if err != nil {
+		return fmt.Errorf("%s: %w", key, err)
+	}
+	p.vars[key] = value
+	return nil
+}
+
+// parseValue parses a quoted or unquoted value and the rest of its line.
+func (p *parser) parseValue() (string, error) {
+	if p.pos >= len(p.src) {
+		return "", nil
+	}
+	switch quote := p.src[p.pos]; quote {
+	case '\'', '"':
+		p.pos++
+		// Text read since the last \$ is in seg, expanded when flushed to b
+		// in double quotes, so that an escaped dollar stays literal
+		var b, seg strings.Builder
+		flush := func() {
+			if quote == '"' {
+				b.WriteString(p.expand(seg.String()))
+			} else {
+				b.WriteString(seg.String())
+			}
+			seg.Reset()
+		}
+		for {
+			if p.pos >= len(p.src) {
+				return "", fmt.Errorf("unterminated %c quote", quote)
+			}
+			c := p.src[p.pos]
+			p.pos++
+			switch {
+			case c == quote:
+				flush()
+				p.skipLine()
+				return b.String(), nil
+			case c == '\\' && quote == '"' && p.pos < len(p.src):
+				if p.src[p.pos] == '$' {
+					flush()
+					b.WriteByte('$')
+				} else {
+					seg.WriteByte(unescape(p.src[p.pos]))
+				}
+				p.pos++
+			default:
+				if c == '\n' {
+					p.line++
+				}
+				seg.WriteByte(c)
+			}
+		}
+	}
+
+	end := strings.IndexByte(p.src[p.pos:], '\n')
+	if end < 0 {
+		end = len(p.src) - p.pos
+	}
+	value := p.src[p.pos : p.pos+end]
+	p.pos += end
+	for i := 1; i < len(value); i++ {
+		if value[i] == '#' && (value[i-1] == ' ' || value[i-1] == '\t') {
+			value = value[:i]
+			break
+		}
+	}
+	return p.expand(strings.TrimSpace(value)), nil
+}
+
+// expand replaces the variable references in `s`.
+func (p *parser) expand(s string) string {
+	return os.Expand(s, func(key string) string {
+		if v, ok := p.vars[key]; ok {
+			return v
+		}
+		return os.Getenv(key)
+	})
+}
+
+// skipBlank skips spaces and line breaks.
+func (p *parser) skipBlank() {
+	for p.pos < len(p.src) {
+		switch p.src[p.pos] {
+		case '\n':
+			p.line++
+		case ' ', '\t', '\r':
+		default:
+			return
+		}
+		p.pos++
+	}
+}
+
+// skipSpaces skips spaces within a line.
+func (p *parser) skipSpaces() {
+	for p.pos < len(p.src) && (p.src[p.pos] == ' ' || p.src[p.pos] == '\t') {
+		p.pos++
+	}
+}
+
+// skipLine skips to the end of the line.
+func (p *parser) skipLine() {
+	if i := strings.IndexByte(p.src[p.pos:], '\n'); i >= 0 {
+		p.pos += i
+	} else {
+		p.pos = len(p.src)
+	}
+}
+
+// isKeyChar reports whether `c` may appear in a variable name.
+func isKeyChar(c byte) bool {
+	return c == '_' || c == '.' || c == '-' ||
+		('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9')
+}
+
+// unescape returns the character of the escape sequence \c.
+func unescape(c byte) byte {
+	switch c {
+	case 'n':
+		return '\n'
+	case 'r':
+		return '\r'
+	case 't':
+		return '\t'
+	}
+	return c
+}
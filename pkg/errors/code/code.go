@@ -0,0 +1,96 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package code defines error codes, which classify errors independently of
+// their messages so that callers and API layers can react to them.
+//
+// The predefined codes cover the failures shared by the packages of loom;
+// applications define their own codes with New, using values outside the
+// range of the predefined ones.
+package code
+
+import "fmt"
+
+// Code is an error code with a message and optional detail.
+type Code interface {
+	// Code returns the integer value of the code.
+	Code() int
+
+	// Message returns the short description of the code.
+	Message() string
+
+	// Detail returns extra information attached to the code, possibly nil.
+	Detail() interface{}
+}
+
+// Predefined codes, with values below 100.
+var (
+	CodeNil                  = New(-1, "", nil)                      // No code.
+	CodeOK                   = New(0, "OK", nil)                     // Success.
+	CodeInternalError        = New(50, "Internal Error", nil)        // An error occurred internally.
+	CodeValidationFailed     = New(51, "Validation Failed", nil)     // Data validation failed.
+	CodeInvalidParameter     = New(53, "Invalid Parameter", nil)     // A parameter is invalid.
+	CodeMissingParameter     = New(54, "Missing Parameter", nil)     // A parameter is missing.
+	CodeInvalidOperation     = New(55, "Invalid Operation", nil)     // The operation is not valid in the current state.
+	CodeInvalidConfiguration = New(56, "Invalid Configuration", nil) // The configuration is invalid.
+	CodeNotImplemented       = New(58, "Not Implemented", nil)       // The feature is not implemented.
+	CodeNotSupported         = New(59, "Not Supported", nil)         // The feature is not supported.
+	CodeOperationFailed      = New(60, "Operation Failed", nil)      // The operation failed.
+	CodeNotAuthorized        = New(61, "Not Authorized", nil)        // The caller is not authorized.
+	CodeSecurityReason       = New(62, "Security Reason", nil)       // The operation was refused for security reasons.
+	CodeServerBusy           = New(63, "Server Is Busy", nil)        // The server is busy; the operation may be retried.
+	CodeUnknown              = New(64, "Unknown Error", nil)         // The error is of unknown cause.
+	CodeNotFound             = New(65, "Not Found", nil)             // The resource does not exist.
+	CodeInternalPanic        = New(68, "Internal Panic", nil)        // A panic occurred and was recovered.
+	CodeTimeout              = New(69, "Timeout", nil)               // The operation did not complete in time.
+)
+
+// localCode is the Code implementation of New.
+type localCode struct {
+	// code is the integer value.
+	code int
+
+	// message is the short description.
+	message string
+
+	// detail is extra information.
+	detail interface{}
+}
+
+// New creates and returns a Code.
+func New(code int, message string, detail interface{}) Code {
+	return localCode{code: code, message: message, detail: detail}
+}
+
+// WithDetail returns a copy of `c` carrying `detail`.
+func WithDetail(c Code, detail interface{}) Code {
+	return localCode{code: c.Code(), message: c.Message(), detail: detail}
+}
+
+// Code implements Code.
+func (c localCode) Code() int {
+	return c.code
+}
+
+// Message implements Code.
+func (c localCode) Message() string {
+	return c.message
+}
+
+// Detail implements Code.
+func (c localCode) Detail() interface{} {
+	return c.detail
+}
+
+// String returns the code as "code:message", with the detail appended when
+// present.
+func (c localCode) String() string {
+	if c.detail != nil {
+		return fmt.Sprintf("%d:%s %v", c.code, c.message, c.detail)
+	}
+	if c.message != "" {
+		return fmt.Sprintf("%d:%s", c.code, c.message)
+	}
+	return fmt.Sprintf("%d", c.code)
+}
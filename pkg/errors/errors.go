@@ -0,0 +1,183 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package errors creates errors carrying an error code of pkg/errors/code.
+//
+// Coded errors wrap an optional cause and work with the standard errors.Is
+// and errors.As, which this package re-exports so that callers need a single
+// import. Code returns the code of the first coded error in a chain, so a code
+// set deep in a library survives being wrapped with fmt.Errorf and %w. Coded
+// errors encode to JSON as objects with their code, message and detail.
+package errors
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/focela/aegis/pkg/errors/code"
+)
+
+// Error is an error with a code, a text and an optional cause.
+type Error struct {
+	// code classifies the error.
+	code code.Code
+
+	// text describes the error.
+	text string
+
+	// cause is the wrapped error, possibly nil.
+	cause error
+}
+
+// coder is implemented by errors carrying a code.
+type coder interface {
+	Code() code.Code
+}
+
+// NewCode creates an error with `c` and the optional `text`, which defaults
+// to the message of `c`.
+func NewCode(c code.Code, text ...string) error {
+	e := &Error{code: c}
+	if len(text) > 0 {
+		e.text = text[0]
+	}
+	return e
+}
+
+// NewCodef creates an error with `c` and a formatted text.
+func NewCodef(c code.Code, format string, args ...interface{}) error {
+	return &Error{code: c, text: fmt.Sprintf(format, args...)}
+}
+
+// WrapCode wraps `err` with `c` and the optional `text`. It returns nil if
+// `err` is nil.
+func WrapCode(c code.Code, err error, text ...string) error {
+	if err == nil {
+		return nil
+	}
+	e := &Error{code: c, cause: err}
+	if len(text) > 0 {
+		e.text = text[0]
+	}
+	return e
+}
+
+// WrapCodef wraps `err` with `c` and a formatted text. It returns nil if
+// `err` is nil.
+func WrapCodef(c code.Code, err error, format string, args ...interface{}) error {
+	if err == nil {
+		return nil
+	}
+	return &Error{code: c, text: fmt.Sprintf(format, args...), cause: err}
+}
+
+// Error implements the error interface, joining the text and the cause with
+// ": ".
+func (e *Error) Error() string {
+	text := e.text
+	if text == "" && e.cause == nil {
+		text = e.code.Message()
+	}
+	switch {
+	case e.cause == nil:
+		return text
+	case text == "":
+		return e.cause.Error()
+	}
+	return text + ": " + e.cause.Error()
+}
+
+// Code returns the code of the error.
+func (e *Error) Code() code.Code {
+	return e.code
+}
+
+// Unwrap returns the cause of the error.
+func (e *Error) Unwrap() error {
+	return e.cause
+}
+
+// MarshalJSON encodes the error as an object with the integer code, the
+// message of the error and the detail of the code, if any, which is the
+// shape API layers return to clients.
+func (e *Error) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(e)
+}
+
+// jsonError is the JSON form of a coded error.
+type jsonError struct {
+	Code    int         `json:"code"`
+	Message string      `json:"message"`
+	Detail  interface{} `json:"detail,omitempty"`
+}
+
+// MarshalJSON encodes `err` as Error.MarshalJSON does, with the code of
+// Code(err), so errors of other packages carrying a code share its shape.
+func MarshalJSON(err error) ([]byte, error) {
+	c := Code(err)
+	return json.Marshal(jsonError{Code: c.Code(), Message: err.Error(), Detail: c.Detail()})
+}
+
+// Code returns the code of the first coded error in the chain of `err`, or
+// code.CodeNil if there is none.
+func Code(err error) code.Code {
+	var c coder
+	if errors.As(err, &c) {
+		return c.Code()
+	}
+	return code.CodeNil
+}
+
+// HasCode reports whether any error in the chain of `err` has the code `c`,
+// comparing codes by their integer value.
+func HasCode(err error, c code.Code) bool {
+	for err != nil {
+		if e, ok := err.(coder); ok && e.Code().Code() == c.Code() {
+			return true
+		}
+		switch u := err.(type) {
+		case interface{ Unwrap() error }:
+			err = u.Unwrap()
+		case interface{ Unwrap() []error }:
+			for _, inner := range u.Unwrap() {
+				if HasCode(inner, c) {
+					return true
+				}
+			}
+			return false
+		default:
+			return false
+		}
+	}
+	return false
+}
+
+// New returns an error with the text `text`, as the standard errors.New.
+func New(text string) error {
+	return errors.New(text)
+}
+
+// Is reports whether any error in the chain of `err` matches `target`, as the
+// standard errors.Is.
+func Is(err, target error) bool {
+	return errors.Is(err, target)
+}
+
+// As finds the first error in the chain of `err` matching `target`, as the
+// standard errors.As.
+func As(err error, target interface{}) bool {
+	return errors.As(err, target)
+}
+
+// Unwrap returns the result of the Unwrap method of `err`, as the standard
+// errors.Unwrap.
+func Unwrap(err error) error {
+	return errors.Unwrap(err)
+}
+
+// Join returns an error wrapping `errs`, as the standard errors.Join.
+func Join(errs ...error) error {
+	return errors.Join(errs...)
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package errors
+
+import (
+	"errors"
+
+	"github.com/focela/aegis/pkg/errors/code"
+)
+
+// retryableError marks its cause as retryable.
+type retryableError struct {
+	error
+}
+
+// Retryable implements the retryable check of IsRetryable.
+func (e *retryableError) Retryable() bool {
+	return true
+}
+
+// Unwrap returns the cause of the error.
+func (e *retryableError) Unwrap() error {
+	return e.error
+}
+
+// Retryable marks `err` as retryable, for IsRetryable. It returns nil if
+// `err` is nil.
+func Retryable(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &retryableError{err}
+}
+
+// IsRetryable reports whether the operation that failed with `err` may
+// succeed if retried.
+//
+// The first error in the chain of `err` with a Retryable() bool method
+// decides, which lets errors opt out as well as in; errors marked by
+// Retryable report true. Otherwise errors with the code code.CodeServerBusy
+// or code.CodeTimeout, and errors reporting Timeout() true, as network
+// timeouts do, are retryable.
+func IsRetryable(err error) bool {
+	var r interface{ Retryable() bool }
+	if errors.As(err, &r) {
+		return r.Retryable()
+	}
+	if HasCode(err, code.CodeServerBusy) || HasCode(err, code.CodeTimeout) {
+		return true
+	}
+	var t interface{ Timeout() bool }
+	return errors.As(err, &t) && t.Timeout()
+}
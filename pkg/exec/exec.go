@@ -0,0 +1,104 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package exec runs functions under a deadline and with panic recovery.
+//
+// DoWithTimeout replaces the usual select on a result channel and
+// time.After: it runs the function in a new goroutine and returns as soon as
+// the function returns, the timeout elapses or the context is done. Panics
+// are recovered into errors with code.CodeInternalPanic, whose detail is the
+// stack of the panic, and timeouts are reported with code.CodeTimeout.
+//
+// Go cannot stop a goroutine from outside, so a function that outlives its
+// deadline keeps running in the background until it returns, and its result
+// is discarded. The context passed to it is cancelled at the deadline:
+// functions that watch it, directly or through context-aware I/O, stop
+// promptly, while functions that ignore it leak their goroutine, and any
+// resources it holds, for as long as they run.
+package exec
+
+import (
+	"context"
+	"runtime/debug"
+	"time"
+
+	"github.com/focela/aegis/pkg/errors"
+	"github.com/focela/aegis/pkg/errors/code"
+)
+
+// result is the outcome of a function run by DoValueWithTimeout.
+type result[T any] struct {
+	value T
+	err   error
+}
+
+// DoWithTimeout runs `fn` with a context derived from `ctx` that expires
+// after `timeout`, and returns its error. If `fn` has not returned by then,
+// DoWithTimeout returns an error with code.CodeTimeout wrapping
+// context.DeadlineExceeded, or ctx.Err() if `ctx` was done first. A
+// non-positive timeout means no limit beyond that of `ctx`.
+func DoWithTimeout(ctx context.Context, timeout time.Duration, fn func(ctx context.Context) error) error {
+	_, err := DoValueWithTimeout(ctx, timeout, func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, fn(ctx)
+	})
+	return err
+}
+
+// DoValueWithTimeout is like DoWithTimeout for a function returning a value.
+func DoValueWithTimeout[T any](ctx context.Context, timeout time.Duration, fn func(ctx context.Context) (T, error)) (T, error) {
+	var cancel context.CancelFunc
+	runCtx := ctx
+	if timeout > 0 {
+		runCtx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		runCtx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	// The channel is buffered so that a late function can always deliver its
+	// result and exit
+	done := make(chan result[T], 1)
+	go func() {
+		var r result[T]
+		defer func() {
+			if p := recover(); p != nil {
+				r.err = panicError(p)
+			}
+			done <- r
+		}()
+		r.value, r.err = fn(runCtx)
+	}()
+
+	var zero T
+	select {
+	case r := <-done:
+		return r.value, r.err
+	case <-runCtx.Done():
+		if ctx.Err() != nil {
+			return zero, ctx.Err()
+		}
+		return zero, errors.WrapCodef(code.CodeTimeout, runCtx.Err(), "exec: timed out after %v", timeout)
+	}
+}
+
+// Try runs `fn` in the calling goroutine and returns its error, recovering a
+// panic into an error with code.CodeInternalPanic.
+func Try(fn func() error) (err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = panicError(p)
+		}
+	}()
+	return fn()
+}
+
+// panicError returns the error of the recovered panic `p`. The stack of the
+// panic is the detail of its code.
+func panicError(p interface{}) error {
+	c := code.WithDetail(code.CodeInternalPanic, string(debug.Stack()))
+	if err, ok := p.(error); ok {
+		return errors.WrapCode(c, err, "exec: panic")
+	}
+	return errors.NewCodef(c, "exec: panic: %v", p)
+}
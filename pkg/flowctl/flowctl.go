@@ -0,0 +1,250 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package flowctl coalesces bursts of calls with debouncing and throttling.
+//
+// A Debouncer runs its function once a burst of calls has been quiet for a
+// wait period, which suits reacting to the last of many configuration change
+// events. A Throttler runs its function at most once per interval, at the
+// first call and again at the end of the interval if more calls arrived,
+// which suits cache invalidation under a steady stream of writes. Both run
+// the function in a timer goroutine, never concurrently with itself, and
+// can run pending work at once with Flush or drop it with Cancel.
+package flowctl
+
+import (
+	"sync"
+	"time"
+)
+
+// DebounceOption configures a Debouncer.
+type DebounceOption func(*Debouncer)
+
+// WithMaxWait runs the function after at most `d` since the first call of a
+// burst, even if calls keep arriving, so a never-ending burst cannot starve
+// it.
+func WithMaxWait(d time.Duration) DebounceOption {
+	return func(db *Debouncer) {
+		db.maxWait = d
+	}
+}
+
+// Debouncer delays a function until calls stop for a wait period. It is safe
+// for concurrent use.
+type Debouncer struct {
+	// mu guards the fields below it.
+	mu sync.Mutex
+
+	// fn is the debounced function.
+	fn func()
+
+	// wait is the quiet period and maxWait the longest delay, zero for none.
+	wait    time.Duration
+	maxWait time.Duration
+
+	// timer fires the pending run, nil when none is pending.
+	timer *time.Timer
+
+	// first is the time of the first call of the pending burst.
+	first time.Time
+
+	// gen identifies the pending run, so a stale timer does nothing.
+	gen uint64
+
+	// runMu serializes the runs of fn.
+	runMu sync.Mutex
+}
+
+// Debounce returns a Debouncer running `fn` once calls to Call have stopped
+// for `wait`.
+func Debounce(fn func(), wait time.Duration, opts ...DebounceOption) *Debouncer {
+	db := &Debouncer{fn: fn, wait: wait}
+	for _, opt := range opts {
+		opt(db)
+	}
+	return db
+}
+
+// Call schedules a run of the function after the wait period, postponing a
+// pending one.
+func (db *Debouncer) Call() {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	now := time.Now()
+	if db.timer == nil {
+		db.first = now
+	} else {
+		db.timer.Stop()
+	}
+	delay := db.wait
+	if db.maxWait > 0 {
+		delay = min(delay, db.first.Add(db.maxWait).Sub(now))
+	}
+	db.gen++
+	gen := db.gen
+	db.timer = time.AfterFunc(delay, func() {
+		db.fire(gen)
+	})
+}
+
+// Flush runs a pending call now, in the calling goroutine, reporting whether
+// there was one.
+func (db *Debouncer) Flush() bool {
+	db.mu.Lock()
+	if !db.take() {
+		db.mu.Unlock()
+		return false
+	}
+	db.mu.Unlock()
+	db.run()
+	return true
+}
+
+// Cancel drops a pending call, reporting whether there was one.
+func (db *Debouncer) Cancel() bool {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	return db.take()
+}
+
+// Pending reports whether a call is pending.
+func (db *Debouncer) Pending() bool {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	return db.timer != nil
+}
+
+// fire runs the pending call `gen` from its timer.
+func (db *Debouncer) fire(gen uint64) {
+	db.mu.Lock()
+	if db.gen != gen || !db.take() {
+		db.mu.Unlock()
+		return
+	}
+	db.mu.Unlock()
+	db.run()
+}
+
+// take clears the pending call, reporting whether there was one. It must be
+// called with the lock held.
+func (db *Debouncer) take() bool {
+	if db.timer == nil {
+		return false
+	}
+	db.timer.Stop()
+	db.timer = nil
+	db.gen++
+	return true
+}
+
+// run runs the function, one run at a time.
+func (db *Debouncer) run() {
+	db.runMu.Lock()
+	defer db.runMu.Unlock()
+	db.fn()
+}
+
+// Throttler runs a function at most once per interval. It is safe for
+// concurrent use.
+type Throttler struct {
+	// mu guards the fields below it.
+	mu sync.Mutex
+
+	// fn is the throttled function.
+	fn func()
+
+	// interval is the shortest time between runs.
+	interval time.Duration
+
+	// timer ends the current interval, nil outside of one.
+	timer *time.Timer
+
+	// gen identifies the current interval, so a stale timer does nothing.
+	gen uint64
+
+	// pending reports a call during the current interval.
+	pending bool
+
+	// runMu serializes the runs of fn.
+	runMu sync.Mutex
+}
+
+// Throttle returns a Throttler running `fn` at most once per `interval`.
+func Throttle(fn func(), interval time.Duration) *Throttler {
+	return &Throttler{fn: fn, interval: interval}
+}
+
+// Call runs the function at once, in a new goroutine, if no interval is in
+// progress, and otherwise schedules a run at the end of the interval.
+func (th *Throttler) Call() {
+	th.mu.Lock()
+	defer th.mu.Unlock()
+	if th.timer != nil {
+		th.pending = true
+		return
+	}
+	th.start()
+	go th.run()
+}
+
+// Flush runs a call pending for the end of the interval now, in the calling
+// goroutine, reporting whether there was one. A new interval starts.
+func (th *Throttler) Flush() bool {
+	th.mu.Lock()
+	if !th.pending {
+		th.mu.Unlock()
+		return false
+	}
+	th.pending = false
+	th.timer.Stop()
+	th.start()
+	th.mu.Unlock()
+	th.run()
+	return true
+}
+
+// Cancel drops a call pending for the end of the interval, reporting whether
+// there was one.
+func (th *Throttler) Cancel() bool {
+	th.mu.Lock()
+	defer th.mu.Unlock()
+	pending := th.pending
+	th.pending = false
+	return pending
+}
+
+// start begins an interval. It must be called with the lock held.
+func (th *Throttler) start() {
+	th.gen++
+	gen := th.gen
+	th.timer = time.AfterFunc(th.interval, func() {
+		th.end(gen)
+	})
+}
+
+// end closes the interval `gen`, running a pending call, which starts the
+// next interval.
+func (th *Throttler) end(gen uint64) {
+	th.mu.Lock()
+	if th.gen != gen {
+		th.mu.Unlock()
+		return
+	}
+	if !th.pending {
+		th.timer = nil
+		th.mu.Unlock()
+		return
+	}
+	th.pending = false
+	th.start()
+	th.mu.Unlock()
+	th.run()
+}
+
+// run runs the function, one run at a time.
+func (th *Throttler) run() {
+	th.runMu.Lock()
+	defer th.runMu.Unlock()
+	th.fn()
+}
@@ -0,0 +1,228 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package fs provides file system helpers: copying and moving files and
+// directories, globbing with "**", searching ancestor directories,
+// temporary files and atomic writes, per-user application directories, file
+// locks, following growing files, memory mapping, disk usage statistics,
+// human-readable sizes and path joining that cannot escape its root.
+package fs
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Errors returned by the package.
+var (
+	// ErrNotFound is returned by SearchUp when no ancestor holds the file.
+	ErrNotFound = errors.New("fs: file not found")
+
+	// ErrPathEscape is returned by SafeJoin for paths leaving their root.
+	ErrPathEscape = errors.New("fs: path escapes its root")
+)
+
+// Exists reports whether `path` exists, following symbolic links.
+func Exists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// IsDir reports whether `path` is a directory, following symbolic links.
+func IsDir(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
+
+// IsFile reports whether `path` is a regular file, following symbolic links.
+func IsFile(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.Mode().IsRegular()
+}
+
+// Copy copies the regular file `src` to `dst` with its permissions,
+// creating the parent directories of `dst` and replacing any file there. If
+// `dst` is an existing directory, the file is copied into it.
+func Copy(src, dst string) error {
+	info, err := os.Stat(src)
+	if err != nil {
+		return err
+	}
+	if !info.Mode().IsRegular() {
+		return fmt.Errorf("fs: %q is not a regular file", src)
+	}
+	if IsDir(dst) {
+		dst = filepath.Join(dst, filepath.Base(src))
+	}
+	if same, err := sameFile(info, dst); err != nil || same {
+		if same {
+			return fmt.Errorf("fs: %q and %q are the same file", src, dst)
+		}
+		return err
+	}
+	return copyFile(src, dst, info.Mode())
+}
+
+// CopyDir copies the directory `src` recursively to `dst`, which is created
+// if missing. Permissions are kept and symbolic links are copied as links.
+func CopyDir(src, dst string) error {
+	info, err := os.Stat(src)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("fs: %q is not a directory", src)
+	}
+	absSrc, err := filepath.Abs(src)
+	if err != nil {
+		return err
+	}
+	absDst, err := filepath.Abs(dst)
+	if err != nil {
+		return err
+	}
+	if absDst == absSrc || strings.HasPrefix(absDst, absSrc+string(filepath.Separator)) {
+		return fmt.Errorf("fs: cannot copy %q into itself", src)
+	}
+
+	// Directories are kept writable until filled, then get their own mode
+	type dirMode struct {
+		path string
+		mode fs.FileMode
+	}
+	var dirs []dirMode
+	err = filepath.WalkDir(src, func(file string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, file)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		switch {
+		case d.IsDir():
+			dirs = append(dirs, dirMode{target, info.Mode().Perm()})
+			return os.MkdirAll(target, 0o700)
+		case info.Mode()&fs.ModeSymlink != 0:
+			link, err := os.Readlink(file)
+			if err != nil {
+				return err
+			}
+			os.Remove(target)
+			return os.Symlink(link, target)
+		case info.Mode().IsRegular():
+			return copyFile(file, target, info.Mode())
+		default:
+			// Devices, sockets and pipes cannot be copied
+			return nil
+		}
+	})
+	for i := len(dirs) - 1; i >= 0 && err == nil; i-- {
+		err = os.Chmod(dirs[i].path, dirs[i].mode)
+	}
+	return err
+}
+
+// Move moves the file or directory `src` to `dst`, copying and removing it
+// when they are on different file systems.
+func Move(src, dst string) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return err
+	}
+	err := os.Rename(src, dst)
+	if err == nil || !isCrossDevice(err) {
+		return err
+	}
+	if IsDir(src) {
+		err = CopyDir(src, dst)
+	} else {
+		err = Copy(src, dst)
+	}
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(src)
+}
+
+// SearchUp looks for `name` in the directory `start` and then in each of
+// its ancestors, returning the path of the first match, or ErrNotFound.
+// It is typically used to find a configuration file or a module root.
+func SearchUp(start, name string) (string, error) {
+	dir, err := filepath.Abs(start)
+	if err != nil {
+		return "", err
+	}
+	for {
+		candidate := filepath.Join(dir, name)
+		if _, err := os.Lstat(candidate); err == nil {
+			return candidate, nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", fmt.Errorf("%w: %q above %q", ErrNotFound, name, start)
+		}
+		dir = parent
+	}
+}
+
+// SafeJoin joins `elems` to the directory `root`, returning ErrPathEscape
+// if the result would lie outside of `root`, as for user-supplied names
+// containing ".." or absolute paths. Symbolic links are not resolved.
+func SafeJoin(root string, elems ...string) (string, error) {
+	rel := filepath.Join(elems...)
+	if filepath.IsAbs(rel) || filepath.VolumeName(rel) != "" || strings.HasPrefix(rel, string(filepath.Separator)) {
+		return "", fmt.Errorf("%w: %q is absolute", ErrPathEscape, rel)
+	}
+	if rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("%w: %q", ErrPathEscape, rel)
+	}
+	return filepath.Join(root, rel), nil
+}
+
+// copyFile copies the content of `src` to `dst`, created with `mode`.
+func copyFile(src, dst string, mode fs.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return err
+	}
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode.Perm())
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(out, in)
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		// The mode of an existing file is not changed by OpenFile
+		err = os.Chmod(dst, mode.Perm())
+	}
+	return err
+}
+
+// sameFile reports whether `dst` is the file described by `info`.
+func sameFile(info fs.FileInfo, dst string) (bool, error) {
+	dstInfo, err := os.Stat(dst)
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return os.SameFile(info, dstInfo), nil
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package fs
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+// atomicOptions holds the settings of WriteFileAtomic.
+type atomicOptions struct {
+	// backups is the number of previous versions kept.
+	backups int
+}
+
+// AtomicOption configures WriteFileAtomic.
+type AtomicOption func(*atomicOptions)
+
+// WithBackups keeps the `n` previous versions of the file, the latest as
+// `file`.1 and the oldest as `file`.`n`.
+func WithBackups(n int) AtomicOption {
+	return func(o *atomicOptions) {
+		o.backups = max(n, 0)
+	}
+}
+
+// WriteFileAtomic writes `data` to `file` through a temporary file in the
+// same directory, synced then renamed over it, so readers see either the old
+// or the new content, never a partial one, and a crash cannot leave the file
+// torn. The directory is synced too, so the rename survives a power loss.
+func WriteFileAtomic(file string, data []byte, perm os.FileMode, opts ...AtomicOption) error {
+	o := &atomicOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	dir := filepath.Dir(file)
+	f, err := os.CreateTemp(dir, "."+filepath.Base(file)+".*")
+	if err != nil {
+		return err
+	}
+	tmp := f.Name()
+	_, err = f.Write(data)
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tmp, perm)
+	}
+	if err == nil && o.backups > 0 {
+		err = rotateBackups(file, o.backups)
+	}
+	if err == nil {
+		err = os.Rename(tmp, file)
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return syncDir(dir)
+}
+
+// rotateBackups shifts the backups of `file`, dropping the `n`th, and makes
+// the current content the first. The file stays in place until renamed over.
+func rotateBackups(file string, n int) error {
+	if _, err := os.Lstat(file); errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	backup := func(i int) string {
+		return fmt.Sprintf("%s.%d", file, i)
+	}
+	if err := os.Remove(backup(n)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	for i := n - 1; i > 0; i-- {
+		if err := os.Rename(backup(i), backup(i+1)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	// A hard link keeps the file in place; copy where links are unsupported
+	if err := os.Link(file, backup(1)); err != nil {
+		return Copy(file, backup(1))
+	}
+	return nil
+}
+
+// syncDir flushes the entries of the directory `dir` to storage. Windows
+// cannot sync directories, and journals renames anyway.
+func syncDir(dir string) error {
+	if runtime.GOOS == "windows" {
+		return nil
+	}
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	err = d.Sync()
+	if closeErr := d.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
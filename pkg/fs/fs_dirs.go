@@ -0,0 +1,151 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package fs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+// appDirPerm is the permission of the application directories, which may
+// hold credentials.
+const appDirPerm = 0o700
+
+// UserConfigDir returns the configuration directory of the application
+// `app`, creating it if missing:
+//
+//   - Linux and other Unix systems: $XDG_CONFIG_HOME/app or ~/.config/app
+//   - macOS: ~/Library/Application Support/app
+//   - Windows: %APPDATA%\app
+func UserConfigDir(app string) (string, error) {
+	return appDir(app, func(home string) (string, string, error) {
+		switch runtime.GOOS {
+		case "windows":
+			dir, err := winDir("APPDATA")
+			return dir, "", err
+		case "darwin", "ios":
+			return filepath.Join(home, "Library", "Application Support"), "", nil
+		}
+		return xdgDir("XDG_CONFIG_HOME", home, ".config"), "", nil
+	})
+}
+
+// UserCacheDir returns the cache directory of the application `app`,
+// creating it if missing:
+//
+//   - Linux and other Unix systems: $XDG_CACHE_HOME/app or ~/.cache/app
+//   - macOS: ~/Library/Caches/app
+//   - Windows: %LOCALAPPDATA%\app\Cache
+func UserCacheDir(app string) (string, error) {
+	return appDir(app, func(home string) (string, string, error) {
+		switch runtime.GOOS {
+		case "windows":
+			dir, err := winDir("LOCALAPPDATA")
+			return dir, "Cache", err
+		case "darwin", "ios":
+			return filepath.Join(home, "Library", "Caches"), "", nil
+		}
+		return xdgDir("XDG_CACHE_HOME", home, ".cache"), "", nil
+	})
+}
+
+// UserDataDir returns the data directory of the application `app`,
+// creating it if missing:
+//
+//   - Linux and other Unix systems: $XDG_DATA_HOME/app or
+//     ~/.local/share/app
+//   - macOS: ~/Library/Application Support/app
+//   - Windows: %LOCALAPPDATA%\app
+func UserDataDir(app string) (string, error) {
+	return appDir(app, func(home string) (string, string, error) {
+		switch runtime.GOOS {
+		case "windows":
+			dir, err := winDir("LOCALAPPDATA")
+			return dir, "", err
+		case "darwin", "ios":
+			return filepath.Join(home, "Library", "Application Support"), "", nil
+		}
+		return xdgDir("XDG_DATA_HOME", home, filepath.Join(".local", "share")), "", nil
+	})
+}
+
+// UserStateDir returns the directory of the application `app` for state
+// such as logs and history, creating it if missing:
+//
+//   - Linux and other Unix systems: $XDG_STATE_HOME/app or
+//     ~/.local/state/app
+//   - macOS: ~/Library/Application Support/app
+//   - Windows: %LOCALAPPDATA%\app
+func UserStateDir(app string) (string, error) {
+	return appDir(app, func(home string) (string, string, error) {
+		switch runtime.GOOS {
+		case "windows":
+			dir, err := winDir("LOCALAPPDATA")
+			return dir, "", err
+		case "darwin", "ios":
+			return filepath.Join(home, "Library", "Application Support"), "", nil
+		}
+		return xdgDir("XDG_STATE_HOME", home, filepath.Join(".local", "state")), "", nil
+	})
+}
+
+// EnsureDir creates the directory `dir` and its parents if missing, with
+// `perm`, 0o755 by default. It fails if `dir` exists and is not a
+// directory.
+func EnsureDir(dir string, perm ...os.FileMode) error {
+	mode := os.FileMode(0o755)
+	if len(perm) > 0 {
+		mode = perm[0]
+	}
+	if err := os.MkdirAll(dir, mode); err != nil {
+		return fmt.Errorf("fs: %w", err)
+	}
+	return nil
+}
+
+// appDir returns the directory `app` below the base directory returned by
+// `base`, called with the home directory, followed by the subdirectory it
+// returns, and creates it.
+func appDir(app string, base func(home string) (dir, sub string, err error)) (string, error) {
+	if app == "" || filepath.Base(app) != app || app == "." || app == ".." {
+		return "", fmt.Errorf("fs: invalid application name %q", app)
+	}
+	home, _ := os.UserHomeDir()
+	dir, sub, err := base(home)
+	if err != nil {
+		return "", err
+	}
+	if dir == "" || !filepath.IsAbs(dir) {
+		return "", fmt.Errorf("fs: no base directory for %s", app)
+	}
+	dir = filepath.Join(dir, app, sub)
+	if err := EnsureDir(dir, appDirPerm); err != nil {
+		return "", err
+	}
+	return dir, nil
+}
+
+// xdgDir returns the directory of the XDG variable `env`, or `fallback`
+// below `home`. Relative values are ignored, as the specification requires.
+func xdgDir(env, home, fallback string) string {
+	if dir := os.Getenv(env); filepath.IsAbs(dir) {
+		return dir
+	}
+	if home == "" {
+		return ""
+	}
+	return filepath.Join(home, fallback)
+}
+
+// winDir returns the directory of the Windows variable `env`.
+func winDir(env string) (string, error) {
+	dir := os.Getenv(env)
+	if dir == "" {
+		return "", fmt.Errorf("fs: %%%s%% is not set", env)
+	}
+	return dir, nil
+}
@@ -0,0 +1,203 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package fs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ErrLocked is returned by TryLock when another holder has the lock.
+var ErrLocked = errors.New("fs: file is locked")
+
+// lockPollInterval is the interval at which Lock retries.
+const lockPollInterval = 50 * time.Millisecond
+
+// FlockOption configures a Flock.
+type FlockOption func(*Flock)
+
+// WithStaleTTL enables the recovery of stale locks. The holder refreshes the
+// modification time of the lock file every third of `ttl`; a lock whose file
+// was not refreshed for `ttl` is deemed stale, as held by a hung process or
+// by a host that lost a network file system, and is broken by removing the
+// file. Breaking a lock requires Unix semantics for removing open files.
+func WithStaleTTL(ttl time.Duration) FlockOption {
+	return func(l *Flock) {
+		l.ttl = ttl
+	}
+}
+
+// Flock is an exclusive advisory lock on a file, held through flock on Unix
+// and LockFileEx on Windows. The operating system releases it when the
+// holding process exits, so crashed holders leave no lock behind.
+//
+// A Flock is safe for concurrent use but is not reentrant: locking a Flock
+// held by the same value blocks. The file is created if missing and left in
+// place by Unlock.
+type Flock struct {
+	// mu guards the fields below it.
+	mu sync.Mutex
+
+	path string
+	ttl  time.Duration
+
+	// file is the locked file, nil when unlocked.
+	file *os.File
+
+	// stop ends the refreshing goroutine.
+	stop chan struct{}
+}
+
+// NewFlock returns an unlocked Flock on the file `path`.
+func NewFlock(path string, opts ...FlockOption) *Flock {
+	l := &Flock{path: path}
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l
+}
+
+// Path returns the path of the lock file.
+func (l *Flock) Path() string {
+	return l.path
+}
+
+// Locked reports whether the lock is held by `l`.
+func (l *Flock) Locked() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.file != nil
+}
+
+// TryLock takes the lock if it is free, returning ErrLocked otherwise.
+func (l *Flock) TryLock() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.file != nil {
+		return ErrLocked
+	}
+	for {
+		f, err := os.OpenFile(l.path, os.O_CREATE|os.O_RDWR, 0o644)
+		if err != nil {
+			return fmt.Errorf("fs: %w", err)
+		}
+		err = lockFile(f)
+		if errors.Is(err, ErrLocked) {
+			f.Close()
+			if l.breakStale() {
+				continue
+			}
+			return ErrLocked
+		}
+		if err != nil {
+			f.Close()
+			return fmt.Errorf("fs: locking %s: %w", l.path, err)
+		}
+
+		// A lock broken meanwhile leaves us holding a removed file
+		if !l.current(f) {
+			f.Close()
+			continue
+		}
+		l.acquired(f)
+		return nil
+	}
+}
+
+// Lock takes the lock, waiting for it to be free until `ctx` ends.
+func (l *Flock) Lock(ctx context.Context) error {
+	for {
+		err := l.TryLock()
+		if !errors.Is(err, ErrLocked) {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("fs: locking %s: %w", l.path, ctx.Err())
+		case <-time.After(lockPollInterval):
+		}
+	}
+}
+
+// Unlock releases the lock. It does nothing if the lock is not held.
+func (l *Flock) Unlock() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.file == nil {
+		return nil
+	}
+	if l.stop != nil {
+		close(l.stop)
+		l.stop = nil
+	}
+	err := unlockFile(l.file)
+	if closeErr := l.file.Close(); err == nil {
+		err = closeErr
+	}
+	l.file = nil
+	if err != nil {
+		return fmt.Errorf("fs: unlocking %s: %w", l.path, err)
+	}
+	return nil
+}
+
+// acquired records the lock on `f`, writing the process id to it for
+// diagnosis. It must be called with the lock held.
+func (l *Flock) acquired(f *os.File) {
+	l.file = f
+	if err := f.Truncate(0); err == nil {
+		f.WriteAt([]byte(strconv.Itoa(os.Getpid())+"\n"), 0)
+	}
+	if l.ttl <= 0 {
+		return
+	}
+	now := time.Now()
+	os.Chtimes(l.path, now, now)
+	l.stop = make(chan struct{})
+	go l.refresh(l.stop)
+}
+
+// refresh touches the lock file periodically until `stop` is closed.
+func (l *Flock) refresh(stop chan struct{}) {
+	ticker := time.NewTicker(l.ttl / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			now := time.Now()
+			os.Chtimes(l.path, now, now)
+		case <-stop:
+			return
+		}
+	}
+}
+
+// breakStale removes the lock file if it is stale, reporting whether it
+// did.
+func (l *Flock) breakStale() bool {
+	if l.ttl <= 0 {
+		return false
+	}
+	info, err := os.Stat(l.path)
+	if err != nil || time.Since(info.ModTime()) < l.ttl {
+		return false
+	}
+	return os.Remove(l.path) == nil
+}
+
+// current reports whether `f` is still the file at the lock path.
+func (l *Flock) current(f *os.File) bool {
+	opened, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	onDisk, err := os.Stat(l.path)
+	return err == nil && os.SameFile(opened, onDisk)
+}
@@ -0,0 +1,25 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+//go:build (!unix && !windows) || aix
+
+package fs
+
+import (
+	"errors"
+	"os"
+)
+
+// errNoFlock is returned on systems without file locking.
+var errNoFlock = errors.New("file locking is not supported on this system")
+
+// lockFile fails, file locking being unsupported.
+func lockFile(f *os.File) error {
+	return errNoFlock
+}
+
+// unlockFile fails, file locking being unsupported.
+func unlockFile(f *os.File) error {
+	return errNoFlock
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package fs
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// Glob returns the paths matching `pattern`, in lexical order.
+//
+// The pattern has the syntax of path.Match with slash separators, plus the
+// element "**" matching any number of directories, including none:
+//
+//	fs.Glob("configs/**/*.yaml")
+//
+// Hidden files are matched like any other. Unlike filepath.Glob, a pattern
+// without meta characters is returned only if the file exists.
+func Glob(pattern string) ([]string, error) {
+	pattern = filepath.ToSlash(pattern)
+	if _, err := path.Match(strings.ReplaceAll(pattern, "**", "*"), ""); err != nil {
+		return nil, fmt.Errorf("fs: invalid pattern %q: %w", pattern, err)
+	}
+	if !strings.Contains(pattern, "**") {
+		return filepath.Glob(filepath.FromSlash(pattern))
+	}
+
+	// Walk only below the longest prefix without meta characters
+	root, rest := ".", pattern
+	parts := strings.Split(pattern, "/")
+	for i, part := range parts {
+		if hasMeta(part) {
+			if i > 0 {
+				root, rest = strings.Join(parts[:i], "/"), strings.Join(parts[i:], "/")
+				if root == "" {
+					root = "/"
+				}
+			}
+			break
+		}
+	}
+
+	var matches []string
+	err := filepath.WalkDir(filepath.FromSlash(root), func(file string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if file == filepath.FromSlash(root) {
+				return nil
+			}
+			return err
+		}
+		rel, err := filepath.Rel(filepath.FromSlash(root), file)
+		if err != nil || rel == "." {
+			return err
+		}
+		if Match(rest, filepath.ToSlash(rel)) {
+			matches = append(matches, file)
+		}
+		return nil
+	})
+	return matches, err
+}
+
+// Match reports whether the slash-separated `name` matches `pattern`, in
+// the syntax of Glob. Malformed patterns match nothing.
+func Match(pattern, name string) bool {
+	return matchParts(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+// matchParts matches the elements of a name against those of a pattern.
+func matchParts(pattern, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			// Collapse repeated "**" and try every number of elements
+			for len(pattern) > 0 && pattern[0] == "**" {
+				pattern = pattern[1:]
+			}
+			if len(pattern) == 0 {
+				return true
+			}
+			for i := range name {
+				if matchParts(pattern, name[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(name) == 0 {
+			return false
+		}
+		if ok, _ := path.Match(pattern[0], name[0]); !ok {
+			return false
+		}
+		pattern, name = pattern[1:], name[1:]
+	}
+	return len(name) == 0
+}
+
+// hasMeta reports whether `s` contains glob meta characters.
+func hasMeta(s string) bool {
+	return strings.ContainsAny(s, `*?[\`)
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package fs
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/focela/aegis/pkg/encoding/binary"
+)
+
+// MmapOption configures Mmap.
+type MmapOption func(*Mapping)
+
+// WithWritable maps the file for writing: changes to the bytes of the
+// mapping are written to the file, at the latest by Flush or Close.
+func WithWritable() MmapOption {
+	return func(m *Mapping) {
+		m.writable = true
+	}
+}
+
+// Mapping is a file mapped into memory. Its bytes must not be used after
+// Close.
+type Mapping struct {
+	writable bool
+
+	// data holds the bytes of the file, nil for an empty file.
+	data []byte
+
+	// file is the mapped file, kept open by the fallback implementation.
+	file *os.File
+
+	// handle is the file mapping object on Windows.
+	handle uintptr
+
+	// closed reports whether Close was called.
+	closed bool
+}
+
+// Mmap maps the file `path` into memory, read-only unless WithWritable is
+// given. The mapping covers the size of the file when mapped. Reading its
+// bytes pages the file in on demand, so large data files can be parsed
+// without reading them whole, for instance with Decoder.
+func Mmap(path string, opts ...MmapOption) (*Mapping, error) {
+	m := &Mapping{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	flag := os.O_RDONLY
+	if m.writable {
+		flag = os.O_RDWR
+	}
+	f, err := os.OpenFile(path, flag, 0)
+	if err != nil {
+		return nil, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	size := info.Size()
+	if size != int64(int(size)) {
+		f.Close()
+		return nil, fmt.Errorf("fs: %s is too large to map", path)
+	}
+	if size == 0 {
+		return m, f.Close()
+	}
+	if err := m.mmap(f, int(size)); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("fs: mapping %s: %w", path, err)
+	}
+	if m.file == nil {
+		return m, f.Close()
+	}
+	return m, nil
+}
+
+// Bytes returns the mapped bytes. They may be modified only if the mapping
+// is writable.
+func (m *Mapping) Bytes() []byte {
+	return m.data
+}
+
+// Len returns the number of mapped bytes.
+func (m *Mapping) Len() int {
+	return len(m.data)
+}
+
+// Decoder returns a Decoder reading the mapped bytes without copying them.
+func (m *Mapping) Decoder() *binary.Decoder {
+	return binary.NewDecoder(m.data)
+}
+
+// Flush writes the changes of a writable mapping to the file.
+func (m *Mapping) Flush() error {
+	if m.closed {
+		return os.ErrClosed
+	}
+	if !m.writable || m.data == nil {
+		return nil
+	}
+	if err := m.flush(); err != nil {
+		return fmt.Errorf("fs: flushing mapping: %w", err)
+	}
+	return nil
+}
+
+// Close flushes a writable mapping and unmaps the file.
+func (m *Mapping) Close() error {
+	if m.closed {
+		return nil
+	}
+	m.closed = true
+	if m.data == nil {
+		return nil
+	}
+	var err error
+	if m.writable {
+		err = m.flush()
+	}
+	if unmapErr := m.munmap(); err == nil {
+		err = unmapErr
+	}
+	m.data = nil
+	if err != nil {
+		return fmt.Errorf("fs: unmapping: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+//go:build !unix && !windows
+
+package fs
+
+import (
+	"io"
+	"os"
+)
+
+// mmap reads `size` bytes of `f`, memory mapping being unsupported. The
+// file stays open for Flush.
+func (m *Mapping) mmap(f *os.File, size int) error {
+	data := make([]byte, size)
+	if _, err := io.ReadFull(f, data); err != nil {
+		return err
+	}
+	m.data = data
+	m.file = f
+	return nil
+}
+
+// flush writes the bytes back to the file.
+func (m *Mapping) flush() error {
+	if _, err := m.file.WriteAt(m.data, 0); err != nil {
+		return err
+	}
+	return m.file.Sync()
+}
+
+// munmap closes the file.
+func (m *Mapping) munmap() error {
+	return m.file.Close()
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+//go:build unix
+
+package fs
+
+import (
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// mmap maps `size` bytes of `f`.
+func (m *Mapping) mmap(f *os.File, size int) error {
+	prot := unix.PROT_READ
+	if m.writable {
+		prot |= unix.PROT_WRITE
+	}
+	data, err := unix.Mmap(int(f.Fd()), 0, size, prot, unix.MAP_SHARED)
+	if err != nil {
+		return err
+	}
+	m.data = data
+	return nil
+}
+
+// flush writes the changed pages to the file.
+func (m *Mapping) flush() error {
+	return unix.Msync(m.data, unix.MS_SYNC)
+}
+
+// munmap unmaps the file.
+func (m *Mapping) munmap() error {
+	return unix.Munmap(m.data)
+}
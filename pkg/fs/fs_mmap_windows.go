@@ -0,0 +1,53 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package fs
+
+import (
+	"os"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+// mmap maps `size` bytes of `f`. The file stays open for Flush.
+func (m *Mapping) mmap(f *os.File, size int) error {
+	prot, access := uint32(windows.PAGE_READONLY), uint32(windows.FILE_MAP_READ)
+	if m.writable {
+		prot, access = windows.PAGE_READWRITE, windows.FILE_MAP_WRITE
+	}
+	handle, err := windows.CreateFileMapping(windows.Handle(f.Fd()), nil, prot, 0, 0, nil)
+	if err != nil {
+		return err
+	}
+	addr, err := windows.MapViewOfFile(handle, access, 0, 0, uintptr(size))
+	if err != nil {
+		windows.CloseHandle(handle)
+		return err
+	}
+	m.data = unsafe.Slice((*byte)(unsafe.Add(nil, addr)), size)
+	m.handle = uintptr(handle)
+	m.file = f
+	return nil
+}
+
+// flush writes the changed pages to the file.
+func (m *Mapping) flush() error {
+	if err := windows.FlushViewOfFile(uintptr(unsafe.Pointer(&m.data[0])), uintptr(len(m.data))); err != nil {
+		return err
+	}
+	return windows.FlushFileBuffers(windows.Handle(m.file.Fd()))
+}
+
+// munmap unmaps the file and closes it.
+func (m *Mapping) munmap() error {
+	err := windows.UnmapViewOfFile(uintptr(unsafe.Pointer(&m.data[0])))
+	if closeErr := windows.CloseHandle(windows.Handle(m.handle)); err == nil {
+		err = closeErr
+	}
+	if closeErr := m.file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
@@ -0,0 +1,21 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+//go:build !unix && !windows
+
+package fs
+
+import (
+	"errors"
+	"os"
+)
+
+// isCrossDevice reports whether `err`, returned by os.Rename, may mean
+// that the paths are on different file systems. The cause is not reported
+// portably here, so any link error is treated as such and copying is
+// attempted.
+func isCrossDevice(err error) bool {
+	var linkErr *os.LinkError
+	return errors.As(err, &linkErr)
+}
@@ -0,0 +1,18 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+//go:build unix
+
+package fs
+
+import (
+	"errors"
+	"syscall"
+)
+
+// isCrossDevice reports whether `err`, returned by os.Rename, means that
+// the paths are on different file systems.
+func isCrossDevice(err error) bool {
+	return errors.Is(err, syscall.EXDEV)
+}
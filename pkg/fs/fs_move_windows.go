@@ -0,0 +1,17 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package fs
+
+import (
+	"errors"
+
+	"golang.org/x/sys/windows"
+)
+
+// isCrossDevice reports whether `err`, returned by os.Rename, means that
+// the paths are on different volumes.
+func isCrossDevice(err error) bool {
+	return errors.Is(err, windows.ERROR_NOT_SAME_DEVICE)
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package fs
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// sizeUnits are the binary unit prefixes, by power of 1024.
+var sizeUnits = []string{"B", "KiB", "MiB", "GiB", "TiB", "PiB", "EiB"}
+
+// sizeFactors maps the unit suffixes accepted by ParseSize to their factor.
+// Both decimal and binary prefixes stand for powers of 1024, as commonly
+// meant in configuration files.
+var sizeFactors = map[string]float64{
+	"":  1,
+	"b": 1,
+	"k": 1 << 10, "kb": 1 << 10, "kib": 1 << 10,
+	"m": 1 << 20, "mb": 1 << 20, "mib": 1 << 20,
+	"g": 1 << 30, "gb": 1 << 30, "gib": 1 << 30,
+	"t": 1 << 40, "tb": 1 << 40, "tib": 1 << 40,
+	"p": 1 << 50, "pb": 1 << 50, "pib": 1 << 50,
+	"e": 1 << 60, "eb": 1 << 60, "eib": 1 << 60,
+}
+
+// FormatSize formats `size` bytes with a binary unit and at most one
+// decimal, such as "512 B" or "1.5 MiB".
+func FormatSize(size int64) string {
+	sign := ""
+	abs := float64(size)
+	if size < 0 {
+		sign, abs = "-", -abs
+	}
+	unit := 0
+	for abs >= 1024 && unit < len(sizeUnits)-1 {
+		abs /= 1024
+		unit++
+	}
+	if unit == 0 {
+		return fmt.Sprintf("%s%d B", sign, int64(abs))
+	}
+	s := strconv.FormatFloat(math.Floor(abs*10)/10, 'f', -1, 64)
+	return sign + s + " " + sizeUnits[unit]
+}
+
+// ParseSize parses a size such as "512", "10MB", "1.5 GiB" or "64k" into
+// bytes. Units are case-insensitive and stand for powers of 1024.
+func ParseSize(s string) (int64, error) {
+	s = strings.TrimSpace(s)
+	i := strings.IndexFunc(s, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.' && r != '-' && r != '+'
+	})
+	if i < 0 {
+		i = len(s)
+	}
+	factor, ok := sizeFactors[strings.ToLower(strings.TrimSpace(s[i:]))]
+	if !ok {
+		return 0, fmt.Errorf("fs: invalid size unit in %q", s)
+	}
+	n, err := strconv.ParseFloat(s[:i], 64)
+	if err != nil {
+		return 0, fmt.Errorf("fs: invalid size %q", s)
+	}
+	size := n * factor
+	if size >= math.MaxInt64 || size <= math.MinInt64 {
+		return 0, fmt.Errorf("fs: size %q overflows int64", s)
+	}
+	return int64(size), nil
+}
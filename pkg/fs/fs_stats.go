@@ -0,0 +1,267 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package fs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// DefaultTopFiles is the number of largest files reported by Stats.
+const DefaultTopFiles = 10
+
+// DirStats holds the disk usage of a directory tree.
+type DirStats struct {
+	// Size is the total size of the files.
+	Size int64
+
+	// Files and Dirs are the numbers of files and of directories below the
+	// root. Symbolic links count as files and are not followed.
+	Files int
+	Dirs  int
+
+	// Unreadable is the number of directories skipped for lack of permission
+	// or because they vanished during the walk.
+	Unreadable int
+
+	// Largest holds the largest files, largest first.
+	Largest []FileSize
+
+	// Extensions holds the usage by lower-cased extension, such as ".log",
+	// the empty extension standing for files without one.
+	Extensions map[string]ExtStats
+}
+
+// FileSize is the size of a file.
+type FileSize struct {
+	Path string
+	Size int64
+}
+
+// ExtStats is the usage of the files of an extension.
+type ExtStats struct {
+	Files int
+	Size  int64
+}
+
+// statsOptions holds the settings of Stats.
+type statsOptions struct {
+	include     []string
+	exclude     []string
+	top         int
+	concurrency int
+}
+
+// StatsOption configures Stats.
+type StatsOption func(*statsOptions)
+
+// WithInclude restricts the files counted to those matching one of
+// `patterns`, in the syntax of Match, against their slash-separated path
+// relative to the root or their base name. Directories are always walked.
+func WithInclude(patterns ...string) StatsOption {
+	return func(o *statsOptions) {
+		o.include = append(o.include, patterns...)
+	}
+}
+
+// WithExclude skips the files and directories matching one of `patterns`,
+// as for WithInclude. An excluded directory is skipped with its content.
+func WithExclude(patterns ...string) StatsOption {
+	return func(o *statsOptions) {
+		o.exclude = append(o.exclude, patterns...)
+	}
+}
+
+// WithTopFiles sets the number of largest files reported, DefaultTopFiles by
+// default.
+func WithTopFiles(n int) StatsOption {
+	return func(o *statsOptions) {
+		o.top = max(n, 0)
+	}
+}
+
+// WithConcurrency sets the number of directories read at once,
+// runtime.GOMAXPROCS by default.
+func WithConcurrency(n int) StatsOption {
+	return func(o *statsOptions) {
+		if n > 0 {
+			o.concurrency = n
+		}
+	}
+}
+
+// Stats walks the directory `root` and returns its disk usage. Directories
+// are read concurrently. The walk stops at the first error other than an
+// unreadable directory, or when `ctx` ends, returning ctx.Err().
+func Stats(ctx context.Context, root string, opts ...StatsOption) (*DirStats, error) {
+	o := &statsOptions{top: DefaultTopFiles, concurrency: runtime.GOMAXPROCS(0)}
+	for _, opt := range opts {
+		opt(o)
+	}
+	for _, pattern := range append(o.include, o.exclude...) {
+		if _, err := path.Match(strings.ReplaceAll(pattern, "**", "*"), ""); err != nil {
+			return nil, fmt.Errorf("fs: invalid pattern %q: %w", pattern, err)
+		}
+	}
+	info, err := os.Stat(root)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("fs: %q is not a directory", root)
+	}
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	w := &statsWalker{
+		ctx:    ctx,
+		cancel: cancel,
+		opts:   o,
+		root:   root,
+		sem:    make(chan struct{}, o.concurrency),
+		stats:  &DirStats{Extensions: make(map[string]ExtStats)},
+	}
+	w.walk(root, "")
+	w.wg.Wait()
+	if err := context.Cause(ctx); err != nil {
+		return nil, err
+	}
+	sortLargest(w.stats.Largest)
+	if len(w.stats.Largest) > o.top {
+		w.stats.Largest = w.stats.Largest[:o.top]
+	}
+	return w.stats, nil
+}
+
+// statsWalker holds the state of a Stats walk.
+type statsWalker struct {
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+	opts   *statsOptions
+	root   string
+
+	// sem bounds the directories read at once.
+	sem chan struct{}
+
+	// wg counts the walking goroutines.
+	wg sync.WaitGroup
+
+	// mu guards stats.
+	mu    sync.Mutex
+	stats *DirStats
+}
+
+// walk accounts for the directory `dir`, named `rel` relative to the root,
+// walking its subdirectories in new goroutines while the concurrency
+// allows, and in the calling one otherwise.
+func (w *statsWalker) walk(dir, rel string) {
+	if w.ctx.Err() != nil {
+		return
+	}
+	entries, err := os.ReadDir(dir)
+	local := &DirStats{Extensions: make(map[string]ExtStats)}
+	if errors.Is(err, os.ErrPermission) || errors.Is(err, os.ErrNotExist) {
+		local.Unreadable++
+	} else if err != nil {
+		w.cancel(err)
+		return
+	}
+	for _, entry := range entries {
+		name := path.Join(rel, entry.Name())
+		file := filepath.Join(dir, entry.Name())
+		if entry.IsDir() {
+			if w.match(w.opts.exclude, name) {
+				continue
+			}
+			local.Dirs++
+			select {
+			case w.sem <- struct{}{}:
+				w.wg.Add(1)
+				go func() {
+					defer w.wg.Done()
+					defer func() { <-w.sem }()
+					w.walk(file, name)
+				}()
+			default:
+				w.walk(file, name)
+			}
+			continue
+		}
+		if w.match(w.opts.exclude, name) || len(w.opts.include) > 0 && !w.match(w.opts.include, name) {
+			continue
+		}
+		info, err := entry.Info()
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			w.cancel(err)
+			return
+		}
+		size := info.Size()
+		local.Files++
+		local.Size += size
+		ext := strings.ToLower(filepath.Ext(entry.Name()))
+		e := local.Extensions[ext]
+		e.Files++
+		e.Size += size
+		local.Extensions[ext] = e
+		if w.opts.top > 0 {
+			local.Largest = append(local.Largest, FileSize{Path: file, Size: size})
+		}
+	}
+	w.merge(local)
+}
+
+// merge adds `local` to the totals.
+func (w *statsWalker) merge(local *DirStats) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	s := w.stats
+	s.Size += local.Size
+	s.Files += local.Files
+	s.Dirs += local.Dirs
+	s.Unreadable += local.Unreadable
+	for ext, l := range local.Extensions {
+		e := s.Extensions[ext]
+		e.Files += l.Files
+		e.Size += l.Size
+		s.Extensions[ext] = e
+	}
+	s.Largest = append(s.Largest, local.Largest...)
+	if len(s.Largest) > 2*w.opts.top {
+		sortLargest(s.Largest)
+		s.Largest = s.Largest[:w.opts.top]
+	}
+}
+
+// match reports whether `name` or its base name matches one of `patterns`.
+func (w *statsWalker) match(patterns []string, name string) bool {
+	base := path.Base(name)
+	for _, pattern := range patterns {
+		if Match(pattern, name) || Match(pattern, base) {
+			return true
+		}
+	}
+	return false
+}
+
+// sortLargest sorts `files` largest first, then by path.
+func sortLargest(files []FileSize) {
+	sort.Slice(files, func(i, j int) bool {
+		if files[i].Size != files[j].Size {
+			return files[i].Size > files[j].Size
+		}
+		return files[i].Path < files[j].Path
+	})
+}
@@ -0,0 +1,300 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package fs
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"iter"
+	"os"
+	"time"
+)
+
+// Defaults of a Tailer.
+const (
+	DefaultTailPollInterval = 250 * time.Millisecond
+	DefaultTailMaxLineSize  = 1 << 20
+)
+
+// Line is a line read by a Tailer, without its line terminator.
+type Line struct {
+	// Text is the content of the line.
+	Text string
+
+	// Offset is the offset just past the line in the file it was read from,
+	// to be passed to WithOffset to resume after it.
+	Offset int64
+}
+
+// TailOption configures a Tailer.
+type TailOption func(*Tailer)
+
+// WithOffset starts reading at `offset`, a Line.Offset checkpointed by a
+// previous Tailer. An offset beyond the end of the file, which was truncated
+// or rotated meanwhile, starts at the beginning, as does a file missing when
+// Tail is called and created later.
+func WithOffset(offset int64) TailOption {
+	return func(t *Tailer) {
+		t.offset = max(offset, 0)
+	}
+}
+
+// WithFromEnd starts reading at the end of the file, so only lines written
+// afterwards are read.
+func WithFromEnd() TailOption {
+	return func(t *Tailer) {
+		t.fromEnd = true
+	}
+}
+
+// WithPollInterval sets the interval at which the file is checked for new
+// content, DefaultTailPollInterval by default.
+func WithPollInterval(d time.Duration) TailOption {
+	return func(t *Tailer) {
+		if d > 0 {
+			t.poll = d
+		}
+	}
+}
+
+// WithMaxLineSize splits lines longer than `n` bytes, DefaultTailMaxLineSize
+// by default.
+func WithMaxLineSize(n int) TailOption {
+	return func(t *Tailer) {
+		if n > 0 {
+			t.maxLine = n
+		}
+	}
+}
+
+// Tailer reads the lines of a growing file, as tail -F does. It follows the
+// path rather than the file: when the file is rotated, which is detected by
+// the path naming another file, the rest of the old file is read and the new
+// one is read from its beginning; when the file is truncated, it is read
+// again from its beginning. A missing file is waited for.
+//
+// A Tailer is not safe for concurrent use.
+type Tailer struct {
+	path    string
+	poll    time.Duration
+	maxLine int
+	fromEnd bool
+
+	// file is the file read, nil while the path is missing.
+	file *os.File
+
+	// offset is the offset of the end of the last line returned.
+	offset int64
+
+	// buf holds the bytes read past offset.
+	buf []byte
+
+	// chunk is the read buffer.
+	chunk []byte
+
+	// closed reports whether Close was called.
+	closed bool
+}
+
+// Tail creates and returns a Tailer reading the lines of the file `path`
+// from its beginning, unless WithOffset or WithFromEnd is given.
+func Tail(path string, opts ...TailOption) (*Tailer, error) {
+	t := &Tailer{
+		path:    path,
+		poll:    DefaultTailPollInterval,
+		maxLine: DefaultTailMaxLineSize,
+		chunk:   make([]byte, 32<<10),
+	}
+	for _, opt := range opts {
+		opt(t)
+	}
+	ok, err := t.open()
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		// The file created later is read from its beginning
+		t.offset = 0
+		return t, nil
+	}
+	info, err := t.file.Stat()
+	if err != nil {
+		t.file.Close()
+		return nil, err
+	}
+	switch {
+	case t.fromEnd:
+		t.offset = info.Size()
+	case t.offset > info.Size():
+		t.offset = 0
+	}
+	if _, err := t.file.Seek(t.offset, io.SeekStart); err != nil {
+		t.file.Close()
+		return nil, err
+	}
+	return t, nil
+}
+
+// Path returns the path of the file read.
+func (t *Tailer) Path() string {
+	return t.path
+}
+
+// Offset returns the offset just past the last line returned.
+func (t *Tailer) Offset() int64 {
+	return t.offset
+}
+
+// Next returns the next line, waiting for it to be written until `ctx`
+// ends, in which case ctx.Err() is returned.
+func (t *Tailer) Next(ctx context.Context) (Line, error) {
+	for {
+		if t.closed {
+			return Line{}, os.ErrClosed
+		}
+		if line, ok := t.take(false); ok {
+			return line, nil
+		}
+		if t.file == nil {
+			ok, err := t.open()
+			if err != nil {
+				return Line{}, err
+			}
+			if !ok {
+				if err := t.wait(ctx); err != nil {
+					return Line{}, err
+				}
+			}
+			continue
+		}
+		n, err := t.file.Read(t.chunk)
+		if n > 0 {
+			t.buf = append(t.buf, t.chunk[:n]...)
+			continue
+		}
+		if err != nil && err != io.EOF {
+			return Line{}, err
+		}
+
+		// At the end of the file: look for a rotation or a truncation
+		rotated, err := t.rotated()
+		if err != nil {
+			return Line{}, err
+		}
+		if rotated {
+			// The last line of the old file may lack its terminator
+			line, ok := t.take(true)
+			t.file.Close()
+			t.file, t.offset, t.buf = nil, 0, nil
+			if ok {
+				return line, nil
+			}
+			continue
+		}
+		if err := t.wait(ctx); err != nil {
+			return Line{}, err
+		}
+	}
+}
+
+// All returns an iterator over the lines, running until `ctx` ends or a
+// read fails. It stops after yielding the first error.
+func (t *Tailer) All(ctx context.Context) iter.Seq2[Line, error] {
+	return func(yield func(Line, error) bool) {
+		for {
+			line, err := t.Next(ctx)
+			if !yield(line, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// Close closes the file read.
+func (t *Tailer) Close() error {
+	if t.closed {
+		return nil
+	}
+	t.closed = true
+	if t.file == nil {
+		return nil
+	}
+	return t.file.Close()
+}
+
+// open opens the file, reporting whether it exists.
+func (t *Tailer) open() (bool, error) {
+	f, err := os.Open(t.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	t.file = f
+	return true, nil
+}
+
+// take removes the next complete line from the buffer, or an incomplete one
+// if `partial` is set or the line is too long.
+func (t *Tailer) take(partial bool) (Line, bool) {
+	var text []byte
+	var size int
+	if i := bytes.IndexByte(t.buf, '\n'); i >= 0 && i < t.maxLine {
+		text, size = t.buf[:i], i+1
+	} else if len(t.buf) >= t.maxLine {
+		text, size = t.buf[:t.maxLine], t.maxLine
+	} else if partial && len(t.buf) > 0 {
+		text, size = t.buf, len(t.buf)
+	} else {
+		return Line{}, false
+	}
+	text = bytes.TrimSuffix(text, []byte{'\r'})
+	t.offset += int64(size)
+	line := Line{Text: string(text), Offset: t.offset}
+	t.buf = t.buf[size:]
+	return line, true
+}
+
+// rotated reports whether the path names another file than the one read.
+// A truncated file is rewound instead.
+func (t *Tailer) rotated() (bool, error) {
+	opened, err := t.file.Stat()
+	if err != nil {
+		return false, err
+	}
+	current, err := os.Stat(t.path)
+	if errors.Is(err, os.ErrNotExist) {
+		// Moved away and not yet replaced: keep reading the old file
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if !os.SameFile(opened, current) {
+		return true, nil
+	}
+	if opened.Size() < t.offset+int64(len(t.buf)) {
+		if _, err := t.file.Seek(0, io.SeekStart); err != nil {
+			return false, err
+		}
+		t.offset, t.buf = 0, nil
+	}
+	return false, nil
+}
+
+// wait sleeps for the poll interval or until `ctx` ends.
+func (t *Tailer) wait(ctx context.Context) error {
+	timer := time.NewTimer(t.poll)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package fs
+
+import "os"
+
+// TempFile creates a new file in the default temporary directory, named
+// after `pattern` as in os.CreateTemp. The caller removes it.
+func TempFile(pattern string) (*os.File, error) {
+	return os.CreateTemp("", pattern)
+}
+
+// TempDir creates a new directory in the default temporary directory,
+// named after `pattern` as in os.MkdirTemp, and returns a function removing
+// it with its content.
+func TempDir(pattern string) (dir string, cleanup func() error, err error) {
+	dir, err = os.MkdirTemp("", pattern)
+	if err != nil {
+		return "", nil, err
+	}
+	return dir, func() error { return os.RemoveAll(dir) }, nil
+}
+
+// WriteTemp writes `data` to a new temporary file named after `pattern` and
+// returns its path. The caller removes it.
+func WriteTemp(pattern string, data []byte) (string, error) {
+	f, err := TempFile(pattern)
+	if err != nil {
+		return "", err
+	}
+	_, err = f.Write(data)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
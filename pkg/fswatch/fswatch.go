@@ -0,0 +1,496 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package fswatch watches directory trees for changes, on top of fsnotify.
+//
+// Adding a directory watches it recursively, including the directories
+// created in it later. Events are debounced: those arriving within the
+// debounce period of each other are coalesced by path, their operations
+// combined, and delivered together once the burst ends, so an editor saving
+// a file through a temporary file and a rename yields one notification.
+// Handlers may restrict the paths they receive with globs in the syntax of
+// fs.Glob, matched against the path relative to the watched root and
+// against the base name.
+package fswatch
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/focela/aegis/pkg/flowctl"
+	lfs "github.com/focela/aegis/pkg/fs"
+)
+
+// DefaultDebounce is the default debounce period.
+const DefaultDebounce = 100 * time.Millisecond
+
+// Op is a set of file operations.
+type Op uint32
+
+// Operations reported by events.
+const (
+	Create Op = 1 << iota
+	Write
+	Remove
+	Rename
+	Chmod
+)
+
+// opNames holds the names of the operations, by bit.
+var opNames = []string{"CREATE", "WRITE", "REMOVE", "RENAME", "CHMOD"}
+
+// Has reports whether the set contains all of `op`.
+func (o Op) Has(op Op) bool {
+	return o&op == op
+}
+
+// String returns the operations joined by "|".
+func (o Op) String() string {
+	var names []string
+	for i, name := range opNames {
+		if o&(1<<i) != 0 {
+			names = append(names, name)
+		}
+	}
+	return strings.Join(names, "|")
+}
+
+// Event is a change of a file or directory.
+type Event struct {
+	// Path is the path of the file, below a watched root.
+	Path string
+
+	// Op holds the operations coalesced into the event.
+	Op Op
+}
+
+// String returns the event as "OP path".
+func (e Event) String() string {
+	return e.Op.String() + " " + e.Path
+}
+
+// HandlerFunc handles the events of a burst, sorted by path. It runs in a
+// timer goroutine, never concurrently with itself.
+type HandlerFunc func(events []Event)
+
+// Option configures a Watcher.
+type Option func(*Watcher)
+
+// WithDebounce sets the debounce period, DefaultDebounce by default. Zero
+// delivers each event as it arrives.
+func WithDebounce(d time.Duration) Option {
+	return func(w *Watcher) {
+		if d >= 0 {
+			w.debounce = d
+		}
+	}
+}
+
+// WithMaxWait delivers events after at most `d` since the first event of a
+// burst, so a steady stream of changes is not delayed forever.
+func WithMaxWait(d time.Duration) Option {
+	return func(w *Watcher) {
+		w.maxWait = d
+	}
+}
+
+// WithExclude ignores the paths matching one of `patterns`. An excluded
+// directory is not watched.
+func WithExclude(patterns ...string) Option {
+	return func(w *Watcher) {
+		w.exclude = append(w.exclude, patterns...)
+	}
+}
+
+// WithErrorHandler sets the function receiving the errors of the watcher,
+// which are dropped by default.
+func WithErrorHandler(f func(err error)) Option {
+	return func(w *Watcher) {
+		w.onError = f
+	}
+}
+
+// Watcher watches directory trees. It is safe for concurrent use.
+type Watcher struct {
+	// mu guards the fields below it.
+	mu sync.Mutex
+
+	// watcher is the underlying watcher.
+	watcher *fsnotify.Watcher
+
+	// roots holds the cleaned paths added by Add.
+	roots []string
+
+	// handlers holds the registered handlers by id.
+	handlers map[uint64]*handler
+
+	// seq numbers the handlers.
+	seq uint64
+
+	// pending holds the operations of the current burst, by path.
+	pending map[string]Op
+
+	// debouncer delivers the pending events.
+	debouncer *flowctl.Debouncer
+
+	debounce time.Duration
+	maxWait  time.Duration
+	exclude  []string
+	onError  func(err error)
+
+	// closed reports whether Close was called.
+	closed bool
+
+	// done is closed once the event loop returns.
+	done chan struct{}
+}
+
+// handler is a registered HandlerFunc with its filters.
+type handler struct {
+	fn       HandlerFunc
+	patterns []string
+}
+
+// New creates and returns a Watcher watching nothing yet.
+func New(opts ...Option) (*Watcher, error) {
+	w := &Watcher{
+		handlers: make(map[uint64]*handler),
+		pending:  make(map[string]Op),
+		debounce: DefaultDebounce,
+		done:     make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(w)
+	}
+	for _, pattern := range w.exclude {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("fswatch: invalid pattern %q: %w", pattern, err)
+		}
+	}
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("fswatch: %w", err)
+	}
+	w.watcher = watcher
+	if w.debounce > 0 {
+		var dopts []flowctl.DebounceOption
+		if w.maxWait > 0 {
+			dopts = append(dopts, flowctl.WithMaxWait(w.maxWait))
+		}
+		w.debouncer = flowctl.Debounce(w.flush, w.debounce, dopts...)
+	}
+	go w.loop()
+	return w, nil
+}
+
+// Add watches `path`, recursively if it is a directory.
+func (w *Watcher) Add(path string) error {
+	root, err := filepath.Abs(path)
+	if err != nil {
+		return err
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return fmt.Errorf("fswatch: watcher is closed")
+	}
+	if err := w.addTree(root, root); err != nil {
+		return err
+	}
+	if !slices.Contains(w.roots, root) {
+		w.roots = append(w.roots, root)
+	}
+	return nil
+}
+
+// Remove stops watching `path` and the directories below it.
+func (w *Watcher) Remove(path string) error {
+	root, err := filepath.Abs(path)
+	if err != nil {
+		return err
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, watched := range w.watcher.WatchList() {
+		if within(root, watched) {
+			// Directories removed from disk are already unwatched
+			_ = w.watcher.Remove(watched)
+		}
+	}
+	w.roots = slices.DeleteFunc(w.roots, func(r string) bool {
+		return within(root, r)
+	})
+	return nil
+}
+
+// WatchList returns the watched paths, sorted.
+func (w *Watcher) WatchList() []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	list := w.watcher.WatchList()
+	slices.Sort(list)
+	return list
+}
+
+// On registers `fn` for the events whose path matches one of `patterns`, or
+// for all events if none is given, and returns a function unregistering it.
+func (w *Watcher) On(fn HandlerFunc, patterns ...string) (cancel func()) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.seq++
+	id := w.seq
+	w.handlers[id] = &handler{fn: fn, patterns: patterns}
+	return func() {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		delete(w.handlers, id)
+	}
+}
+
+// Flush delivers the pending events now, in the calling goroutine.
+func (w *Watcher) Flush() {
+	if w.debouncer == nil || !w.debouncer.Flush() {
+		w.flush()
+	}
+}
+
+// Close stops watching, dropping the pending events.
+func (w *Watcher) Close() error {
+	w.mu.Lock()
+	if w.closed {
+		w.mu.Unlock()
+		return nil
+	}
+	w.closed = true
+	w.mu.Unlock()
+	if w.debouncer != nil {
+		w.debouncer.Cancel()
+	}
+	err := w.watcher.Close()
+	<-w.done
+	return err
+}
+
+// loop receives the events of the underlying watcher until it is closed.
+func (w *Watcher) loop() {
+	defer close(w.done)
+	for {
+		select {
+		case ev, ok := <-w.watcher.Events:
+			if !ok {
+				return
+			}
+			w.handle(ev)
+		case err, ok := <-w.watcher.Errors:
+			if !ok {
+				return
+			}
+			w.fail(err)
+		}
+	}
+}
+
+// handle records `ev`, watching the directories it creates.
+func (w *Watcher) handle(ev fsnotify.Event) {
+	w.mu.Lock()
+	if w.closed {
+		w.mu.Unlock()
+		return
+	}
+	root := w.rootOf(ev.Name)
+	if root == "" || w.excluded(root, ev.Name) {
+		w.mu.Unlock()
+		return
+	}
+	w.record(ev.Name, convertOp(ev.Op))
+
+	// The files of a directory created or moved in may predate its watch,
+	// so they are reported as created too
+	if ev.Has(fsnotify.Create) {
+		if info, err := os.Lstat(ev.Name); err == nil && info.IsDir() {
+			if err := w.addTree(root, ev.Name); err != nil {
+				defer w.fail(err)
+			}
+		}
+	}
+	w.mu.Unlock()
+
+	if w.debouncer != nil {
+		w.debouncer.Call()
+	} else {
+		w.flush()
+	}
+}
+
+// record adds `op` to the pending operations of `path`. It must be called
+// with the lock held.
+func (w *Watcher) record(path string, op Op) {
+	if op != 0 {
+		w.pending[path] |= op
+	}
+}
+
+// flush delivers the pending events to the handlers.
+func (w *Watcher) flush() {
+	w.mu.Lock()
+	if len(w.pending) == 0 {
+		w.mu.Unlock()
+		return
+	}
+	events := make([]Event, 0, len(w.pending))
+	for path, op := range w.pending {
+		events = append(events, Event{Path: path, Op: op})
+	}
+	clear(w.pending)
+	slices.SortFunc(events, func(a, b Event) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+	type delivery struct {
+		id     uint64
+		fn     HandlerFunc
+		events []Event
+	}
+	var deliveries []delivery
+	for id, h := range w.handlers {
+		var matched []Event
+		for _, ev := range events {
+			if w.matches(h.patterns, ev.Path) {
+				matched = append(matched, ev)
+			}
+		}
+		if len(matched) > 0 {
+			deliveries = append(deliveries, delivery{id, h.fn, matched})
+		}
+	}
+	w.mu.Unlock()
+
+	// Handlers run in the order of registration, outside the lock
+	slices.SortFunc(deliveries, func(a, b delivery) int {
+		return int(a.id) - int(b.id)
+	})
+	for _, d := range deliveries {
+		d.fn(d.events)
+	}
+}
+
+// addTree watches the directory `dir` below `root` and its subdirectories,
+// recording the files found as created unless `dir` is the root. It must
+// be called with the lock held.
+func (w *Watcher) addTree(root, dir string) error {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return fmt.Errorf("fswatch: %w", err)
+	}
+	if !info.IsDir() {
+		return w.watch(dir)
+	}
+	return filepath.WalkDir(dir, func(file string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// Entries may vanish while walking
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if file != root && w.excluded(root, file) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if dir != root && file != dir {
+			w.record(file, Create)
+		}
+		if d.IsDir() {
+			return w.watch(file)
+		}
+		return nil
+	})
+}
+
+// watch adds `path` to the underlying watcher.
+func (w *Watcher) watch(path string) error {
+	if err := w.watcher.Add(path); err != nil {
+		return fmt.Errorf("fswatch: watching %q: %w", path, err)
+	}
+	return nil
+}
+
+// rootOf returns the watched root containing `path`, the deepest one if
+// roots are nested, or "" if none does. It must be called with the lock
+// held.
+func (w *Watcher) rootOf(path string) string {
+	best := ""
+	for _, root := range w.roots {
+		if within(root, path) && len(root) > len(best) {
+			best = root
+		}
+	}
+	return best
+}
+
+// excluded reports whether `path` below `root` matches an exclude pattern.
+func (w *Watcher) excluded(root, path string) bool {
+	return len(w.exclude) > 0 && match(w.exclude, root, path)
+}
+
+// matches reports whether `path` is selected by `patterns`, all paths
+// being selected by none. It must be called with the lock held.
+func (w *Watcher) matches(patterns []string, path string) bool {
+	return len(patterns) == 0 || match(patterns, w.rootOf(path), path)
+}
+
+// fail reports `err` to the error handler.
+func (w *Watcher) fail(err error) {
+	if w.onError != nil {
+		w.onError(err)
+	}
+}
+
+// match reports whether `path`, relative to `root`, or its base name
+// matches one of `patterns`.
+func match(patterns []string, root, path string) bool {
+	rel, err := filepath.Rel(root, path)
+	if err != nil {
+		rel = path
+	}
+	rel = filepath.ToSlash(rel)
+	base := filepath.Base(path)
+	for _, pattern := range patterns {
+		if lfs.Match(pattern, rel) || lfs.Match(pattern, base) {
+			return true
+		}
+	}
+	return false
+}
+
+// within reports whether `path` is `root` or below it.
+func within(root, path string) bool {
+	return path == root || strings.HasPrefix(path, root+string(filepath.Separator))
+}
+
+// convertOp converts the operations of fsnotify.
+func convertOp(op fsnotify.Op) Op {
+	var out Op
+	for from, to := range map[fsnotify.Op]Op{
+		fsnotify.Create: Create,
+		fsnotify.Write:  Write,
+		fsnotify.Remove: Remove,
+		fsnotify.Rename: Rename,
+		fsnotify.Chmod:  Chmod,
+	} {
+		if op.Has(from) {
+			out |= to
+		}
+	}
+	return out
+}
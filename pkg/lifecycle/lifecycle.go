@@ -0,0 +1,273 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package lifecycle coordinates the graceful shutdown of a process.
+//
+// Components register a hook releasing their resources, with a priority and
+// a timeout. On SIGINT or SIGTERM, Wait runs the hooks by decreasing
+// priority, those of equal priority concurrently, so servers stop taking
+// requests before the stores they use are closed and the logs are flushed
+// last:
+//
+//	lifecycle.Register("http", srv.Shutdown, lifecycle.WithPriority(100))
+//	lifecycle.Register("cron", c.Stop)
+//	lifecycle.Register("timer", lifecycle.Func(t.Close))
+//	lifecycle.Register("log", lifecycle.Func(flush), lifecycle.WithPriority(-100))
+//	err := lifecycle.Wait(ctx)
+//
+// A hook still running at the end of its timeout is logged as a straggler
+// and abandoned, so that one stuck component cannot hold up the others.
+// Panics are recovered, and all failures are returned joined.
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"slices"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/focela/aegis/pkg/exec"
+)
+
+// DefaultTimeout is the timeout of hooks registered without one.
+const DefaultTimeout = 30 * time.Second
+
+// HookFunc releases a resource on shutdown, giving up when `ctx` ends.
+type HookFunc func(ctx context.Context) error
+
+// Func adapts `fn`, such as the Close method of a timer, to a HookFunc.
+func Func(fn func()) HookFunc {
+	return func(context.Context) error {
+		fn()
+		return nil
+	}
+}
+
+// Closer adapts `c` to a HookFunc calling its Close method.
+func Closer(c io.Closer) HookFunc {
+	return func(context.Context) error {
+		return c.Close()
+	}
+}
+
+// Option configures a Manager.
+type Option func(*Manager)
+
+// WithLogger sets the logger of stragglers, log.Default() by default.
+func WithLogger(logger *log.Logger) Option {
+	return func(m *Manager) {
+		if logger != nil {
+			m.logger = logger
+		}
+	}
+}
+
+// WithShutdownTimeout bounds the whole shutdown to `d`, beyond the timeouts
+// of the hooks. Hooks not started by then fail without running.
+func WithShutdownTimeout(d time.Duration) Option {
+	return func(m *Manager) {
+		m.timeout = d
+	}
+}
+
+// HookOption configures a registered hook.
+type HookOption func(*hook)
+
+// WithPriority sets the priority of the hook, 0 by default. Hooks of higher
+// priority run first.
+func WithPriority(priority int) HookOption {
+	return func(h *hook) {
+		h.priority = priority
+	}
+}
+
+// WithTimeout sets the timeout of the hook, DefaultTimeout by default.
+func WithTimeout(d time.Duration) HookOption {
+	return func(h *hook) {
+		if d > 0 {
+			h.timeout = d
+		}
+	}
+}
+
+// hook is a registered HookFunc.
+type hook struct {
+	name     string
+	fn       HookFunc
+	priority int
+	timeout  time.Duration
+}
+
+// Manager runs the hooks of its components on shutdown. It is safe for
+// concurrent use.
+type Manager struct {
+	// mu guards hooks.
+	mu    sync.Mutex
+	hooks []hook
+
+	// logger reports stragglers.
+	logger *log.Logger
+
+	// timeout bounds the whole shutdown, none if not positive.
+	timeout time.Duration
+
+	// ctx is cancelled when the shutdown starts.
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// once runs the shutdown once, and err is its result.
+	once sync.Once
+	err  error
+}
+
+// defaultManager is the Manager of the package-level functions.
+var defaultManager = sync.OnceValue(func() *Manager {
+	return New()
+})
+
+// New creates and returns a Manager.
+func New(opts ...Option) *Manager {
+	m := &Manager{logger: log.Default()}
+	for _, opt := range opts {
+		opt(m)
+	}
+	m.ctx, m.cancel = context.WithCancel(context.Background())
+	return m
+}
+
+// Register registers `fn` to be run on shutdown under `name`, which
+// identifies it in errors and logs.
+func (m *Manager) Register(name string, fn HookFunc, opts ...HookOption) {
+	h := hook{name: name, fn: fn, timeout: DefaultTimeout}
+	for _, opt := range opts {
+		opt(&h)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.hooks = append(m.hooks, h)
+}
+
+// Context returns a context cancelled when the shutdown starts, for
+// components to stop background work.
+func (m *Manager) Context() context.Context {
+	return m.ctx
+}
+
+// Shutdown runs the hooks, once, and returns their errors joined. Later
+// calls return the result of the first one. `ctx` ending abandons the
+// hooks still running and fails those not started.
+func (m *Manager) Shutdown(ctx context.Context) error {
+	m.once.Do(func() {
+		m.cancel()
+		if m.timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, m.timeout)
+			defer cancel()
+		}
+		m.mu.Lock()
+		pending := slices.Clone(m.hooks)
+		m.mu.Unlock()
+		// The sort is stable, so hooks of equal priority keep the order of
+		// registration in errors
+		slices.SortStableFunc(pending, func(a, b hook) int {
+			return b.priority - a.priority
+		})
+
+		var errs []error
+		for len(pending) > 0 {
+			n := 1
+			for n < len(pending) && pending[n].priority == pending[0].priority {
+				n++
+			}
+			errs = append(errs, m.runAll(ctx, pending[:n])...)
+			pending = pending[n:]
+		}
+		m.err = errors.Join(errs...)
+	})
+	return m.err
+}
+
+// Wait blocks until the process receives one of `signals`, SIGINT and
+// SIGTERM by default, or `ctx` ends, then runs Shutdown. A second signal
+// during shutdown exits at once with status 1.
+func (m *Manager) Wait(ctx context.Context, signals ...os.Signal) error {
+	if len(signals) == 0 {
+		signals = []os.Signal{os.Interrupt, syscall.SIGTERM}
+	}
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, signals...)
+	defer signal.Stop(ch)
+
+	select {
+	case <-ch:
+	case <-ctx.Done():
+	}
+	go func() {
+		if _, ok := <-ch; ok {
+			os.Exit(1)
+		}
+	}()
+	return m.Shutdown(context.WithoutCancel(ctx))
+}
+
+// runAll runs `hooks` concurrently and returns their errors in order.
+func (m *Manager) runAll(ctx context.Context, hooks []hook) []error {
+	errs := make([]error, len(hooks))
+	var wg sync.WaitGroup
+	for i, h := range hooks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = m.run(ctx, h)
+		}()
+	}
+	wg.Wait()
+	return slices.DeleteFunc(errs, func(err error) bool {
+		return err == nil
+	})
+}
+
+// run runs `h` within its timeout, logging it if abandoned.
+func (m *Manager) run(ctx context.Context, h hook) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("lifecycle: %s: not run: %w", h.name, err)
+	}
+	start := time.Now()
+	err := exec.DoWithTimeout(ctx, h.timeout, h.fn)
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		m.logger.Printf("lifecycle: %s still running after %v, abandoned", h.name, time.Since(start).Round(time.Millisecond))
+	}
+	return fmt.Errorf("lifecycle: %s: %w", h.name, err)
+}
+
+// Register registers `fn` on the default Manager.
+func Register(name string, fn HookFunc, opts ...HookOption) {
+	defaultManager().Register(name, fn, opts...)
+}
+
+// Context returns the context of the default Manager, cancelled when its
+// shutdown starts.
+func Context() context.Context {
+	return defaultManager().Context()
+}
+
+// Shutdown runs the hooks of the default Manager.
+func Shutdown(ctx context.Context) error {
+	return defaultManager().Shutdown(ctx)
+}
+
+// Wait waits for a signal, then runs the hooks of the default Manager.
+func Wait(ctx context.Context, signals ...os.Signal) error {
+	return defaultManager().Wait(ctx, signals...)
+}
@@ -0,0 +1,237 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package proc manages child processes, graceful shutdown and restarts of
+// the current process.
+//
+// A Process wraps os/exec with functional options for the environment and
+// standard streams. Started in its own process group, a process is
+// signalled together with the children it spawned, so stopping a shell
+// script stops its pipeline too. Process groups are not supported on
+// Windows, where signals reach the process only.
+//
+// RegisterShutdown and WaitForShutdown register and run shutdown hooks
+// through pkg/lifecycle, on SIGINT or SIGTERM, each within its own timeout.
+// Restart runs the hooks of pkg/lifecycle too, then replaces the process
+// with a new instance of its executable.
+package proc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// DefaultStopTimeout is how long Stop waits after SIGTERM before killing.
+const DefaultStopTimeout = 10 * time.Second
+
+// Option configures a Process.
+type Option func(*Process)
+
+// WithDir sets the working directory, the current one by default.
+func WithDir(dir string) Option {
+	return func(p *Process) {
+		p.cmd.Dir = dir
+	}
+}
+
+// WithEnv adds the "KEY=value" pairs `env` to the environment, which is
+// inherited from the current process by default. Later pairs override
+// earlier ones.
+func WithEnv(env ...string) Option {
+	return func(p *Process) {
+		p.env = append(p.env, env...)
+	}
+}
+
+// WithCleanEnv starts the process with only the variables of WithEnv,
+// without inheriting the environment.
+func WithCleanEnv() Option {
+	return func(p *Process) {
+		p.cleanEnv = true
+	}
+}
+
+// WithStdin sets the standard input, empty by default.
+func WithStdin(r io.Reader) Option {
+	return func(p *Process) {
+		p.cmd.Stdin = r
+	}
+}
+
+// WithStdout sets the standard output, discarded by default.
+func WithStdout(w io.Writer) Option {
+	return func(p *Process) {
+		p.cmd.Stdout = w
+	}
+}
+
+// WithStderr sets the standard error, discarded by default.
+func WithStderr(w io.Writer) Option {
+	return func(p *Process) {
+		p.cmd.Stderr = w
+	}
+}
+
+// WithInheritStdio connects the standard streams to those of the current
+// process.
+func WithInheritStdio() Option {
+	return func(p *Process) {
+		p.cmd.Stdin, p.cmd.Stdout, p.cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	}
+}
+
+// WithProcessGroup starts the process in its own process group, so that
+// signals reach its children too.
+func WithProcessGroup() Option {
+	return func(p *Process) {
+		p.group = true
+	}
+}
+
+// WithStopTimeout sets how long Stop and the cancellation of the context
+// wait after SIGTERM before killing, DefaultStopTimeout by default.
+func WithStopTimeout(d time.Duration) Option {
+	return func(p *Process) {
+		if d > 0 {
+			p.stopTimeout = d
+		}
+	}
+}
+
+// Process is a child process.
+type Process struct {
+	cmd         *exec.Cmd
+	env         []string
+	cleanEnv    bool
+	group       bool
+	stopTimeout time.Duration
+}
+
+// New creates a Process running `name` with `args`. Once started, the
+// cancellation of `ctx` stops it as Stop does.
+func New(ctx context.Context, name string, args []string, opts ...Option) *Process {
+	p := &Process{
+		cmd:         exec.CommandContext(ctx, name, args...),
+		stopTimeout: DefaultStopTimeout,
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	if p.cleanEnv {
+		p.cmd.Env = append([]string{}, p.env...)
+	} else if len(p.env) > 0 {
+		p.cmd.Env = append(os.Environ(), p.env...)
+	}
+	if p.group {
+		setProcessGroup(p.cmd)
+	}
+	p.cmd.Cancel = func() error {
+		return p.Signal(syscall.SIGTERM)
+	}
+	p.cmd.WaitDelay = p.stopTimeout
+	return p
+}
+
+// Start starts the process.
+func (p *Process) Start() error {
+	if err := p.cmd.Start(); err != nil {
+		return fmt.Errorf("proc: starting %s: %w", p.cmd.Path, err)
+	}
+	return nil
+}
+
+// Wait waits for the process to exit. A non-zero exit status is returned
+// as an *exec.ExitError, see ExitCode.
+func (p *Process) Wait() error {
+	return p.cmd.Wait()
+}
+
+// Run starts the process and waits for it to exit.
+func (p *Process) Run() error {
+	if err := p.Start(); err != nil {
+		return err
+	}
+	return p.Wait()
+}
+
+// Pid returns the process id, or 0 if not started.
+func (p *Process) Pid() int {
+	if p.cmd.Process == nil {
+		return 0
+	}
+	return p.cmd.Process.Pid
+}
+
+// Signal sends `sig` to the process, or to its whole group if it was
+// started with WithProcessGroup.
+func (p *Process) Signal(sig os.Signal) error {
+	if p.cmd.Process == nil {
+		return fmt.Errorf("proc: process not started")
+	}
+	if p.group {
+		return signalGroup(p.cmd.Process, sig)
+	}
+	return p.cmd.Process.Signal(sig)
+}
+
+// Kill kills the process, or its whole group.
+func (p *Process) Kill() error {
+	return p.Signal(os.Kill)
+}
+
+// Stop sends SIGTERM and waits for the process to exit, killing it if it
+// is still running after the stop timeout. It must not be called together
+// with Wait, whose result it returns.
+func (p *Process) Stop() error {
+	if err := p.Signal(syscall.SIGTERM); err != nil && !errors.Is(err, os.ErrProcessDone) {
+		return err
+	}
+	done := make(chan error, 1)
+	go func() {
+		done <- p.cmd.Wait()
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(p.stopTimeout):
+		p.Kill()
+		return <-done
+	}
+}
+
+// Output runs `name` with `args` and returns its standard output. A
+// failure includes the standard error in its message.
+func Output(ctx context.Context, name string, args []string, opts ...Option) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+	opts = append(opts, WithStdout(&stdout), WithStderr(&stderr))
+	err := New(ctx, name, args, opts...).Run()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			err = fmt.Errorf("%w: %s", err, msg)
+		}
+		return stdout.Bytes(), fmt.Errorf("proc: %s: %w", name, err)
+	}
+	return stdout.Bytes(), nil
+}
+
+// ExitCode returns the exit status carried by `err`, 0 if `err` is nil and
+// -1 if it carries none.
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitCode()
+	}
+	return -1
+}
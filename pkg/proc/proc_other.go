@@ -0,0 +1,33 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+//go:build !unix
+
+package proc
+
+import (
+	"os"
+	"os/exec"
+)
+
+// setProcessGroup does nothing, process groups being unsupported.
+func setProcessGroup(cmd *exec.Cmd) {}
+
+// signalGroup sends `sig` to `p` alone.
+func signalGroup(p *os.Process, sig os.Signal) error {
+	return p.Signal(sig)
+}
+
+// execSelf starts `path` as a new process and exits, the process image
+// being irreplaceable.
+func execSelf(path string, args, env []string) error {
+	cmd := exec.Command(path, args[1:]...)
+	cmd.Env = env
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	os.Exit(0)
+	return nil
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package proc
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/focela/aegis/pkg/lifecycle"
+)
+
+// RestartEnv is the variable counting the restarts of the process.
+const RestartEnv = "PROC_RESTARTS"
+
+// Restart runs the shutdown hooks of pkg/lifecycle, then replaces the current process with
+// a new instance of its executable, with the same arguments and
+// environment. On Windows, the new instance is started as a child and the
+// current process exits. Restart returns only on failure; a failing hook
+// does not prevent the restart.
+func Restart(ctx context.Context) error {
+	path, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("proc: restart: %w", err)
+	}
+	lifecycle.Shutdown(ctx)
+	env := slices.DeleteFunc(os.Environ(), func(kv string) bool {
+		return strings.HasPrefix(kv, RestartEnv+"=")
+	})
+	env = append(env, RestartEnv+"="+strconv.Itoa(Restarts()+1))
+	if err := execSelf(path, os.Args, env); err != nil {
+		return fmt.Errorf("proc: restart: %w", err)
+	}
+	return nil
+}
+
+// Restarts returns the number of times the process was restarted by
+// Restart.
+func Restarts() int {
+	n, _ := strconv.Atoi(os.Getenv(RestartEnv))
+	return n
+}
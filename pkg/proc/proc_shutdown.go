@@ -0,0 +1,41 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package proc
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/focela/aegis/pkg/lifecycle"
+)
+
+// DefaultShutdownTimeout is the timeout of hooks registered without one.
+const DefaultShutdownTimeout = lifecycle.DefaultTimeout
+
+// ShutdownFunc releases a resource on shutdown, giving up when `ctx` ends.
+type ShutdownFunc = lifecycle.HookFunc
+
+// RegisterShutdown registers `fn` to be run on shutdown, under `name` in
+// errors, with at most `timeout`, DefaultShutdownTimeout if zero. The hook
+// is registered with the default priority of pkg/lifecycle, whose hooks of
+// equal priority run concurrently; use lifecycle.Register with
+// lifecycle.WithPriority to stop a server before its database.
+func RegisterShutdown(name string, timeout time.Duration, fn ShutdownFunc) {
+	lifecycle.Register(name, fn, lifecycle.WithTimeout(timeout))
+}
+
+// Shutdown runs the hooks registered with pkg/lifecycle, once, as
+// lifecycle.Shutdown.
+func Shutdown(ctx context.Context) error {
+	return lifecycle.Shutdown(ctx)
+}
+
+// WaitForShutdown blocks until the process receives one of `signals`,
+// SIGINT and SIGTERM by default, or `ctx` ends, then runs Shutdown, as
+// lifecycle.Wait.
+func WaitForShutdown(ctx context.Context, signals ...os.Signal) error {
+	return lifecycle.Wait(ctx, signals...)
+}
@@ -0,0 +1,276 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package res packs directories into compressed resource bundles shipped
+// inside the binary.
+//
+// Pack turns a directory into a bundle, which a program embeds with
+// go:embed or as the byte literal written by WriteGo:
+//
+//	//go:embed assets.res
+//	var assets []byte
+//
+//	bundle, err := res.Load(assets)
+//	http.Handle("/", http.FileServerFS(bundle))
+//
+// A Bundle is an fs.FS, so it serves templates and static files through the
+// standard interfaces, and Export writes its files back to disk.
+//
+// A bundle starts with a header naming its compression format, any format
+// of pkg/encoding/compress, followed by the compressed entries: each file
+// or directory with its name, mode, modification time and content, encoded
+// with the varints and fixed-size integers of pkg/encoding/binary.
+package res
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	iofs "io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/focela/aegis/pkg/encoding/binary"
+	"github.com/focela/aegis/pkg/encoding/compress"
+	"github.com/focela/aegis/pkg/fs"
+)
+
+// magic starts every bundle, followed by the format version.
+const (
+	magic   = "LRES"
+	version = 1
+)
+
+// ErrInvalidBundle is returned for data that is not a well-formed bundle.
+var ErrInvalidBundle = errors.New("res: invalid bundle")
+
+// options holds the settings of a packing.
+type options struct {
+	format  string
+	level   int
+	prefix  string
+	exclude []string
+}
+
+// Option configures a packing.
+type Option func(*options)
+
+// WithCompression sets the compression format, a name of
+// compress.Get, and level, compress.NameGzip at compress.BestCompression by
+// default.
+func WithCompression(format string, level ...int) Option {
+	return func(o *options) {
+		o.format = format
+		if len(level) > 0 {
+			o.level = level[0]
+		}
+	}
+}
+
+// WithPrefix places the packed files below the slash-separated directory
+// `prefix` of the bundle.
+func WithPrefix(prefix string) Option {
+	return func(o *options) {
+		o.prefix = strings.Trim(path.Clean("/"+prefix), "/")
+	}
+}
+
+// WithExclude skips the files and directories matching one of `patterns`,
+// in the syntax of fs.Match, against their path relative to the packed
+// directory or their base name.
+func WithExclude(patterns ...string) Option {
+	return func(o *options) {
+		o.exclude = append(o.exclude, patterns...)
+	}
+}
+
+// entry is a file or directory of a bundle.
+type entry struct {
+	name    string
+	mode    iofs.FileMode
+	modTime time.Time
+	data    []byte
+
+	// children holds the entries of a directory, sorted by name.
+	children []*entry
+}
+
+// Pack packs the directory `dir` into a bundle.
+func Pack(dir string, opts ...Option) ([]byte, error) {
+	o := &options{format: compress.NameGzip, level: compress.BestCompression}
+	for _, opt := range opts {
+		opt(o)
+	}
+	c, err := compress.Get(o.format, o.level)
+	if err != nil {
+		return nil, fmt.Errorf("res: %w", err)
+	}
+
+	var body []byte
+	count := 0
+	err = filepath.WalkDir(dir, func(file string, d iofs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, file)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if rel != "." && excluded(o.exclude, rel) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		var data []byte
+		switch {
+		case d.IsDir():
+		case info.Mode().IsRegular():
+			if data, err = os.ReadFile(file); err != nil {
+				return err
+			}
+		default:
+			// Links and special files have no place in a bundle
+			return nil
+		}
+		name := path.Join(o.prefix, rel)
+		if name == "." {
+			return nil
+		}
+		body = appendEntry(body, name, info, data)
+		count++
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("res: packing %s: %w", dir, err)
+	}
+
+	compressed, err := c.Compress(append(binary.AppendUvarint(nil, uint64(count)), body...))
+	if err != nil {
+		return nil, fmt.Errorf("res: %w", err)
+	}
+	out := append([]byte(magic), version)
+	out = appendBytes(out, []byte(o.format))
+	return append(out, compressed...), nil
+}
+
+// PackFile packs the directory `dir` into the bundle file `dst`.
+func PackFile(dir, dst string, opts ...Option) error {
+	data, err := Pack(dir, opts...)
+	if err != nil {
+		return err
+	}
+	return fs.WriteFileAtomic(dst, data, 0o644)
+}
+
+// WriteGo writes to `w` a Go source file of package `pkg` declaring the
+// bundle `data` as the byte slice variable `name`, for builds that cannot
+// use go:embed.
+func WriteGo(w io.Writer, pkg, name string, data []byte) error {
+	var b strings.Builder
+	b.WriteString("// Code generated by res.WriteGo. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package %s\n\n", pkg)
+	fmt.Fprintf(&b, "var %s = []byte(%s)\n", name, strconv.Quote(string(data)))
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// appendEntry appends the encoding of an entry to `b`.
+func appendEntry(b []byte, name string, info iofs.FileInfo, data []byte) []byte {
+	b = appendBytes(b, []byte(name))
+	b = binary.AppendUvarint(b, uint64(info.Mode()))
+	b = append(b, binary.EncodeInt64(info.ModTime().UnixNano())...)
+	return appendBytes(b, data)
+}
+
+// appendBytes appends `data` prefixed with its length to `b`.
+func appendBytes(b, data []byte) []byte {
+	return append(binary.AppendUvarint(b, uint64(len(data))), data...)
+}
+
+// excluded reports whether the slash-separated `rel` or its base name
+// matches one of `patterns`.
+func excluded(patterns []string, rel string) bool {
+	for _, pattern := range patterns {
+		if fs.Match(pattern, rel) || fs.Match(pattern, path.Base(rel)) {
+			return true
+		}
+	}
+	return false
+}
+
+// decoder reads the encoded entries of a bundle.
+type decoder struct {
+	b []byte
+}
+
+// uvarint reads a varint.
+func (d *decoder) uvarint() (uint64, error) {
+	v, n, err := binary.DecodeUvarint(d.b)
+	if err != nil {
+		return 0, ErrInvalidBundle
+	}
+	d.b = d.b[n:]
+	return v, nil
+}
+
+// bytes reads a length-prefixed byte slice.
+func (d *decoder) bytes() ([]byte, error) {
+	n, err := d.uvarint()
+	if err != nil || n > uint64(len(d.b)) {
+		return nil, ErrInvalidBundle
+	}
+	v := d.b[:n:n]
+	d.b = d.b[n:]
+	return v, nil
+}
+
+// int64 reads a fixed-size integer.
+func (d *decoder) int64() (int64, error) {
+	if len(d.b) < 8 {
+		return 0, ErrInvalidBundle
+	}
+	v := binary.DecodeToInt64(d.b[:8])
+	d.b = d.b[8:]
+	return v, nil
+}
+
+// entry reads an entry.
+func (d *decoder) entry() (*entry, error) {
+	name, err := d.bytes()
+	if err != nil {
+		return nil, err
+	}
+	mode, err := d.uvarint()
+	if err != nil {
+		return nil, err
+	}
+	modTime, err := d.int64()
+	if err != nil {
+		return nil, err
+	}
+	data, err := d.bytes()
+	if err != nil {
+		return nil, err
+	}
+	if !iofs.ValidPath(string(name)) || string(name) == "." {
+		return nil, fmt.Errorf("%w: invalid name %q", ErrInvalidBundle, name)
+	}
+	return &entry{
+		name:    string(name),
+		mode:    iofs.FileMode(mode),
+		modTime: time.Unix(0, modTime),
+		data:    bytes.Clone(data),
+	}, nil
+}
@@ -0,0 +1,270 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package res
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	iofs "io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/focela/aegis/pkg/encoding/compress"
+)
+
+// Bundle is a loaded resource bundle. It implements fs.FS, fs.ReadFileFS,
+// fs.ReadDirFS and fs.StatFS, and is safe for concurrent use.
+type Bundle struct {
+	// entries indexes the entries by name, "." being the root.
+	entries map[string]*entry
+}
+
+// Load decodes the bundle `data`.
+func Load(data []byte) (*Bundle, error) {
+	if len(data) < len(magic)+1 || string(data[:len(magic)]) != magic {
+		return nil, ErrInvalidBundle
+	}
+	if data[len(magic)] != version {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrInvalidBundle, data[len(magic)])
+	}
+	d := &decoder{b: data[len(magic)+1:]}
+	format, err := d.bytes()
+	if err != nil {
+		return nil, err
+	}
+	c, err := compress.Get(string(format))
+	if err != nil {
+		return nil, fmt.Errorf("res: %w", err)
+	}
+	body, err := c.Decompress(d.b)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidBundle, err)
+	}
+
+	d = &decoder{b: body}
+	count, err := d.uvarint()
+	if err != nil {
+		return nil, err
+	}
+	b := &Bundle{entries: map[string]*entry{
+		".": {name: ".", mode: iofs.ModeDir | 0o755},
+	}}
+	for range count {
+		e, err := d.entry()
+		if err != nil {
+			return nil, err
+		}
+		b.add(e)
+	}
+	for _, e := range b.entries {
+		slices.SortFunc(e.children, func(x, y *entry) int {
+			return strings.Compare(x.name, y.name)
+		})
+	}
+	return b, nil
+}
+
+// LoadFile decodes the bundle file `file`.
+func LoadFile(file string) (*Bundle, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	return Load(data)
+}
+
+// MustLoad is like Load but panics on failure, for embedded bundles.
+func MustLoad(data []byte) *Bundle {
+	b, err := Load(data)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// add indexes `e`, creating its missing parent directories.
+func (b *Bundle) add(e *entry) {
+	if old, ok := b.entries[e.name]; ok {
+		// A directory listed after one of its files keeps its children
+		e.children = old.children
+		old.children = nil
+		*old = *e
+		return
+	}
+	b.entries[e.name] = e
+	dir := path.Dir(e.name)
+	parent, ok := b.entries[dir]
+	if !ok {
+		parent = &entry{name: dir, mode: iofs.ModeDir | 0o755, modTime: e.modTime}
+		b.add(parent)
+	}
+	parent.children = append(parent.children, e)
+}
+
+// Open implements fs.FS.
+func (b *Bundle) Open(name string) (iofs.File, error) {
+	e, err := b.lookup("open", name)
+	if err != nil {
+		return nil, err
+	}
+	if e.mode.IsDir() {
+		return &dirFile{entry: e}, nil
+	}
+	return &file{entry: e, Reader: bytes.NewReader(e.data)}, nil
+}
+
+// ReadFile implements fs.ReadFileFS.
+func (b *Bundle) ReadFile(name string) ([]byte, error) {
+	e, err := b.lookup("read", name)
+	if err != nil {
+		return nil, err
+	}
+	if e.mode.IsDir() {
+		return nil, &iofs.PathError{Op: "read", Path: name, Err: fmt.Errorf("is a directory")}
+	}
+	return bytes.Clone(e.data), nil
+}
+
+// ReadDir implements fs.ReadDirFS.
+func (b *Bundle) ReadDir(name string) ([]iofs.DirEntry, error) {
+	e, err := b.lookup("readdir", name)
+	if err != nil {
+		return nil, err
+	}
+	if !e.mode.IsDir() {
+		return nil, &iofs.PathError{Op: "readdir", Path: name, Err: fmt.Errorf("not a directory")}
+	}
+	return dirEntries(e.children), nil
+}
+
+// Stat implements fs.StatFS.
+func (b *Bundle) Stat(name string) (iofs.FileInfo, error) {
+	e, err := b.lookup("stat", name)
+	if err != nil {
+		return nil, err
+	}
+	return fileInfo{e}, nil
+}
+
+// Export writes the files of the directory `name` of the bundle, "." for
+// all, below the directory `dst`, replacing existing files.
+func (b *Bundle) Export(name, dst string) error {
+	if _, err := b.lookup("export", name); err != nil {
+		return err
+	}
+	return iofs.WalkDir(b, name, func(p string, d iofs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel := p
+		switch {
+		case p == name:
+			rel = "."
+		case name != ".":
+			rel = strings.TrimPrefix(p, name+"/")
+		}
+		target := filepath.Join(dst, filepath.FromSlash(rel))
+		e := b.entries[p]
+		if d.IsDir() {
+			return os.MkdirAll(target, e.mode.Perm()|0o700)
+		}
+		if err := os.WriteFile(target, e.data, e.mode.Perm()); err != nil {
+			return fmt.Errorf("res: exporting %s: %w", p, err)
+		}
+		return os.Chtimes(target, e.modTime, e.modTime)
+	})
+}
+
+// lookup returns the entry `name` or a *fs.PathError for `op`.
+func (b *Bundle) lookup(op, name string) (*entry, error) {
+	if !iofs.ValidPath(name) {
+		return nil, &iofs.PathError{Op: op, Path: name, Err: iofs.ErrInvalid}
+	}
+	e, ok := b.entries[name]
+	if !ok {
+		return nil, &iofs.PathError{Op: op, Path: name, Err: iofs.ErrNotExist}
+	}
+	return e, nil
+}
+
+// fileInfo implements fs.FileInfo for an entry.
+type fileInfo struct {
+	e *entry
+}
+
+func (fi fileInfo) Name() string                 { return path.Base(fi.e.name) }
+func (fi fileInfo) Size() int64                  { return int64(len(fi.e.data)) }
+func (fi fileInfo) Mode() iofs.FileMode          { return fi.e.mode }
+func (fi fileInfo) ModTime() time.Time           { return fi.e.modTime }
+func (fi fileInfo) IsDir() bool                  { return fi.e.mode.IsDir() }
+func (fi fileInfo) Sys() any                     { return nil }
+func (fi fileInfo) Type() iofs.FileMode          { return fi.e.mode.Type() }
+func (fi fileInfo) Info() (iofs.FileInfo, error) { return fi, nil }
+
+// dirEntries returns the directory entries of `entries`.
+func dirEntries(entries []*entry) []iofs.DirEntry {
+	out := make([]iofs.DirEntry, len(entries))
+	for i, e := range entries {
+		out[i] = fileInfo{e}
+	}
+	return out
+}
+
+// file is an open regular file. It implements io.Seeker and io.ReaderAt.
+type file struct {
+	*bytes.Reader
+	entry *entry
+}
+
+// Stat implements fs.File.
+func (f *file) Stat() (iofs.FileInfo, error) {
+	return fileInfo{f.entry}, nil
+}
+
+// Close implements fs.File.
+func (f *file) Close() error {
+	return nil
+}
+
+// dirFile is an open directory. It implements fs.ReadDirFile.
+type dirFile struct {
+	entry *entry
+
+	// offset is the number of entries already read.
+	offset int
+}
+
+// Stat implements fs.File.
+func (d *dirFile) Stat() (iofs.FileInfo, error) {
+	return fileInfo{d.entry}, nil
+}
+
+// Read implements fs.File.
+func (d *dirFile) Read([]byte) (int, error) {
+	return 0, &iofs.PathError{Op: "read", Path: d.entry.name, Err: fmt.Errorf("is a directory")}
+}
+
+// Close implements fs.File.
+func (d *dirFile) Close() error {
+	return nil
+}
+
+// ReadDir implements fs.ReadDirFile.
+func (d *dirFile) ReadDir(n int) ([]iofs.DirEntry, error) {
+	rest := d.entry.children[d.offset:]
+	if n > 0 {
+		if len(rest) == 0 {
+			return nil, io.EOF
+		}
+		rest = rest[:min(n, len(rest))]
+	}
+	d.offset += len(rest)
+	return dirEntries(rest), nil
+}
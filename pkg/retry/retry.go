@@ -0,0 +1,194 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package retry retries failing operations with exponential backoff.
+//
+// Do calls a function until it succeeds, the attempts or the elapsed time
+// run out, the context is done, or the error is not worth retrying. The
+// delay between attempts grows by a multiplier up to a ceiling, and jitter
+// randomizes it so clients failing together do not retry in lockstep. By
+// default every error is retried except context errors and errors wrapped by
+// Permanent; WithRetryIf(errors.IsRetryable) instead retries only errors
+// classified as transient by pkg/errors.
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+)
+
+// Defaults of the options.
+const (
+	DefaultMaxAttempts = 3
+	DefaultInitial     = 100 * time.Millisecond
+	DefaultMax         = 10 * time.Second
+	DefaultMultiplier  = 2.0
+	DefaultJitter      = 0.2
+)
+
+// options holds the settings of Do.
+type options struct {
+	maxAttempts int
+	maxElapsed  time.Duration
+	initial     time.Duration
+	max         time.Duration
+	multiplier  float64
+	jitter      float64
+	retryIf     func(error) bool
+	onRetry     func(attempt int, err error, delay time.Duration)
+}
+
+// Option configures Do.
+type Option func(*options)
+
+// WithMaxAttempts sets the number of attempts, including the first,
+// DefaultMaxAttempts by default. Zero or less means no limit.
+func WithMaxAttempts(n int) Option {
+	return func(o *options) {
+		o.maxAttempts = n
+	}
+}
+
+// WithMaxElapsed stops retrying once `d` has elapsed since the first
+// attempt, or would have elapsed after the next delay. Zero, the default,
+// means no limit.
+func WithMaxElapsed(d time.Duration) Option {
+	return func(o *options) {
+		o.maxElapsed = d
+	}
+}
+
+// WithBackoff sets the delay before the first retry, the largest delay and
+// the factor applied to the delay after each retry. A multiplier of 1 gives
+// constant delays.
+func WithBackoff(initial, max time.Duration, multiplier float64) Option {
+	return func(o *options) {
+		o.initial, o.max, o.multiplier = initial, max, multiplier
+	}
+}
+
+// WithJitter randomizes each delay within [d*(1-fraction), d]. A fraction of
+// 1 gives full jitter and 0 none; DefaultJitter is the default.
+func WithJitter(fraction float64) Option {
+	return func(o *options) {
+		o.jitter = min(max(fraction, 0), 1)
+	}
+}
+
+// WithRetryIf retries only errors for which `retryIf` returns true. Context
+// errors and errors wrapped by Permanent are never retried.
+func WithRetryIf(retryIf func(error) bool) Option {
+	return func(o *options) {
+		o.retryIf = retryIf
+	}
+}
+
+// WithOnRetry calls `hook` before each retry with the number of the failed
+// attempt, starting at 1, its error and the delay before the next attempt,
+// typically to log it.
+func WithOnRetry(hook func(attempt int, err error, delay time.Duration)) Option {
+	return func(o *options) {
+		o.onRetry = hook
+	}
+}
+
+// permanentError marks an error as not retryable.
+type permanentError struct {
+	error
+}
+
+// Unwrap returns the cause of the error.
+func (e *permanentError) Unwrap() error {
+	return e.error
+}
+
+// Permanent wraps `err` so that Do returns it at once. Do returns `err`
+// itself, without the wrapper. It returns nil if `err` is nil.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err}
+}
+
+// Do calls `fn` until it returns nil or retrying stops, and returns the last
+// error. When `ctx` is done while waiting, the returned error wraps both
+// ctx.Err() and the last error of `fn`.
+func Do(ctx context.Context, fn func(ctx context.Context) error, opts ...Option) error {
+	_, err := DoValue(ctx, func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, fn(ctx)
+	}, opts...)
+	return err
+}
+
+// DoValue is like Do for a function returning a value, which it returns from
+// the successful attempt.
+func DoValue[T any](ctx context.Context, fn func(ctx context.Context) (T, error), opts ...Option) (T, error) {
+	o := &options{
+		maxAttempts: DefaultMaxAttempts,
+		initial:     DefaultInitial,
+		max:         DefaultMax,
+		multiplier:  DefaultMultiplier,
+		jitter:      DefaultJitter,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	var zero T
+	start := time.Now()
+	delay := o.initial
+	for attempt := 1; ; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return zero, err
+		}
+		value, err := fn(ctx)
+		if err == nil {
+			return value, nil
+		}
+		var permanent *permanentError
+		if errors.As(err, &permanent) {
+			return zero, permanent.error
+		}
+		if !o.shouldRetry(ctx, err) || (o.maxAttempts > 0 && attempt >= o.maxAttempts) {
+			return zero, err
+		}
+		wait := o.jittered(delay)
+		if o.maxElapsed > 0 && time.Since(start)+wait > o.maxElapsed {
+			return zero, err
+		}
+		if o.onRetry != nil {
+			o.onRetry(attempt, err, wait)
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return zero, fmt.Errorf("%w: %w", ctx.Err(), err)
+		case <-timer.C:
+		}
+		delay = min(time.Duration(float64(delay)*o.multiplier), o.max)
+	}
+}
+
+// shouldRetry reports whether `err` is worth retrying.
+func (o *options) shouldRetry(ctx context.Context, err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		// A deadline of the operation itself is retryable while ctx lives
+		if ctx.Err() != nil || errors.Is(err, context.Canceled) {
+			return false
+		}
+	}
+	return o.retryIf == nil || o.retryIf(err)
+}
+
+// jittered returns `d` reduced by a random share of the jitter fraction.
+func (o *options) jittered(d time.Duration) time.Duration {
+	if o.jitter == 0 || d <= 0 {
+		return d
+	}
+	return d - time.Duration(o.jitter*rand.Float64()*float64(d))
+}
@@ -0,0 +1,355 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package timer provides a hierarchical timing wheel for scheduling large
+// numbers of jobs.
+//
+// A Timer advances one goroutine by fixed ticks and keeps its entries in
+// several wheels of slots, each wheel covering a range that many times
+// larger than the one below. An entry is stored in the finest wheel that can
+// hold its deadline and cascades to finer wheels as the deadline approaches,
+// so adding, firing and cancelling an entry take constant time however many
+// are pending. Millions of timers thus cost a few words of memory each,
+// instead of a goroutine or runtime timer each.
+//
+// Deadlines are rounded up to the tick, 10ms by default, and jobs run in
+// their own goroutines, so a slow job never delays the wheel.
+package timer
+
+import (
+	"sync"
+	"time"
+
+	"github.com/focela/aegis/pkg/clock"
+)
+
+// JobFunc is a job run by a Timer.
+type JobFunc func()
+
+// Default wheel geometry.
+const (
+	DefaultTick   = 10 * time.Millisecond
+	DefaultSlots  = 64
+	DefaultLevels = 6
+)
+
+// Option configures a Timer.
+type Option func(*Timer)
+
+// WithTick sets the tick, the resolution of deadlines, DefaultTick by
+// default.
+func WithTick(tick time.Duration) Option {
+	return func(t *Timer) {
+		if tick > 0 {
+			t.tick = tick
+		}
+	}
+}
+
+// WithWheel sets the number of slots of each wheel and the number of wheels.
+// Deadlines up to tick*slots^levels ahead are placed directly; later ones
+// wait in the coarsest wheel.
+func WithWheel(slots, levels int) Option {
+	return func(t *Timer) {
+		if slots > 1 && levels > 0 {
+			t.slots, t.levels = slots, levels
+		}
+	}
+}
+
+// WithClock sets the clock the timer reads and ticks on, clock.Real() by
+// default. With a clock.Fake, the entries due run on the timer goroutine
+// after the fake is advanced, not before Advance returns, so tests must
+// wait for their effects. Like a real ticker, the fake drops a tick sent
+// while the timer still handles the previous one; the entries it would
+// have run then run on the next tick.
+func WithClock(c clock.Clock) Option {
+	return func(t *Timer) {
+		if c != nil {
+			t.clock = c
+		}
+	}
+}
+
+// Timer is a hierarchical timing wheel. It is safe for concurrent use.
+type Timer struct {
+	// mu guards the fields below it.
+	mu sync.Mutex
+
+	// tick is the duration of a tick.
+	tick time.Duration
+
+	// slots is the number of slots per wheel and levels the number of wheels.
+	slots  int
+	levels int
+
+	// wheels holds the entries of each slot of each wheel.
+	wheels [][][]*Entry
+
+	// spans holds the ticks covered by one slot of each wheel.
+	spans []int64
+
+	// ticks is the number of ticks elapsed.
+	ticks int64
+
+	// base is the time of tick zero, shifted while paused.
+	base time.Time
+
+	// paused stops the wheel from advancing.
+	paused bool
+
+	// pausedAt is the time the timer was paused.
+	pausedAt time.Time
+
+	// closed reports whether Close was called.
+	closed bool
+
+	// done stops the ticking goroutine.
+	done chan struct{}
+
+	// clock tells the time.
+	clock clock.Clock
+}
+
+// defaultTimer is the Timer of the package-level functions.
+var defaultTimer = sync.OnceValue(func() *Timer {
+	return New()
+})
+
+// New creates and returns a running Timer.
+func New(opts ...Option) *Timer {
+	t := &Timer{
+		tick:   DefaultTick,
+		slots:  DefaultSlots,
+		levels: DefaultLevels,
+		done:   make(chan struct{}),
+		clock:  clock.Real(),
+	}
+	for _, opt := range opts {
+		opt(t)
+	}
+	t.wheels = make([][][]*Entry, t.levels)
+	t.spans = make([]int64, t.levels)
+	span := int64(1)
+	for i := range t.wheels {
+		t.wheels[i] = make([][]*Entry, t.slots)
+		t.spans[i] = span
+		span *= int64(t.slots)
+	}
+	t.base = t.clock.Now()
+	go t.loop()
+	return t
+}
+
+// Add runs `job` every `interval` until the entry is closed.
+func (t *Timer) Add(interval time.Duration, job JobFunc) *Entry {
+	return t.add(interval, job, -1, false)
+}
+
+// AddOnce runs `job` once after `delay`.
+func (t *Timer) AddOnce(delay time.Duration, job JobFunc) *Entry {
+	return t.add(delay, job, 1, false)
+}
+
+// AddTimes runs `job` every `interval`, `times` times in total.
+func (t *Timer) AddTimes(interval time.Duration, times int, job JobFunc) *Entry {
+	return t.add(interval, job, times, false)
+}
+
+// AddSingleton runs `job` every `interval` like Add, but skips a run while
+// the previous one is still running.
+func (t *Timer) AddSingleton(interval time.Duration, job JobFunc) *Entry {
+	return t.add(interval, job, -1, true)
+}
+
+// Start resumes a timer paused by Stop. Deadlines are shifted by the pause.
+func (t *Timer) Start() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.paused {
+		t.paused = false
+		t.base = t.base.Add(t.clock.Now().Sub(t.pausedAt))
+	}
+}
+
+// Stop pauses the timer: no job runs until Start is called.
+func (t *Timer) Stop() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !t.paused {
+		t.paused, t.pausedAt = true, t.clock.Now()
+	}
+}
+
+// Close stops the timer and discards its entries. Running jobs are not
+// interrupted. A closed timer cannot be restarted.
+func (t *Timer) Close() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.closed {
+		return
+	}
+	t.closed = true
+	close(t.done)
+	for _, wheel := range t.wheels {
+		for i, slot := range wheel {
+			for _, e := range slot {
+				e.status = StatusClosed
+			}
+			wheel[i] = nil
+		}
+	}
+}
+
+// Add runs `job` every `interval` on the default timer.
+func Add(interval time.Duration, job JobFunc) *Entry {
+	return defaultTimer().Add(interval, job)
+}
+
+// AddOnce runs `job` once after `delay` on the default timer.
+func AddOnce(delay time.Duration, job JobFunc) *Entry {
+	return defaultTimer().AddOnce(delay, job)
+}
+
+// AddTimes runs `job` every `interval`, `times` times, on the default timer.
+func AddTimes(interval time.Duration, times int, job JobFunc) *Entry {
+	return defaultTimer().AddTimes(interval, times, job)
+}
+
+// AddSingleton runs `job` every `interval` on the default timer, skipping a
+// run while the previous one is still running.
+func AddSingleton(interval time.Duration, job JobFunc) *Entry {
+	return defaultTimer().AddSingleton(interval, job)
+}
+
+// add creates and schedules an entry.
+func (t *Timer) add(interval time.Duration, job JobFunc, times int, singleton bool) *Entry {
+	e := &Entry{
+		timer:     t,
+		job:       job,
+		interval:  t.toTicks(interval),
+		times:     times,
+		singleton: singleton,
+		status:    StatusReady,
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.closed || times == 0 {
+		e.status = StatusClosed
+		return e
+	}
+	e.at = t.ticks + e.interval
+	t.schedule(e)
+	return e
+}
+
+// toTicks returns `d` in ticks, rounded up, at least one.
+func (t *Timer) toTicks(d time.Duration) int64 {
+	n := int64((d + t.tick - 1) / t.tick)
+	if n < 1 {
+		return 1
+	}
+	return n
+}
+
+// schedule stores `e` in the finest wheel holding its deadline. It must be
+// called with the lock held.
+func (t *Timer) schedule(e *Entry) {
+	if e.at <= t.ticks {
+		e.at = t.ticks
+	}
+	e.scheduled = true
+	for level, span := range t.spans {
+		if e.at/span-t.ticks/span < int64(t.slots) {
+			slot := int(e.at / span % int64(t.slots))
+			t.wheels[level][slot] = append(t.wheels[level][slot], e)
+			return
+		}
+	}
+	// Beyond the range of the wheels: park the entry in the last slot of the
+	// coarsest wheel to be reconsidered on its cascade
+	level := t.levels - 1
+	slot := int((t.ticks/t.spans[level] + int64(t.slots) - 1) % int64(t.slots))
+	t.wheels[level][slot] = append(t.wheels[level][slot], e)
+}
+
+// loop advances the wheel until the timer is closed.
+func (t *Timer) loop() {
+	ticker := t.clock.NewTicker(t.tick)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C():
+			t.advance()
+		case <-t.done:
+			return
+		}
+	}
+}
+
+// advance processes every tick elapsed since the last call, catching up
+// when the ticker fell behind.
+func (t *Timer) advance() {
+	t.mu.Lock()
+	if t.paused || t.closed {
+		t.mu.Unlock()
+		return
+	}
+	target := int64(t.clock.Now().Sub(t.base) / t.tick)
+	var due []*Entry
+	for t.ticks < target {
+		t.ticks++
+		due = t.step(due)
+	}
+	t.mu.Unlock()
+	for _, e := range due {
+		e.run()
+	}
+}
+
+// step processes the current tick: it cascades the coarser wheels reaching
+// a slot boundary, then appends the due entries to `due`. It must be called
+// with the lock held.
+func (t *Timer) step(due []*Entry) []*Entry {
+	for level := t.levels - 1; level > 0; level-- {
+		span := t.spans[level]
+		if t.ticks%span != 0 {
+			continue
+		}
+		slot := int(t.ticks / span % int64(t.slots))
+		entries := t.wheels[level][slot]
+		t.wheels[level][slot] = nil
+		for _, e := range entries {
+			if e.status != StatusClosed {
+				t.schedule(e)
+			}
+		}
+	}
+	slot := int(t.ticks % int64(t.slots))
+	entries := t.wheels[0][slot]
+	t.wheels[0][slot] = nil
+	for _, e := range entries {
+		e.scheduled = false
+		switch e.status {
+		case StatusClosed:
+			continue
+		case StatusStopped:
+			// Paused entries leave the wheel; Start schedules them again
+			continue
+		}
+		due = append(due, e)
+		if e.times > 0 {
+			e.times--
+		}
+		if e.times == 0 {
+			e.status = StatusClosed
+			continue
+		}
+		if e.at += e.interval; e.at <= t.ticks {
+			e.at = t.ticks + 1
+		}
+		t.schedule(e)
+	}
+	return due
+}
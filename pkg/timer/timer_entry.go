@@ -0,0 +1,113 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package timer
+
+import "sync/atomic"
+
+// Status is the state of an Entry.
+type Status int
+
+// Entry states.
+const (
+	// StatusReady is an entry waiting for its next run.
+	StatusReady Status = iota
+
+	// StatusStopped is a paused entry.
+	StatusStopped
+
+	// StatusClosed is an entry that will not run again.
+	StatusClosed
+)
+
+// Entry is a job scheduled on a Timer.
+type Entry struct {
+	// timer is the owning Timer, whose lock guards the fields below.
+	timer *Timer
+
+	// job is the function run.
+	job JobFunc
+
+	// interval is the period in ticks.
+	interval int64
+
+	// times is the number of runs left, negative for unlimited.
+	times int
+
+	// singleton skips runs while the previous one is running.
+	singleton bool
+
+	// status is the state of the entry.
+	status Status
+
+	// at is the tick of the next run.
+	at int64
+
+	// scheduled reports whether the entry is in a wheel.
+	scheduled bool
+
+	// running is set while a singleton job runs.
+	running atomic.Bool
+}
+
+// Status returns the state of the entry.
+func (e *Entry) Status() Status {
+	e.timer.mu.Lock()
+	defer e.timer.mu.Unlock()
+	return e.status
+}
+
+// Stop pauses the entry: it does not run until Start is called.
+func (e *Entry) Stop() {
+	e.timer.mu.Lock()
+	defer e.timer.mu.Unlock()
+	if e.status == StatusReady {
+		e.status = StatusStopped
+	}
+}
+
+// Start resumes a paused entry. If its deadline passed while it was paused,
+// it runs at the next tick and then resumes its period from there.
+func (e *Entry) Start() {
+	t := e.timer
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if e.status != StatusStopped {
+		return
+	}
+	e.status = StatusReady
+	if !e.scheduled {
+		if e.at <= t.ticks {
+			e.at = t.ticks + 1
+		}
+		t.schedule(e)
+	}
+}
+
+// Close removes the entry: it will not run again.
+func (e *Entry) Close() {
+	e.timer.mu.Lock()
+	defer e.timer.mu.Unlock()
+	e.status = StatusClosed
+}
+
+// Run runs the job now, in the calling goroutine, honoring the singleton
+// mode. It does not affect the schedule.
+func (e *Entry) Run() {
+	if e.singleton {
+		if !e.running.CompareAndSwap(false, true) {
+			return
+		}
+		defer e.running.Store(false)
+	}
+	e.job()
+}
+
+// run starts the job in a new goroutine.
+func (e *Entry) run() {
+	if e.singleton && e.running.Load() {
+		return
+	}
+	go e.Run()
+}
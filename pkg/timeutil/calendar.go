@@ -0,0 +1,288 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package timeutil
+
+import (
+	"sync"
+	"time"
+)
+
+// Default business hours of a Calendar, as offsets from midnight.
+const (
+	DefaultOpening = 9 * time.Hour
+	DefaultClosing = 17 * time.Hour
+)
+
+// maxDaysSearched bounds the search for a business day, so a calendar
+// without any fails loudly instead of looping forever.
+const maxDaysSearched = 3 * 366
+
+// CalendarOption configures a Calendar.
+type CalendarOption func(*Calendar)
+
+// WithWeekend sets the days that are never business days, Saturday and
+// Sunday by default. A set covering the whole week is ignored.
+func WithWeekend(days ...time.Weekday) CalendarOption {
+	return func(c *Calendar) {
+		var weekend [7]bool
+		for _, d := range days {
+			weekend[d%7] = true
+		}
+		for _, off := range weekend {
+			if !off {
+				c.weekend = weekend
+				return
+			}
+		}
+	}
+}
+
+// WithBusinessHours sets the opening and closing times of business days, as
+// offsets from midnight, DefaultOpening and DefaultClosing by default.
+// Offsets are wall-clock times, so 9h is 09:00 on DST changes too. Invalid
+// hours are ignored.
+func WithBusinessHours(opening, closing time.Duration) CalendarOption {
+	return func(c *Calendar) {
+		if opening >= 0 && closing > opening && closing <= 24*time.Hour {
+			c.opening, c.closing = opening, closing
+		}
+	}
+}
+
+// WithCalendarLocation sets the time zone days and hours are evaluated in,
+// time.Local by default.
+func WithCalendarLocation(loc *time.Location) CalendarOption {
+	return func(c *Calendar) {
+		if loc != nil {
+			c.loc = loc
+		}
+	}
+}
+
+// Calendar tells business days from weekends and holidays and computes
+// durations over business hours. It is safe for concurrent use.
+type Calendar struct {
+	// mu guards holidays and recurring.
+	mu sync.RWMutex
+
+	// weekend holds the days off by weekday.
+	weekend [7]bool
+
+	// opening and closing are the business hours as offsets from midnight.
+	opening, closing time.Duration
+
+	// loc is the time zone of the calendar.
+	loc *time.Location
+
+	// holidays holds the names of holidays by date.
+	holidays map[calendarDate]string
+
+	// recurring holds the names of yearly holidays by month and day.
+	recurring map[calendarDate]string
+}
+
+// calendarDate is a date, with a zero year for recurring holidays.
+type calendarDate struct {
+	year  int
+	month time.Month
+	day   int
+}
+
+// NewCalendar creates and returns a Calendar without holidays.
+func NewCalendar(opts ...CalendarOption) *Calendar {
+	c := &Calendar{
+		opening:   DefaultOpening,
+		closing:   DefaultClosing,
+		loc:       time.Local,
+		holidays:  make(map[calendarDate]string),
+		recurring: make(map[calendarDate]string),
+	}
+	c.weekend[time.Saturday], c.weekend[time.Sunday] = true, true
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// AddHoliday registers the date of `date`, in the calendar location, as a
+// holiday called `name`.
+func (c *Calendar) AddHoliday(date time.Time, name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.holidays[c.dateOf(date)] = name
+}
+
+// AddRecurringHoliday registers `day` of `month` of every year as a holiday
+// called `name`.
+func (c *Calendar) AddRecurringHoliday(month time.Month, day int, name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.recurring[calendarDate{month: month, day: day}] = name
+}
+
+// RemoveHoliday unregisters the holiday on the date of `date`. Recurring
+// holidays are not affected.
+func (c *Calendar) RemoveHoliday(date time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.holidays, c.dateOf(date))
+}
+
+// Holiday returns the name of the holiday on the date of `t`, and false if
+// it is none.
+func (c *Calendar) Holiday(t time.Time) (name string, found bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	d := c.dateOf(t)
+	if name, found = c.holidays[d]; found {
+		return name, true
+	}
+	name, found = c.recurring[calendarDate{month: d.month, day: d.day}]
+	return name, found
+}
+
+// IsWeekend reports whether the date of `t` falls on the weekend.
+func (c *Calendar) IsWeekend(t time.Time) bool {
+	return c.weekend[t.In(c.loc).Weekday()]
+}
+
+// IsBusinessDay reports whether the date of `t` is neither on the weekend
+// nor a holiday.
+func (c *Calendar) IsBusinessDay(t time.Time) bool {
+	if c.IsWeekend(t) {
+		return false
+	}
+	_, holiday := c.Holiday(t)
+	return !holiday
+}
+
+// IsBusinessHours reports whether `t` falls within the business hours of a
+// business day.
+func (c *Calendar) IsBusinessHours(t time.Time) bool {
+	return c.IsBusinessDay(t) && c.hours(t).Contains(t)
+}
+
+// NextBusinessDay returns the first business day after the date of `t`, at
+// the same time of day.
+func (c *Calendar) NextBusinessDay(t time.Time) time.Time {
+	return c.step(t.In(c.loc), 1)
+}
+
+// PrevBusinessDay returns the last business day before the date of `t`, at
+// the same time of day.
+func (c *Calendar) PrevBusinessDay(t time.Time) time.Time {
+	return c.step(t.In(c.loc), -1)
+}
+
+// AddBusinessDays returns the date `n` business days after `t`, at the same
+// time of day, or before it if `n` is negative. Zero returns `t`.
+func (c *Calendar) AddBusinessDays(t time.Time, n int) time.Time {
+	t = t.In(c.loc)
+	for ; n > 0; n-- {
+		t = c.step(t, 1)
+	}
+	for ; n < 0; n++ {
+		t = c.step(t, -1)
+	}
+	return t
+}
+
+// BusinessDaysBetween returns the number of business days from the date of
+// `start` included to the date of `end` excluded, negative if `end` is
+// before `start`.
+func (c *Calendar) BusinessDaysBetween(start, end time.Time) int {
+	sign := 1
+	if end.Before(start) {
+		start, end, sign = end, start, -1
+	}
+	n := 0
+	last := c.dateOf(end)
+	for day := c.midnight(start); c.dateOf(day) != last; day = day.AddDate(0, 0, 1) {
+		if c.IsBusinessDay(day) {
+			n++
+		}
+	}
+	return sign * n
+}
+
+// BusinessDuration returns the business hours elapsed between `start` and
+// `end`, negative if `end` is before `start`.
+func (c *Calendar) BusinessDuration(start, end time.Time) time.Duration {
+	if end.Before(start) {
+		return -c.BusinessDuration(end, start)
+	}
+	span := NewRange(start, end)
+	var total time.Duration
+	for day := c.midnight(start); day.Before(end); day = day.AddDate(0, 0, 1) {
+		if !c.IsBusinessDay(day) {
+			continue
+		}
+		if r, ok := c.hours(day).Intersect(span); ok {
+			total += r.Duration()
+		}
+	}
+	return total
+}
+
+// AddBusinessDuration returns the time reached by counting `d` of business
+// hours from `t`, as when computing the deadline of an SLA. Counting
+// outside business hours starts at the next opening. Negative durations
+// count as zero.
+func (c *Calendar) AddBusinessDuration(t time.Time, d time.Duration) time.Time {
+	t = t.In(c.loc)
+	for searched := 0; ; searched++ {
+		if searched > maxDaysSearched {
+			panic("timeutil: calendar has no business day")
+		}
+		if c.IsBusinessDay(t) {
+			hours := c.hours(t)
+			if t.Before(hours.Start) {
+				t = hours.Start
+			}
+			if t.Before(hours.End) {
+				left := hours.End.Sub(t)
+				if d <= left {
+					return t.Add(max(d, 0))
+				}
+				d -= left
+			}
+		}
+		t = c.midnight(t).AddDate(0, 0, 1)
+	}
+}
+
+// step moves `t` to the next business day in direction `dir`, keeping the
+// time of day.
+func (c *Calendar) step(t time.Time, dir int) time.Time {
+	y, m, d := t.Date()
+	for i := 1; i <= maxDaysSearched; i++ {
+		day := time.Date(y, m, d+dir*i, 0, 0, 0, 0, c.loc)
+		if c.IsBusinessDay(day) {
+			return time.Date(y, m, d+dir*i, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), c.loc)
+		}
+	}
+	panic("timeutil: calendar has no business day")
+}
+
+// hours returns the business hours of the date of `t`.
+func (c *Calendar) hours(t time.Time) Range {
+	y, m, d := t.In(c.loc).Date()
+	return Range{
+		Start: time.Date(y, m, d, 0, 0, 0, int(c.opening), c.loc),
+		End:   time.Date(y, m, d, 0, 0, 0, int(c.closing), c.loc),
+	}
+}
+
+// midnight returns the start of the date of `t`.
+func (c *Calendar) midnight(t time.Time) time.Time {
+	y, m, d := t.In(c.loc).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, c.loc)
+}
+
+// dateOf returns the date of `t` in the calendar location.
+func (c *Calendar) dateOf(t time.Time) calendarDate {
+	y, m, d := t.In(c.loc).Date()
+	return calendarDate{year: y, month: m, day: d}
+}
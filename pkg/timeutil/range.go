@@ -0,0 +1,226 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package timeutil
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Range is the half-open interval of time [Start, End). A range whose end
+// is not after its start is empty.
+type Range struct {
+	Start time.Time
+	End   time.Time
+}
+
+// NewRange returns the range between `start` and `end`, swapping them if
+// `end` is before `start`.
+func NewRange(start, end time.Time) Range {
+	if end.Before(start) {
+		start, end = end, start
+	}
+	return Range{Start: start, End: end}
+}
+
+// RangeOf returns the range starting at `start` and lasting `d`.
+func RangeOf(start time.Time, d time.Duration) Range {
+	return NewRange(start, start.Add(d))
+}
+
+// IsEmpty reports whether the range contains no instant.
+func (r Range) IsEmpty() bool {
+	return !r.End.After(r.Start)
+}
+
+// Duration returns the length of the range, zero if it is empty.
+func (r Range) Duration() time.Duration {
+	if r.IsEmpty() {
+		return 0
+	}
+	return r.End.Sub(r.Start)
+}
+
+// Contains reports whether `t` lies within the range. The end is excluded.
+func (r Range) Contains(t time.Time) bool {
+	return !t.Before(r.Start) && t.Before(r.End)
+}
+
+// ContainsRange reports whether `other` lies entirely within the range. An
+// empty range is contained in any range.
+func (r Range) ContainsRange(other Range) bool {
+	if other.IsEmpty() {
+		return true
+	}
+	return !other.Start.Before(r.Start) && !other.End.After(r.End)
+}
+
+// Overlaps reports whether the range shares at least one instant with
+// `other`. Adjacent ranges do not overlap.
+func (r Range) Overlaps(other Range) bool {
+	return r.Start.Before(other.End) && other.Start.Before(r.End) &&
+		!r.IsEmpty() && !other.IsEmpty()
+}
+
+// Intersect returns the instants shared with `other`, and false if there
+// are none.
+func (r Range) Intersect(other Range) (Range, bool) {
+	if !r.Overlaps(other) {
+		return Range{}, false
+	}
+	return Range{Start: latest(r.Start, other.Start), End: earliest(r.End, other.End)}, true
+}
+
+// Split cuts the range into consecutive ranges of `d`, the last one being
+// shorter if the duration is not a multiple of `d`. It returns nil if the
+// range is empty or `d` is not positive.
+//
+// Durations are added to the start, so a range split by 24 hours does not
+// follow calendar days across DST changes; use SplitFunc with AddDate for
+// that.
+func (r Range) Split(d time.Duration) []Range {
+	if d <= 0 {
+		return nil
+	}
+	return r.SplitFunc(func(t time.Time) time.Time {
+		return t.Add(d)
+	})
+}
+
+// SplitFunc cuts the range at the instants produced by `next`, called with
+// the start of each part to get the start of the following one, so that
+// ranges can be split by calendar units:
+//
+//	months := period.SplitFunc(func(t time.Time) time.Time {
+//		return t.AddDate(0, 1, 0)
+//	})
+//
+// It stops if `next` does not move forward.
+func (r Range) SplitFunc(next func(t time.Time) time.Time) []Range {
+	var parts []Range
+	for start := r.Start; start.Before(r.End); {
+		end := next(start)
+		if !end.After(start) {
+			break
+		}
+		if end.After(r.End) {
+			end = r.End
+		}
+		parts = append(parts, Range{Start: start, End: end})
+		start = end
+	}
+	return parts
+}
+
+// Equal reports whether the range and `other` have the same bounds. All
+// empty ranges are equal.
+func (r Range) Equal(other Range) bool {
+	if r.IsEmpty() || other.IsEmpty() {
+		return r.IsEmpty() && other.IsEmpty()
+	}
+	return r.Start.Equal(other.Start) && r.End.Equal(other.End)
+}
+
+// String formats the range as "[start, end)" with RFC 3339 times.
+func (r Range) String() string {
+	return fmt.Sprintf("[%s, %s)", r.Start.Format(time.RFC3339Nano), r.End.Format(time.RFC3339Nano))
+}
+
+// MergeRanges returns the union of `ranges` as sorted ranges that neither
+// overlap nor touch. Empty ranges are dropped and `ranges` is not modified.
+func MergeRanges(ranges []Range) []Range {
+	sorted := make([]Range, 0, len(ranges))
+	for _, r := range ranges {
+		if !r.IsEmpty() {
+			sorted = append(sorted, r)
+		}
+	}
+	slices.SortFunc(sorted, func(a, b Range) int {
+		return a.Start.Compare(b.Start)
+	})
+
+	var merged []Range
+	for _, r := range sorted {
+		if n := len(merged); n > 0 && !r.Start.After(merged[n-1].End) {
+			merged[n-1].End = latest(merged[n-1].End, r.End)
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+// IntersectRanges returns the instants covered by both `a` and `b`, as
+// sorted ranges that neither overlap nor touch.
+func IntersectRanges(a, b []Range) []Range {
+	a, b = MergeRanges(a), MergeRanges(b)
+	var out []Range
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		if r, ok := a[i].Intersect(b[j]); ok {
+			out = append(out, r)
+		}
+		if a[i].End.Before(b[j].End) {
+			i++
+		} else {
+			j++
+		}
+	}
+	return out
+}
+
+// SubtractRanges returns the instants of `ranges` not covered by `holes`,
+// as sorted ranges that neither overlap nor touch.
+func SubtractRanges(ranges, holes []Range) []Range {
+	holes = MergeRanges(holes)
+	var out []Range
+	for _, r := range MergeRanges(ranges) {
+		for _, h := range holes {
+			if !h.End.After(r.Start) {
+				continue
+			}
+			if !h.Start.Before(r.End) {
+				break
+			}
+			if h.Start.After(r.Start) {
+				out = append(out, Range{Start: r.Start, End: h.Start})
+			}
+			r.Start = h.End
+			if !r.Start.Before(r.End) {
+				break
+			}
+		}
+		if !r.IsEmpty() {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// TotalDuration returns the time covered by `ranges`, counting overlapping
+// parts once.
+func TotalDuration(ranges []Range) time.Duration {
+	var total time.Duration
+	for _, r := range MergeRanges(ranges) {
+		total += r.Duration()
+	}
+	return total
+}
+
+// earliest returns the earlier of `a` and `b`.
+func earliest(a, b time.Time) time.Time {
+	if b.Before(a) {
+		return b
+	}
+	return a
+}
+
+// latest returns the later of `a` and `b`.
+func latest(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,184 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package timeutil provides a flexible Time type, time ranges, business-day
+// calendars and time measurement helpers.
+package timeutil
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Phase is a named part of a measurement.
+type Phase struct {
+	// Name is the name given to Lap.
+	Name string
+
+	// Duration is the total time spent in the phase.
+	Duration time.Duration
+
+	// Count is the number of laps recorded under the name.
+	Count int
+}
+
+// Report is the result of a measurement.
+type Report struct {
+	// Total is the time elapsed between Start and Stop, or until now.
+	Total time.Duration
+
+	// Phases holds the phases in the order of their first lap.
+	Phases []Phase
+}
+
+// String formats the report with one line per phase, giving its share of
+// the total.
+func (r Report) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "total %v", r.Total)
+	width := 0
+	for _, p := range r.Phases {
+		width = max(width, len(p.Name))
+	}
+	for _, p := range r.Phases {
+		share := 0.0
+		if r.Total > 0 {
+			share = float64(p.Duration) / float64(r.Total) * 100
+		}
+		fmt.Fprintf(&b, "\n  %-*s %12v %5.1f%%", width, p.Name, p.Duration, share)
+		if p.Count > 1 {
+			fmt.Fprintf(&b, " (%d laps)", p.Count)
+		}
+	}
+	return b.String()
+}
+
+// Stopwatch measures the total duration of a task and of its named phases.
+// It is safe for concurrent use, although laps are meant to be recorded in
+// sequence:
+//
+//	sw := timeutil.StartStopwatch()
+//	decode(req)
+//	sw.Lap("decode")
+//	query(db)
+//	sw.Lap("query")
+//	log.Print(sw.Stop())
+type Stopwatch struct {
+	// mu guards the fields below it.
+	mu sync.Mutex
+
+	// start is the start time and last the time of the last lap.
+	start time.Time
+	last  time.Time
+
+	// stopped is the stop time, zero while running.
+	stopped time.Time
+
+	// phases holds the phases in order and index their positions by name.
+	phases []Phase
+	index  map[string]int
+
+	// now returns the current time.
+	now func() time.Time
+}
+
+// NewStopwatch creates and returns a Stopwatch, not yet started.
+func NewStopwatch() *Stopwatch {
+	return &Stopwatch{now: time.Now}
+}
+
+// StartStopwatch creates, starts and returns a Stopwatch.
+func StartStopwatch() *Stopwatch {
+	sw := NewStopwatch()
+	sw.Start()
+	return sw
+}
+
+// Start starts the stopwatch, discarding any previous measurement.
+func (sw *Stopwatch) Start() {
+	sw.mu.Lock()
+	defer sw.mu.Unlock()
+	sw.start = sw.now()
+	sw.last = sw.start
+	sw.stopped = time.Time{}
+	sw.phases, sw.index = nil, nil
+}
+
+// Lap ends the current phase, naming it `name`, and returns its duration.
+// The phase started at the previous lap, or at Start. Laps with the same
+// name add up. Lap does nothing on a stopwatch that is not running.
+func (sw *Stopwatch) Lap(name string) time.Duration {
+	sw.mu.Lock()
+	defer sw.mu.Unlock()
+	if sw.start.IsZero() || !sw.stopped.IsZero() {
+		return 0
+	}
+	now := sw.now()
+	d := now.Sub(sw.last)
+	sw.last = now
+	if sw.index == nil {
+		sw.index = make(map[string]int)
+	}
+	i, ok := sw.index[name]
+	if !ok {
+		i = len(sw.phases)
+		sw.index[name] = i
+		sw.phases = append(sw.phases, Phase{Name: name})
+	}
+	sw.phases[i].Duration += d
+	sw.phases[i].Count++
+	return d
+}
+
+// Stop stops the stopwatch and returns its report. Time since the last lap
+// is not attributed to any phase. Stopping again returns the same report.
+func (sw *Stopwatch) Stop() Report {
+	sw.mu.Lock()
+	defer sw.mu.Unlock()
+	if !sw.start.IsZero() && sw.stopped.IsZero() {
+		sw.stopped = sw.now()
+	}
+	return sw.report()
+}
+
+// Elapsed returns the time elapsed since Start, up to Stop if stopped.
+func (sw *Stopwatch) Elapsed() time.Duration {
+	sw.mu.Lock()
+	defer sw.mu.Unlock()
+	return sw.elapsed()
+}
+
+// Running reports whether the stopwatch is started and not stopped.
+func (sw *Stopwatch) Running() bool {
+	sw.mu.Lock()
+	defer sw.mu.Unlock()
+	return !sw.start.IsZero() && sw.stopped.IsZero()
+}
+
+// Report returns the measurement so far without stopping the stopwatch.
+func (sw *Stopwatch) Report() Report {
+	sw.mu.Lock()
+	defer sw.mu.Unlock()
+	return sw.report()
+}
+
+// report returns the measurement. It must be called with the lock held.
+func (sw *Stopwatch) report() Report {
+	phases := make([]Phase, len(sw.phases))
+	copy(phases, sw.phases)
+	return Report{Total: sw.elapsed(), Phases: phases}
+}
+
+// elapsed returns the measured time. It must be called with the lock held.
+func (sw *Stopwatch) elapsed() time.Duration {
+	switch {
+	case sw.start.IsZero():
+		return 0
+	case sw.stopped.IsZero():
+		return sw.now().Sub(sw.start)
+	}
+	return sw.stopped.Sub(sw.start)
+}
@@ -0,0 +1,148 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package timeutil
+
+import (
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/focela/aegis/pkg/clock"
+)
+
+// TTLTickerOption configures a TTLTicker.
+type TTLTickerOption func(*TTLTicker)
+
+// WithTickerClock sets the clock the ticker runs on, clock.Real() by
+// default.
+func WithTickerClock(c clock.Clock) TTLTickerOption {
+	return func(t *TTLTicker) {
+		if c != nil {
+			t.clock = c
+		}
+	}
+}
+
+// TTLTicker is a ticker whose ticks are spread randomly around its
+// interval. Janitor loops of caches created at the same time would
+// otherwise purge in lockstep and hit shared resources together. It
+// implements clock.Ticker and is safe for concurrent use.
+type TTLTicker struct {
+	// mu guards the fields below it.
+	mu sync.Mutex
+
+	// ch receives the ticks.
+	ch chan time.Time
+
+	// interval is the mean period and jitter the maximum deviation from it,
+	// as a fraction of it.
+	interval time.Duration
+	jitter   float64
+
+	// timer fires the next tick, nil once stopped.
+	timer clock.Timer
+
+	// gen identifies the armed timer, so a stale one does nothing.
+	gen uint64
+
+	// clock fires the ticks.
+	clock clock.Clock
+}
+
+// NewTTLTicker creates and returns a running TTLTicker ticking every
+// `interval`, shifted by up to `jitter` times the interval either way.
+// `jitter` is clamped to [0, 1]. It panics if `interval` is not positive.
+//
+// Like time.Ticker, it drops ticks for slow receivers, and Stop must be
+// called to release it.
+func NewTTLTicker(interval time.Duration, jitter float64, opts ...TTLTickerOption) *TTLTicker {
+	if interval <= 0 {
+		panic("timeutil: non-positive ticker interval")
+	}
+	t := &TTLTicker{
+		ch:       make(chan time.Time, 1),
+		interval: interval,
+		jitter:   min(max(jitter, 0), 1),
+		clock:    clock.Real(),
+	}
+	for _, opt := range opts {
+		opt(t)
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.arm()
+	return t
+}
+
+// C returns the channel of the ticks.
+func (t *TTLTicker) C() <-chan time.Time {
+	return t.ch
+}
+
+// Interval returns the mean period of the ticker.
+func (t *TTLTicker) Interval() time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.interval
+}
+
+// Reset changes the mean period to `interval` and restarts the ticker,
+// including a stopped one. It panics if `interval` is not positive.
+func (t *TTLTicker) Reset(interval time.Duration) {
+	if interval <= 0 {
+		panic("timeutil: non-positive ticker interval")
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.interval = interval
+	if t.timer != nil {
+		t.timer.Stop()
+	}
+	t.arm()
+}
+
+// Stop turns the ticker off. It does not close the channel.
+func (t *TTLTicker) Stop() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.timer != nil {
+		t.timer.Stop()
+		t.timer = nil
+	}
+	t.gen++
+}
+
+// arm schedules the next tick. It must be called with the lock held.
+func (t *TTLTicker) arm() {
+	t.gen++
+	gen := t.gen
+	t.timer = t.clock.AfterFunc(t.next(), func() {
+		t.tick(gen)
+	})
+}
+
+// tick delivers the tick of timer `gen` and schedules the next one.
+func (t *TTLTicker) tick(gen uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.gen != gen {
+		return
+	}
+	select {
+	case t.ch <- t.clock.Now():
+	default:
+	}
+	t.arm()
+}
+
+// next returns the delay of the next tick. It must be called with the lock
+// held.
+func (t *TTLTicker) next() time.Duration {
+	if t.jitter == 0 {
+		return t.interval
+	}
+	d := float64(t.interval) * (1 + t.jitter*(2*rand.Float64()-1))
+	return max(time.Duration(d), 1)
+}
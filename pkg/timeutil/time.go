@@ -0,0 +1,157 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package timeutil
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"fmt"
+	"time"
+
+	"github.com/focela/aegis/pkg/conv"
+)
+
+// Time is a time.Time that parses most common formats and marshals the same
+// way to JSON, text and SQL.
+//
+// Parse accepts RFC 3339, "2006-01-02 15:04:05" and the other layouts of
+// conv.Time, as well as unix timestamps in seconds, milliseconds,
+// microseconds or nanoseconds, told apart by magnitude. Time marshals to
+// RFC 3339 with nanoseconds, and the zero Time marshals to JSON null and SQL
+// NULL, so optional timestamps need no pointer. Format and ParseFormat use
+// the date tokens of Format rather than Go layouts, which remain available
+// through the embedded time.Time and Layout.
+type Time struct {
+	time.Time
+}
+
+// Now returns the current time.
+func Now() Time {
+	return Time{time.Now()}
+}
+
+// New returns the Time of `t`.
+func New(t time.Time) Time {
+	return Time{t}
+}
+
+// Parse parses `value`, a string, byte slice, number, time.Time or Time, as
+// conv.Time does. Strings without zone information are interpreted in the
+// location of `opts`, time.Local by default.
+func Parse(value interface{}, opts ...conv.Option) (Time, error) {
+	if t, ok := value.(Time); ok {
+		return t, nil
+	}
+	t, err := conv.To[time.Time](value, opts...)
+	if err != nil {
+		return Time{}, fmt.Errorf("timeutil: %w", err)
+	}
+	return Time{t}, nil
+}
+
+// MustParse is like Parse but panics on error.
+func MustParse(value interface{}, opts ...conv.Option) Time {
+	t, err := Parse(value, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// ParseLayout parses `s` with the Go layout `layout`, in the location of
+// `loc`, time.Local by default.
+func ParseLayout(s, layout string, loc ...*time.Location) (Time, error) {
+	l := time.Local
+	if len(loc) > 0 && loc[0] != nil {
+		l = loc[0]
+	}
+	t, err := time.ParseInLocation(layout, s, l)
+	if err != nil {
+		return Time{}, fmt.Errorf("timeutil: %w", err)
+	}
+	return Time{t}, nil
+}
+
+// Layout formats the time with the Go layout `layout`.
+func (t Time) Layout(layout string) string {
+	return t.Time.Format(layout)
+}
+
+// String returns the time as "2006-01-02 15:04:05", or an empty string for
+// the zero Time.
+func (t Time) String() string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Time.Format(time.DateTime)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Time) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return t.Time.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts null, strings in any
+// format of Parse and unix timestamps.
+func (t *Time) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		*t = Time{}
+		return nil
+	}
+	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
+		data = data[1 : len(data)-1]
+	}
+	parsed, err := Parse(string(data))
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (t Time) MarshalText() ([]byte, error) {
+	if t.IsZero() {
+		return []byte{}, nil
+	}
+	return t.Time.MarshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (t *Time) UnmarshalText(text []byte) error {
+	parsed, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
+// Value implements driver.Valuer, storing the zero Time as NULL.
+func (t Time) Value() (driver.Value, error) {
+	if t.IsZero() {
+		return nil, nil
+	}
+	return t.Time, nil
+}
+
+// Scan implements sql.Scanner. It accepts time.Time, the formats of Parse
+// and NULL, which scans as the zero Time.
+func (t *Time) Scan(src interface{}) error {
+	if src == nil {
+		*t = Time{}
+		return nil
+	}
+	parsed, err := Parse(src)
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
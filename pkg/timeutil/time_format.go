@@ -0,0 +1,143 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package timeutil
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// tokenLayouts maps the parseable format tokens to Go layout elements.
+var tokenLayouts = map[byte]string{
+	'Y': "2006",
+	'y': "06",
+	'm': "01",
+	'n': "1",
+	'M': "Jan",
+	'F': "January",
+	'd': "02",
+	'j': "2",
+	'D': "Mon",
+	'l': "Monday",
+	'H': "15",
+	'h': "03",
+	'g': "3",
+	'i': "04",
+	's': "05",
+	'v': ".000",
+	'u': ".000000",
+	'A': "PM",
+	'a': "pm",
+	'T': "MST",
+	'P': "-07:00",
+	'O': "-0700",
+	'c': time.RFC3339,
+	'r': time.RFC1123Z,
+}
+
+// Format formats the time with the date tokens of `format`:
+//
+//	Y  year, 4 digits          y  year, 2 digits
+//	m  month, 01-12            n  month, 1-12
+//	M  month, Jan-Dec          F  month, January-December
+//	d  day, 01-31              j  day, 1-31
+//	D  weekday, Mon-Sun        l  weekday, Monday-Sunday
+//	N  ISO weekday, 1-7        w  weekday, 0-6 from Sunday
+//	z  day of year, 0-365      W  ISO week, 01-53
+//	H  hour, 00-23             G  hour, 0-23
+//	h  hour, 01-12             g  hour, 1-12
+//	i  minute, 00-59           s  second, 00-59
+//	v  milliseconds, 000-999   u  microseconds, 000000-999999
+//	A  AM or PM                a  am or pm
+//	T  zone abbreviation       e  zone name
+//	P  offset, -07:00          O  offset, -0700
+//	U  unix seconds            c  RFC 3339
+//	r  RFC 1123 with offset
+//
+// Any other character is written as is, and a backslash writes the next
+// character literally.
+func (t Time) Format(format string) string {
+	var b strings.Builder
+	for i := 0; i < len(format); i++ {
+		c := format[i]
+		switch c {
+		case '\\':
+			if i+1 < len(format) {
+				i++
+				b.WriteByte(format[i])
+			}
+		case 'N':
+			wd := int(t.Weekday())
+			if wd == 0 {
+				wd = 7
+			}
+			b.WriteString(strconv.Itoa(wd))
+		case 'w':
+			b.WriteString(strconv.Itoa(int(t.Weekday())))
+		case 'z':
+			b.WriteString(strconv.Itoa(t.YearDay() - 1))
+		case 'W':
+			_, week := t.ISOWeek()
+			fmt.Fprintf(&b, "%02d", week)
+		case 'G':
+			b.WriteString(strconv.Itoa(t.Hour()))
+		case 'e':
+			b.WriteString(t.Location().String())
+		case 'U':
+			b.WriteString(strconv.FormatInt(t.Unix(), 10))
+		case 'v':
+			fmt.Fprintf(&b, "%03d", t.Nanosecond()/1e6)
+		case 'u':
+			fmt.Fprintf(&b, "%06d", t.Nanosecond()/1e3)
+		default:
+			if layout, ok := tokenLayouts[c]; ok {
+				b.WriteString(t.Time.Format(layout))
+			} else {
+				b.WriteByte(c)
+			}
+		}
+	}
+	return b.String()
+}
+
+// ParseFormat parses `s` with the date tokens of `format`, as listed on
+// Format, in the location of `loc`, time.Local by default. The tokens N, w,
+// z, W, G, e and U cannot be parsed, and fractional seconds are matched by v
+// or u only after a dot, as in "H:i:s.v".
+func ParseFormat(s, format string, loc ...*time.Location) (Time, error) {
+	layout, err := formatToLayout(format)
+	if err != nil {
+		return Time{}, err
+	}
+	return ParseLayout(s, layout, loc...)
+}
+
+// formatToLayout converts the date tokens of `format` to a Go layout.
+func formatToLayout(format string) (string, error) {
+	var b strings.Builder
+	for i := 0; i < len(format); i++ {
+		c := format[i]
+		switch {
+		case c == '\\':
+			if i+1 < len(format) {
+				i++
+				b.WriteByte(format[i])
+			}
+		case c == '.' && i+1 < len(format) && (format[i+1] == 'v' || format[i+1] == 'u'):
+			// The dot is part of the fractional second element
+		case strings.IndexByte("NwzWGeU", c) >= 0:
+			return "", fmt.Errorf("timeutil: token %q of %q cannot be parsed", c, format)
+		default:
+			if layout, ok := tokenLayouts[c]; ok {
+				b.WriteString(layout)
+			} else {
+				b.WriteByte(c)
+			}
+		}
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,254 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package ksuid provides KSUIDs, K-sortable unique identifiers.
+//
+// A KSUID holds a 32-bit timestamp, in seconds since Epoch, followed by 128
+// random bits, and is written as 27 base62 characters, so its string and
+// binary forms both sort by creation time. KSUIDs generated by New in the
+// same process are strictly increasing: within a second, the payload of the
+// previous KSUID is incremented instead of drawn again.
+package ksuid
+
+import (
+	"crypto/rand"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/focela/aegis/pkg/encoding/base62"
+)
+
+// Size is the length of a KSUID in bytes, and EncodedSize that of its string.
+const (
+	Size        = 20
+	EncodedSize = 27
+)
+
+// Epoch is the Unix time, in seconds, of the zero KSUID timestamp.
+const Epoch = 1400000000
+
+// ErrInvalid is returned when parsing malformed input.
+var ErrInvalid = errors.New("ksuid: invalid KSUID")
+
+// KSUID is a K-sortable unique identifier.
+type KSUID [Size]byte
+
+// Zero is the zero KSUID.
+var Zero KSUID
+
+// maxValue is the largest value a KSUID holds, 2^160 - 1.
+var maxValue = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), Size*8), big.NewInt(1))
+
+// state guards the monotonicity of New.
+var state struct {
+	sync.Mutex
+	last KSUID
+}
+
+// New returns a KSUID for the current time.
+func New() KSUID {
+	ts := uint32(time.Now().Unix() - Epoch)
+	state.Lock()
+	defer state.Unlock()
+	var k KSUID
+	if last := state.last; ts <= last.timestamp() {
+		k = last
+		if !k.increment() {
+			// The payload overflowed: move to the next second
+			k.setTimestamp(last.timestamp() + 1)
+		}
+	} else {
+		_, _ = rand.Read(k[4:])
+		k.setTimestamp(ts)
+	}
+	state.last = k
+	return k
+}
+
+// NewAt returns a KSUID for `t` with a random payload, without the
+// monotonicity of New. It panics if `t` is outside the KSUID time range,
+// from 2014-05-13 to 2150-06-19.
+func NewAt(t time.Time) KSUID {
+	sec := t.Unix() - Epoch
+	if sec < 0 || sec > 1<<32-1 {
+		panic(fmt.Sprintf("ksuid: time %v out of range", t))
+	}
+	var k KSUID
+	_, _ = rand.Read(k[4:])
+	k.setTimestamp(uint32(sec))
+	return k
+}
+
+// Parse parses the 27-character base62 string `s`.
+func Parse(s string) (KSUID, error) {
+	if len(s) != EncodedSize {
+		return Zero, fmt.Errorf("%w %q", ErrInvalid, s)
+	}
+	n := new(big.Int)
+	base := big.NewInt(int64(len(base62.Alphabet)))
+	for i := 0; i < len(s); i++ {
+		d := strings.IndexByte(base62.Alphabet, s[i])
+		if d < 0 {
+			return Zero, fmt.Errorf("%w %q", ErrInvalid, s)
+		}
+		n.Mul(n, base).Add(n, big.NewInt(int64(d)))
+	}
+	if n.Cmp(maxValue) > 0 {
+		return Zero, fmt.Errorf("%w %q", ErrInvalid, s)
+	}
+	var k KSUID
+	n.FillBytes(k[:])
+	return k, nil
+}
+
+// MustParse is like Parse but panics on error.
+func MustParse(s string) KSUID {
+	k, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return k
+}
+
+// FromBytes returns the KSUID of the 20 bytes `b`.
+func FromBytes(b []byte) (KSUID, error) {
+	var k KSUID
+	if len(b) != Size {
+		return Zero, fmt.Errorf("%w: %d bytes", ErrInvalid, len(b))
+	}
+	copy(k[:], b)
+	return k, nil
+}
+
+// Valid reports whether `s` parses as a KSUID.
+func Valid(s string) bool {
+	_, err := Parse(s)
+	return err == nil
+}
+
+// String returns the 27-character base62 form of `k`, zero-padded.
+func (k KSUID) String() string {
+	n := new(big.Int).SetBytes(k[:])
+	base := big.NewInt(int64(len(base62.Alphabet)))
+	var buf [EncodedSize]byte
+	mod := new(big.Int)
+	for i := EncodedSize - 1; i >= 0; i-- {
+		n.DivMod(n, base, mod)
+		buf[i] = base62.Alphabet[mod.Int64()]
+	}
+	return string(buf[:])
+}
+
+// Bytes returns the 20 bytes of `k`.
+func (k KSUID) Bytes() []byte {
+	return k[:]
+}
+
+// Payload returns the 16 random bytes of `k`.
+func (k KSUID) Payload() []byte {
+	return k[4:]
+}
+
+// Time returns the timestamp of `k`, to the second.
+func (k KSUID) Time() time.Time {
+	return time.Unix(int64(k.timestamp())+Epoch, 0)
+}
+
+// IsZero reports whether `k` is the zero KSUID.
+func (k KSUID) IsZero() bool {
+	return k == Zero
+}
+
+// Compare returns -1, 0 or 1 as `k` sorts before, equal to or after `other`.
+func (k KSUID) Compare(other KSUID) int {
+	for i := range k {
+		if k[i] != other[i] {
+			if k[i] < other[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (k KSUID) MarshalText() ([]byte, error) {
+	return []byte(k.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (k *KSUID) UnmarshalText(text []byte) error {
+	parsed, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*k = parsed
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (k KSUID) MarshalBinary() ([]byte, error) {
+	return k.Bytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (k *KSUID) UnmarshalBinary(data []byte) error {
+	parsed, err := FromBytes(data)
+	if err != nil {
+		return err
+	}
+	*k = parsed
+	return nil
+}
+
+// Value implements driver.Valuer, storing the string form.
+func (k KSUID) Value() (driver.Value, error) {
+	return k.String(), nil
+}
+
+// Scan implements sql.Scanner. It accepts the string form, 20 raw bytes, and
+// NULL, which scans as the zero KSUID.
+func (k *KSUID) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*k = Zero
+		return nil
+	case string:
+		return k.UnmarshalText([]byte(v))
+	case []byte:
+		if len(v) == Size {
+			return k.UnmarshalBinary(v)
+		}
+		return k.UnmarshalText(v)
+	}
+	return fmt.Errorf("ksuid: cannot scan %T", src)
+}
+
+// timestamp returns the timestamp of `k` in seconds since Epoch.
+func (k KSUID) timestamp() uint32 {
+	return uint32(k[0])<<24 | uint32(k[1])<<16 | uint32(k[2])<<8 | uint32(k[3])
+}
+
+// setTimestamp sets the timestamp of `k` to `ts` seconds since Epoch.
+func (k *KSUID) setTimestamp(ts uint32) {
+	k[0], k[1], k[2], k[3] = byte(ts>>24), byte(ts>>16), byte(ts>>8), byte(ts)
+}
+
+// increment adds one to the payload of `k`, reporting false when it wraps
+// around to zero.
+func (k *KSUID) increment() bool {
+	for i := Size - 1; i >= 4; i-- {
+		k[i]++
+		if k[i] != 0 {
+			return true
+		}
+	}
+	return false
+}
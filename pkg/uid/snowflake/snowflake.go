@@ -0,0 +1,237 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package snowflake generates Snowflake IDs, time-ordered 63-bit integers
+// unique across a cluster without coordination.
+//
+// An ID holds, from the most significant bit, the milliseconds since an
+// epoch, a datacenter number, a worker number and a per-millisecond
+// sequence. The default layout is the original one, with 41 bits of time,
+// 5 bits each of datacenter and worker, and 12 bits of sequence, which lasts
+// about 69 years from the epoch and yields up to 4096 IDs per millisecond
+// and worker. Each process must use a distinct datacenter and worker pair.
+//
+// The generator never issues an ID twice: when the sequence is exhausted it
+// waits for the next millisecond, and when the clock steps back by at most
+// the tolerated drift it waits for the clock to catch up. Larger steps back
+// fail with ErrClockMovedBackwards.
+package snowflake
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// DefaultEpoch is the default epoch, 2010-11-04T01:42:54.657Z, the epoch of
+// the original Snowflake.
+var DefaultEpoch = time.UnixMilli(1288834974657)
+
+// Default layout and drift tolerance.
+const (
+	DefaultDatacenterBits = 5
+	DefaultWorkerBits     = 5
+	DefaultSequenceBits   = 12
+	DefaultMaxDrift       = 10 * time.Millisecond
+)
+
+var (
+	// ErrClockMovedBackwards is returned when the clock steps back by more
+	// than the tolerated drift.
+	ErrClockMovedBackwards = errors.New("snowflake: clock moved backwards")
+
+	// ErrTimeOverflow is returned when the time since the epoch no longer
+	// fits in the timestamp bits.
+	ErrTimeOverflow = errors.New("snowflake: timestamp overflow")
+)
+
+// options holds the settings of a Generator.
+type options struct {
+	epoch          time.Time
+	datacenterBits uint
+	workerBits     uint
+	sequenceBits   uint
+	maxDrift       time.Duration
+}
+
+// Option configures a Generator.
+type Option func(*options)
+
+// WithEpoch sets the time of the zero timestamp, DefaultEpoch by default.
+// Generators of one cluster must share it.
+func WithEpoch(epoch time.Time) Option {
+	return func(o *options) {
+		o.epoch = epoch
+	}
+}
+
+// WithBits sets the number of datacenter, worker and sequence bits; the
+// timestamp takes the remaining bits of the 63. Generators of one cluster
+// must share the layout.
+func WithBits(datacenter, worker, sequence uint) Option {
+	return func(o *options) {
+		o.datacenterBits, o.workerBits, o.sequenceBits = datacenter, worker, sequence
+	}
+}
+
+// WithMaxDrift sets how far the clock may step back before Next fails
+// instead of waiting, DefaultMaxDrift by default.
+func WithMaxDrift(drift time.Duration) Option {
+	return func(o *options) {
+		o.maxDrift = drift
+	}
+}
+
+// Parts are the fields of an ID.
+type Parts struct {
+	Time       time.Time
+	Datacenter int64
+	Worker     int64
+	Sequence   int64
+}
+
+// Generator issues IDs for one datacenter and worker. It is safe for
+// concurrent use.
+type Generator struct {
+	// mu guards last and sequence.
+	mu sync.Mutex
+
+	// epochMs is the epoch in Unix milliseconds.
+	epochMs int64
+
+	// maxDrift is the tolerated backward clock step.
+	maxDrift time.Duration
+
+	// datacenter and worker are the numbers of the generator.
+	datacenter int64
+	worker     int64
+
+	// Shifts of the fields and the largest timestamp and sequence.
+	timeShift       uint
+	datacenterShift uint
+	workerShift     uint
+	maxTime         int64
+	maxSequence     int64
+
+	// last is the timestamp of the last ID and sequence its sequence.
+	last     int64
+	sequence int64
+
+	// now returns the current time.
+	now func() time.Time
+}
+
+// New creates and returns a Generator for `datacenter` and `worker`, which
+// must fit in their bits.
+func New(datacenter, worker int64, opts ...Option) (*Generator, error) {
+	o := &options{
+		epoch:          DefaultEpoch,
+		datacenterBits: DefaultDatacenterBits,
+		workerBits:     DefaultWorkerBits,
+		sequenceBits:   DefaultSequenceBits,
+		maxDrift:       DefaultMaxDrift,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	fieldBits := o.datacenterBits + o.workerBits + o.sequenceBits
+	if o.sequenceBits == 0 || fieldBits > 63-32 {
+		return nil, fmt.Errorf("snowflake: invalid layout of %d datacenter, %d worker and %d sequence bits",
+			o.datacenterBits, o.workerBits, o.sequenceBits)
+	}
+	if datacenter < 0 || datacenter >= 1<<o.datacenterBits {
+		return nil, fmt.Errorf("snowflake: datacenter %d out of range [0, %d)", datacenter, int64(1)<<o.datacenterBits)
+	}
+	if worker < 0 || worker >= 1<<o.workerBits {
+		return nil, fmt.Errorf("snowflake: worker %d out of range [0, %d)", worker, int64(1)<<o.workerBits)
+	}
+	return &Generator{
+		epochMs:         o.epoch.UnixMilli(),
+		maxDrift:        o.maxDrift,
+		datacenter:      datacenter,
+		worker:          worker,
+		timeShift:       fieldBits,
+		datacenterShift: o.workerBits + o.sequenceBits,
+		workerShift:     o.sequenceBits,
+		maxTime:         1<<(63-fieldBits) - 1,
+		maxSequence:     1<<o.sequenceBits - 1,
+		last:            -1,
+		now:             time.Now,
+	}, nil
+}
+
+// Next returns a new ID.
+func (g *Generator) Next() (int64, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.next()
+}
+
+// Batch returns `n` new IDs in increasing order, holding the generator for
+// the whole batch.
+func (g *Generator) Batch(n int) ([]int64, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	ids := make([]int64, n)
+	for i := range ids {
+		id, err := g.next()
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+// Parse returns the fields of `id`, issued with the layout and epoch of `g`.
+func (g *Generator) Parse(id int64) Parts {
+	return Parts{
+		Time:       time.UnixMilli(g.epochMs + id>>g.timeShift),
+		Datacenter: id >> g.datacenterShift & (1<<(g.timeShift-g.datacenterShift) - 1),
+		Worker:     id >> g.workerShift & (1<<(g.datacenterShift-g.workerShift) - 1),
+		Sequence:   id & g.maxSequence,
+	}
+}
+
+// next returns a new ID. It must be called with the lock held.
+func (g *Generator) next() (int64, error) {
+	ts := g.timestamp()
+	if ts < g.last {
+		drift := time.Duration(g.last-ts) * time.Millisecond
+		if drift > g.maxDrift {
+			return 0, fmt.Errorf("%w by %v", ErrClockMovedBackwards, drift)
+		}
+		ts = g.waitUntil(g.last)
+	}
+	if ts == g.last {
+		g.sequence = (g.sequence + 1) & g.maxSequence
+		if g.sequence == 0 {
+			ts = g.waitUntil(g.last + 1)
+		}
+	} else {
+		g.sequence = 0
+	}
+	if ts < 0 || ts > g.maxTime {
+		return 0, ErrTimeOverflow
+	}
+	g.last = ts
+	return ts<<g.timeShift | g.datacenter<<g.datacenterShift | g.worker<<g.workerShift | g.sequence, nil
+}
+
+// timestamp returns the milliseconds elapsed since the epoch.
+func (g *Generator) timestamp() int64 {
+	return g.now().UnixMilli() - g.epochMs
+}
+
+// waitUntil sleeps until the timestamp reaches `ts` and returns it.
+func (g *Generator) waitUntil(ts int64) int64 {
+	for {
+		now := g.timestamp()
+		if now >= ts {
+			return now
+		}
+		time.Sleep(time.Duration(ts-now) * time.Millisecond)
+	}
+}
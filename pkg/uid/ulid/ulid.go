@@ -0,0 +1,277 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package ulid provides ULIDs, universally unique lexicographically sortable
+// identifiers.
+//
+// A ULID holds a 48-bit millisecond Unix timestamp followed by 80 random bits
+// and is written as 26 characters of Crockford's base32, so its string and
+// binary forms both sort by creation time. ULIDs generated by New in the same
+// process are strictly increasing: within a millisecond, the random part of
+// the previous ULID is incremented instead of drawn again.
+package ulid
+
+import (
+	"crypto/rand"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Size is the length of a ULID in bytes, and EncodedSize that of its string.
+const (
+	Size        = 16
+	EncodedSize = 26
+)
+
+// alphabet is Crockford's base32 alphabet.
+const alphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// maxTime is the largest timestamp a ULID can hold.
+const maxTime = 1<<48 - 1
+
+// ErrInvalid is returned when parsing malformed input.
+var ErrInvalid = errors.New("ulid: invalid ULID")
+
+// ULID is a universally unique lexicographically sortable identifier.
+type ULID [Size]byte
+
+// Zero is the zero ULID.
+var Zero ULID
+
+// values maps characters to their value, accepting lowercase and the
+// Crockford aliases I, L and O; 0xff marks invalid characters.
+var values = func() (v [256]byte) {
+	for i := range v {
+		v[i] = 0xff
+	}
+	for i := 0; i < len(alphabet); i++ {
+		v[alphabet[i]] = byte(i)
+		v[alphabet[i]|0x20] = byte(i)
+	}
+	v['I'], v['i'], v['L'], v['l'] = 1, 1, 1, 1
+	v['O'], v['o'] = 0, 0
+	return v
+}()
+
+// state guards the monotonicity of New.
+var state struct {
+	sync.Mutex
+	last ULID
+}
+
+// New returns a ULID for the current time.
+func New() ULID {
+	ms := uint64(time.Now().UnixMilli())
+	state.Lock()
+	defer state.Unlock()
+	var u ULID
+	if last := state.last; ms <= last.ms() {
+		u = last
+		if !u.increment() {
+			// The random part overflowed: move to the next millisecond
+			u.setMs(last.ms() + 1)
+		}
+	} else {
+		_, _ = rand.Read(u[6:])
+		u.setMs(ms)
+	}
+	state.last = u
+	return u
+}
+
+// NewAt returns a ULID for `t` with a random part, without the monotonicity
+// of New. It panics if `t` is before the Unix epoch or beyond year 10889.
+func NewAt(t time.Time) ULID {
+	ms := t.UnixMilli()
+	if ms < 0 || ms > maxTime {
+		panic(fmt.Sprintf("ulid: time %v out of range", t))
+	}
+	var u ULID
+	_, _ = rand.Read(u[6:])
+	u.setMs(uint64(ms))
+	return u
+}
+
+// Parse parses the 26-character string `s`, case-insensitively.
+func Parse(s string) (ULID, error) {
+	var u ULID
+	if len(s) != EncodedSize {
+		return Zero, fmt.Errorf("%w %q", ErrInvalid, s)
+	}
+	// The first character holds only the top 3 bits of the 130-bit string
+	if values[s[0]] > 7 {
+		return Zero, fmt.Errorf("%w %q", ErrInvalid, s)
+	}
+	var acc uint64
+	bits, n := 0, Size-1
+	for i := EncodedSize - 1; i >= 0; i-- {
+		v := values[s[i]]
+		if v == 0xff {
+			return Zero, fmt.Errorf("%w %q", ErrInvalid, s)
+		}
+		acc |= uint64(v) << bits
+		bits += 5
+		for bits >= 8 && n >= 0 {
+			u[n] = byte(acc)
+			acc >>= 8
+			bits -= 8
+			n--
+		}
+	}
+	return u, nil
+}
+
+// MustParse is like Parse but panics on error.
+func MustParse(s string) ULID {
+	u, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+// FromBytes returns the ULID of the 16 bytes `b`.
+func FromBytes(b []byte) (ULID, error) {
+	var u ULID
+	if len(b) != Size {
+		return Zero, fmt.Errorf("%w: %d bytes", ErrInvalid, len(b))
+	}
+	copy(u[:], b)
+	return u, nil
+}
+
+// Valid reports whether `s` parses as a ULID.
+func Valid(s string) bool {
+	_, err := Parse(s)
+	return err == nil
+}
+
+// String returns the 26-character uppercase form of `u`.
+func (u ULID) String() string {
+	var buf [EncodedSize]byte
+	var acc uint64
+	bits, n := 0, EncodedSize-1
+	for i := Size - 1; i >= 0; i-- {
+		acc |= uint64(u[i]) << bits
+		bits += 8
+		for bits >= 5 {
+			buf[n] = alphabet[acc&0x1f]
+			acc >>= 5
+			bits -= 5
+			n--
+		}
+	}
+	buf[0] = alphabet[acc&0x1f]
+	return string(buf[:])
+}
+
+// Bytes returns the 16 bytes of `u`.
+func (u ULID) Bytes() []byte {
+	return u[:]
+}
+
+// Time returns the timestamp of `u`, to the millisecond.
+func (u ULID) Time() time.Time {
+	return time.UnixMilli(int64(u.ms()))
+}
+
+// IsZero reports whether `u` is the zero ULID.
+func (u ULID) IsZero() bool {
+	return u == Zero
+}
+
+// Compare returns -1, 0 or 1 as `u` sorts before, equal to or after `other`.
+func (u ULID) Compare(other ULID) int {
+	for i := range u {
+		if u[i] != other[i] {
+			if u[i] < other[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (u ULID) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (u *ULID) UnmarshalText(text []byte) error {
+	parsed, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*u = parsed
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (u ULID) MarshalBinary() ([]byte, error) {
+	return u.Bytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (u *ULID) UnmarshalBinary(data []byte) error {
+	parsed, err := FromBytes(data)
+	if err != nil {
+		return err
+	}
+	*u = parsed
+	return nil
+}
+
+// Value implements driver.Valuer, storing the string form.
+func (u ULID) Value() (driver.Value, error) {
+	return u.String(), nil
+}
+
+// Scan implements sql.Scanner. It accepts the string form, 16 raw bytes, and
+// NULL, which scans as the zero ULID.
+func (u *ULID) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*u = Zero
+		return nil
+	case string:
+		return u.UnmarshalText([]byte(v))
+	case []byte:
+		if len(v) == Size {
+			return u.UnmarshalBinary(v)
+		}
+		return u.UnmarshalText(v)
+	}
+	return fmt.Errorf("ulid: cannot scan %T", src)
+}
+
+// ms returns the timestamp of `u` in milliseconds.
+func (u ULID) ms() uint64 {
+	return uint64(u[0])<<40 | uint64(u[1])<<32 | uint64(u[2])<<24 |
+		uint64(u[3])<<16 | uint64(u[4])<<8 | uint64(u[5])
+}
+
+// setMs sets the timestamp of `u` to `ms` milliseconds.
+func (u *ULID) setMs(ms uint64) {
+	for i := 5; i >= 0; i-- {
+		u[i] = byte(ms)
+		ms >>= 8
+	}
+}
+
+// increment adds one to the random part of `u`, reporting false when it
+// wraps around to zero.
+func (u *ULID) increment() bool {
+	for i := Size - 1; i >= 6; i-- {
+		u[i]++
+		if u[i] != 0 {
+			return true
+		}
+	}
+	return false
+}
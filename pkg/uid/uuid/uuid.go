@@ -0,0 +1,248 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package uuid provides RFC 9562 UUIDs of versions 4 and 7.
+//
+// Version 4 UUIDs are random. Version 7 UUIDs start with a millisecond Unix
+// timestamp, so they sort by creation time, which keeps database indexes
+// compact; UUIDs generated by NewV7 in the same process are strictly
+// increasing, even within a millisecond. UUID implements the text, binary,
+// JSON and database/sql interfaces, using the canonical string form except
+// for MarshalBinary, which yields the 16 bytes.
+package uuid
+
+import (
+	"crypto/rand"
+	"database/sql/driver"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Size is the length of a UUID in bytes.
+const Size = 16
+
+// ErrInvalid is returned when parsing malformed input.
+var ErrInvalid = errors.New("uuid: invalid UUID")
+
+// UUID is a universally unique identifier.
+type UUID [Size]byte
+
+// Nil is the nil UUID, with all bits zero.
+var Nil UUID
+
+// v7State guards the monotonicity of NewV7.
+var v7State struct {
+	sync.Mutex
+	last UUID
+}
+
+// NewV4 returns a random UUID.
+func NewV4() UUID {
+	var u UUID
+	_, _ = rand.Read(u[:])
+	u.setVersion(4)
+	return u
+}
+
+// NewV7 returns a time-ordered UUID.
+//
+// The 12 bits following the timestamp are random and, when the clock has not
+// advanced, are instead incremented from the previous UUID, borrowing from
+// the timestamp on overflow, so the result always sorts after it.
+func NewV7() UUID {
+	var u UUID
+	_, _ = rand.Read(u[6:])
+	ms := uint64(time.Now().UnixMilli())
+
+	v7State.Lock()
+	defer v7State.Unlock()
+	last := v7State.last
+	lastMs := uint64(last[0])<<40 | uint64(binary.BigEndian.Uint32(last[1:5]))<<8 | uint64(last[5])
+	seq := uint16(u[6]&0x0f)<<8 | uint16(u[7])
+	if ms <= lastMs {
+		ms = lastMs
+		seq = (uint16(last[6]&0x0f)<<8 | uint16(last[7])) + 1
+		if seq > 0x0fff {
+			ms, seq = ms+1, 0
+		}
+	}
+	u[0] = byte(ms >> 40)
+	binary.BigEndian.PutUint32(u[1:5], uint32(ms>>8))
+	u[5] = byte(ms)
+	u[6], u[7] = byte(seq>>8), byte(seq)
+	u.setVersion(7)
+	v7State.last = u
+	return u
+}
+
+// Parse parses `s` in the canonical form, optionally in braces or with a
+// "urn:uuid:" prefix, or as 32 hex digits. Letters may be of either case.
+func Parse(s string) (UUID, error) {
+	var u UUID
+	switch {
+	case len(s) == 45 && strings.EqualFold(s[:9], "urn:uuid:"):
+		s = s[9:]
+	case len(s) == 38 && s[0] == '{' && s[37] == '}':
+		s = s[1:37]
+	}
+	switch len(s) {
+	case 32:
+		if _, err := hex.Decode(u[:], []byte(s)); err != nil {
+			return Nil, fmt.Errorf("%w %q", ErrInvalid, s)
+		}
+	case 36:
+		if s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+			return Nil, fmt.Errorf("%w %q", ErrInvalid, s)
+		}
+		h := s[:8] + s[9:13] + s[14:18] + s[19:23] + s[24:]
+		if _, err := hex.Decode(u[:], []byte(h)); err != nil {
+			return Nil, fmt.Errorf("%w %q", ErrInvalid, s)
+		}
+	default:
+		return Nil, fmt.Errorf("%w %q", ErrInvalid, s)
+	}
+	return u, nil
+}
+
+// MustParse is like Parse but panics on error.
+func MustParse(s string) UUID {
+	u, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+// FromBytes returns the UUID of the 16 bytes `b`.
+func FromBytes(b []byte) (UUID, error) {
+	var u UUID
+	if len(b) != Size {
+		return Nil, fmt.Errorf("%w: %d bytes", ErrInvalid, len(b))
+	}
+	copy(u[:], b)
+	return u, nil
+}
+
+// Valid reports whether `s` parses as a UUID.
+func Valid(s string) bool {
+	_, err := Parse(s)
+	return err == nil
+}
+
+// String returns the canonical form of `u`, in lowercase.
+func (u UUID) String() string {
+	var buf [36]byte
+	hex.Encode(buf[0:8], u[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], u[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], u[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], u[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:], u[10:])
+	return string(buf[:])
+}
+
+// Bytes returns the 16 bytes of `u`.
+func (u UUID) Bytes() []byte {
+	return u[:]
+}
+
+// Version returns the version of `u`.
+func (u UUID) Version() int {
+	return int(u[6] >> 4)
+}
+
+// IsNil reports whether `u` is the nil UUID.
+func (u UUID) IsNil() bool {
+	return u == Nil
+}
+
+// Time returns the creation time of a version 7 UUID, to the millisecond,
+// and the zero time for other versions.
+func (u UUID) Time() time.Time {
+	if u.Version() != 7 {
+		return time.Time{}
+	}
+	ms := int64(u[0])<<40 | int64(binary.BigEndian.Uint32(u[1:5]))<<8 | int64(u[5])
+	return time.UnixMilli(ms)
+}
+
+// Compare returns -1, 0 or 1 as `u` sorts before, equal to or after `other`.
+func (u UUID) Compare(other UUID) int {
+	for i := range u {
+		if u[i] != other[i] {
+			if u[i] < other[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (u UUID) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (u *UUID) UnmarshalText(text []byte) error {
+	parsed, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*u = parsed
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (u UUID) MarshalBinary() ([]byte, error) {
+	return u.Bytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (u *UUID) UnmarshalBinary(data []byte) error {
+	parsed, err := FromBytes(data)
+	if err != nil {
+		return err
+	}
+	*u = parsed
+	return nil
+}
+
+// Value implements driver.Valuer, storing the canonical form.
+func (u UUID) Value() (driver.Value, error) {
+	return u.String(), nil
+}
+
+// Scan implements sql.Scanner. It accepts the string forms of Parse, 16 raw
+// bytes, and NULL, which scans as the nil UUID.
+func (u *UUID) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*u = Nil
+		return nil
+	case string:
+		return u.UnmarshalText([]byte(v))
+	case []byte:
+		if len(v) == Size {
+			return u.UnmarshalBinary(v)
+		}
+		return u.UnmarshalText(v)
+	}
+	return fmt.Errorf("uuid: cannot scan %T", src)
+}
+
+// setVersion sets the version bits of `u` and the RFC 9562 variant.
+func (u *UUID) setVersion(version byte) {
+	u[6] = u[6]&0x0f | version<<4
+	u[8] = u[8]&0x3f | 0x80
+}
@@ -0,0 +1,460 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package valid validates struct fields against rules declared in tags.
+//
+// Rules are listed in the "v" tag, separated by '|', each with optional
+// parameters after a ':' separated by ',':
+//
+//	type User struct {
+//		Name  string   `json:"name" v:"required|min:1|max:100|regex:^\\w+$"`
+//		Email string   `json:"email" v:"required|email"`
+//		Roles []string `json:"roles" v:"max:5"`
+//	}
+//
+// The parameter of regex and not-regex extends to the end of the tag, so
+// these rules come last. Nested structs, including those held by slices,
+// arrays and maps, are validated recursively, up to a depth limit. Fields
+// are named by their json tag or Go name, joined into paths such as
+// "items[2].name".
+//
+// Filters listed in the "f" tag, in the same syntax, normalize fields before
+// they are validated, when Validate is given a pointer:
+//
+//	Email string `f:"trim|lower" v:"required|email"`
+//	Limit int    `f:"default:10" v:"between:1,100"`
+//
+// Validation does not stop at the first violation: the returned *Error lists
+// all of them, and carries code.CodeValidationFailed for pkg/errors. Domain
+// rules plug into the same syntax through RegisterRule, and messages are
+// translated through SetMessages in the locale set by WithLocale.
+package valid
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// TagName is the struct tag holding the rules of a field.
+const TagName = "v"
+
+// DefaultMaxDepth is the default nesting limit of Validate.
+const DefaultMaxDepth = 32
+
+// Option configures Validate.
+type Option func(*validator)
+
+// WithMaxDepth sets the number of levels of nested structs and collections
+// walked, DefaultMaxDepth by default. Deeper values fail the validation with
+// an error rather than being left unchecked, which also stops pointer
+// cycles.
+func WithMaxDepth(n int) Option {
+	return func(v *validator) {
+		if n > 0 {
+			v.maxDepth = n
+		}
+	}
+}
+
+// Validate validates the struct `value`, which may also be a pointer to a
+// struct or a slice, array or map of structs. Given a pointer, it applies
+// the filters first, as Filter does. It returns an *Error listing the
+// violations, nil if there are none, or another error if a rule is
+// malformed or unknown.
+func Validate(value interface{}, opts ...Option) error {
+	return ValidateContext(context.Background(), value, opts...)
+}
+
+// ValidateContext is like Validate, passing `ctx` to custom rules and
+// writing messages in the locale of `ctx`.
+func ValidateContext(ctx context.Context, value interface{}, opts ...Option) error {
+	v := &validator{ctx: ctx, locale: Locale(ctx), maxDepth: DefaultMaxDepth}
+	for _, opt := range opts {
+		opt(v)
+	}
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.Pointer {
+		if err := v.filter("", rv, 0); err != nil {
+			return err
+		}
+	}
+	v.walk("", rv, 0)
+	return v.result()
+}
+
+// ruleSpec is a parsed rule.
+type ruleSpec struct {
+	name   string
+	params []string
+
+	// re is the compiled parameter of regex and not-regex.
+	re *regexp.Regexp
+}
+
+// fieldSpec describes a field of a struct type.
+type fieldSpec struct {
+	// index is the index sequence for reflect.Value.FieldByIndex.
+	index []int
+
+	// name is the json or Go name of the field.
+	name string
+
+	// rules holds the rules of the field and filters its filters.
+	rules   []ruleSpec
+	filters []ruleSpec
+}
+
+// typeSpec is the cached description of a struct type.
+type typeSpec struct {
+	fields []fieldSpec
+	err    error
+}
+
+// typeCache caches *typeSpec by reflect.Type.
+var typeCache sync.Map
+
+// cachedType returns the description of the struct type `t`.
+func cachedType(t reflect.Type) *typeSpec {
+	if spec, ok := typeCache.Load(t); ok {
+		return spec.(*typeSpec)
+	}
+	spec := &typeSpec{}
+	spec.fields, spec.err = typeFields(t, nil)
+	actual, _ := typeCache.LoadOrStore(t, spec)
+	return actual.(*typeSpec)
+}
+
+// typeFields returns the exported fields of the struct type `t`, promoting
+// the fields of untagged embedded structs, with `index` prefixing their
+// index sequences.
+func typeFields(t reflect.Type, index []int) ([]fieldSpec, error) {
+	var fields []fieldSpec
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() && !f.Anonymous {
+			continue
+		}
+		fieldIndex := append(append([]int(nil), index...), i)
+		tag, tagged := f.Tag.Lookup(TagName)
+		if tag == "-" {
+			continue
+		}
+		ft := f.Type
+		if ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+		if f.Anonymous && !tagged && ft.Kind() == reflect.Struct {
+			embedded, err := typeFields(ft, fieldIndex)
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, embedded...)
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		rules, err := parseRules(tag)
+		if err != nil {
+			return nil, fmt.Errorf("valid: field %s of %s: %w", f.Name, t, err)
+		}
+		filters, err := parseRules(f.Tag.Get(FilterTagName))
+		if err != nil {
+			return nil, fmt.Errorf("valid: field %s of %s: %w", f.Name, t, err)
+		}
+		fields = append(fields, fieldSpec{index: fieldIndex, name: fieldName(f), rules: rules, filters: filters})
+	}
+	return fields, nil
+}
+
+// fieldName returns the json name of `f`, or its Go name.
+func fieldName(f reflect.StructField) string {
+	if tag, ok := f.Tag.Lookup("json"); ok {
+		if name, _, _ := strings.Cut(tag, ","); name != "" && name != "-" {
+			return name
+		}
+	}
+	return f.Name
+}
+
+// parseRules parses the rules of a tag.
+func parseRules(tag string) ([]ruleSpec, error) {
+	var rules []ruleSpec
+	for tag != "" {
+		var item string
+		name, _, _ := strings.Cut(tag, ":")
+		name = strings.TrimSpace(name)
+		if (name == "regex" || name == "not-regex") && strings.Contains(tag, ":") {
+			item, tag = tag, ""
+		} else {
+			item, tag, _ = strings.Cut(tag, "|")
+		}
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		name, param, hasParam := strings.Cut(item, ":")
+		spec := ruleSpec{name: strings.TrimSpace(name)}
+		switch {
+		case spec.name == "regex" || spec.name == "not-regex":
+			re, err := regexp.Compile(param)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s rule: %w", spec.name, err)
+			}
+			spec.params, spec.re = []string{param}, re
+		case hasParam:
+			spec.params = strings.Split(param, ",")
+			for i, p := range spec.params {
+				spec.params[i] = strings.TrimSpace(p)
+			}
+		}
+		rules = append(rules, spec)
+	}
+	return rules, nil
+}
+
+// validator collects the violations of a validation.
+type validator struct {
+	// ctx is passed to custom rules.
+	ctx context.Context
+
+	// locale selects the messages.
+	locale string
+
+	// maxDepth is the nesting limit.
+	maxDepth int
+
+	// errs holds the violations.
+	errs []*FieldError
+
+	// err is the first malformed rule.
+	err error
+}
+
+// result returns the outcome of the validation.
+func (v *validator) result() error {
+	if v.err != nil {
+		return v.err
+	}
+	if len(v.errs) > 0 {
+		return &Error{fields: v.errs}
+	}
+	return nil
+}
+
+// walk validates the structs held by `rv`, at the path `path` and nesting
+// level `depth`. The elements of maps are walked in the order of their keys.
+func (v *validator) walk(path string, rv reflect.Value, depth int) {
+	rv = indirect(rv)
+	if !rv.IsValid() || v.err != nil {
+		return
+	}
+	kind := rv.Kind()
+	if kind != reflect.Struct && kind != reflect.Slice && kind != reflect.Array && kind != reflect.Map {
+		return
+	}
+	if depth >= v.maxDepth {
+		v.err = fmt.Errorf("valid: %s exceeds the maximum depth of %d", displayPath(path), v.maxDepth)
+		return
+	}
+	switch kind {
+	case reflect.Struct:
+		spec := cachedType(rv.Type())
+		if spec.err != nil {
+			v.err = spec.err
+			return
+		}
+		for _, f := range spec.fields {
+			fv, err := rv.FieldByIndexErr(f.index)
+			if err != nil {
+				// Below a nil embedded pointer
+				continue
+			}
+			fpath := joinPath(path, f.name)
+			v.check(fpath, rv, fv, f.rules)
+			v.walk(fpath, fv, depth+1)
+		}
+	case reflect.Slice, reflect.Array:
+		if !holdsStructs(rv.Type().Elem()) {
+			return
+		}
+		for i := 0; i < rv.Len(); i++ {
+			v.walk(fmt.Sprintf("%s[%d]", path, i), rv.Index(i), depth+1)
+		}
+	case reflect.Map:
+		if !holdsStructs(rv.Type().Elem()) {
+			return
+		}
+		type entry struct {
+			name  string
+			value reflect.Value
+		}
+		entries := make([]entry, 0, rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			entries = append(entries, entry{fmt.Sprint(iter.Key()), iter.Value()})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].name < entries[j].name
+		})
+		for _, e := range entries {
+			v.walk(fmt.Sprintf("%s[%s]", path, e.name), e.value, depth+1)
+		}
+	}
+}
+
+// check validates the field `rv` of the struct `parent`, at the path `path`,
+// against `rules`. Built-in rules other than required and its conditional
+// variants are skipped for absent values: nil pointers and empty strings,
+// slices and maps.
+func (v *validator) check(path string, parent, rv reflect.Value, rules []ruleSpec) {
+	if len(rules) == 0 {
+		return
+	}
+	// Rule names are checked first, so that a typo is reported even when
+	// the rule would be skipped
+	for _, r := range rules {
+		if !knownRule(r.name) {
+			v.err = fmt.Errorf("valid: unknown rule %q on %s", r.name, path)
+			return
+		}
+	}
+	rv = indirect(rv)
+	absent := isAbsent(rv)
+	var value interface{}
+	if rv.IsValid() {
+		value = rv.Interface()
+	}
+	for _, r := range rules {
+		if r.name == "required" {
+			if absent || rv.IsZero() {
+				v.fail(path, r, value, "required")
+				return
+			}
+			continue
+		}
+		in := RuleInput{Rule: r.name, Params: r.params, Field: path, Value: value, parent: parent}
+		if parent.IsValid() {
+			in.Struct = parent.Interface()
+		}
+		if custom := lookupRule(r.name); custom != nil {
+			if err := custom(v.ctx, in); err != nil {
+				message := err.Error()
+				if template, ok := lookupMessage(v.locale, r.name); ok {
+					message = expandMessage(template, path, value, r.params)
+				}
+				v.errs = append(v.errs, &FieldError{
+					Field:   path,
+					Rule:    r.name,
+					Params:  r.params,
+					Value:   value,
+					Message: message,
+				})
+			}
+			continue
+		}
+		if fn, ok := crossRules[r.name]; ok {
+			key, err := fn(rv, r, in)
+			if err != nil {
+				v.err = fmt.Errorf("valid: rule %q on %s: %w", r.name, path, err)
+				return
+			}
+			if key != "" {
+				v.fail(path, r, value, key)
+			}
+			continue
+		}
+		if absent {
+			continue
+		}
+		key, err := builtinRules[r.name](rv, r)
+		if err != nil {
+			v.err = fmt.Errorf("valid: rule %q on %s: %w", r.name, path, err)
+			return
+		}
+		if key != "" {
+			v.fail(path, r, value, key)
+		}
+	}
+}
+
+// knownRule reports whether `name` is a built-in, cross-field or registered
+// rule.
+func knownRule(name string) bool {
+	if name == "required" || lookupRule(name) != nil {
+		return true
+	}
+	_, cross := crossRules[name]
+	_, builtin := builtinRules[name]
+	return cross || builtin
+}
+
+// fail records a violation of `r`, described by the message `key`.
+func (v *validator) fail(path string, r ruleSpec, value interface{}, key string) {
+	v.errs = append(v.errs, &FieldError{
+		Field:   path,
+		Rule:    r.name,
+		Params:  r.params,
+		Value:   value,
+		Message: formatMessage(v.locale, key, path, value, r.params),
+	})
+}
+
+// indirect dereferences the pointers and interfaces of `rv`, returning the
+// zero Value for nil.
+func indirect(rv reflect.Value) reflect.Value {
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return reflect.Value{}
+		}
+		rv = rv.Elem()
+	}
+	return rv
+}
+
+// isAbsent reports whether `rv` is nil or an empty string, slice or map.
+func isAbsent(rv reflect.Value) bool {
+	switch rv.Kind() {
+	case reflect.Invalid:
+		return true
+	case reflect.String, reflect.Slice, reflect.Map:
+		return rv.Len() == 0
+	}
+	return false
+}
+
+// holdsStructs reports whether values of `t` may hold structs to walk.
+func holdsStructs(t reflect.Type) bool {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Struct, reflect.Interface:
+		return true
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return holdsStructs(t.Elem())
+	}
+	return false
+}
+
+// displayPath returns `path` for messages, naming the root value.
+func displayPath(path string) string {
+	if path == "" {
+		return "the value"
+	}
+	return path
+}
+
+// joinPath appends the field `name` to `path`.
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package valid
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// CheckValue validates `value` against `rules`, in the syntax of the "v"
+// tag, naming it `name` in messages, "value" by default.
+func CheckValue(value interface{}, rules string, name ...string) error {
+	return CheckValueContext(context.Background(), value, rules, name...)
+}
+
+// CheckValueContext is like CheckValue, passing `ctx` to custom rules and
+// writing messages in the locale of `ctx`.
+func CheckValueContext(ctx context.Context, value interface{}, rules string, name ...string) error {
+	specs, err := parseRules(rules)
+	if err != nil {
+		return fmt.Errorf("valid: %w", err)
+	}
+	field := "value"
+	if len(name) > 0 {
+		field = name[0]
+	}
+	v := &validator{ctx: ctx, locale: Locale(ctx)}
+	v.check(field, reflect.Value{}, reflect.ValueOf(value), specs)
+	return v.result()
+}
+
+// CheckMap validates the values of `data`, such as query parameters or
+// decoded JSON objects, against `rules`, which holds the rules of each key
+// in the syntax of the "v" tag. Keys may be dotted paths into nested maps,
+// such as "address.city"; missing keys are absent values. Values keep their
+// type, so a string is measured by its length: numeric parameters are
+// checked with numeric or integer, or converted before min and max.
+//
+// Keys are checked in sorted order, and all violations are returned.
+func CheckMap(data map[string]interface{}, rules map[string]string) error {
+	return CheckMapContext(context.Background(), data, rules)
+}
+
+// CheckMapContext is like CheckMap, passing `ctx` to custom rules and
+// writing messages in the locale of `ctx`.
+func CheckMapContext(ctx context.Context, data map[string]interface{}, rules map[string]string) error {
+	v := &validator{ctx: ctx, locale: Locale(ctx)}
+	for _, key := range slices.Sorted(maps.Keys(rules)) {
+		specs, err := parseRules(rules[key])
+		if err != nil {
+			return fmt.Errorf("valid: key %s: %w", key, err)
+		}
+		parent, value := lookupPath(data, key)
+		v.check(key, reflect.ValueOf(parent), reflect.ValueOf(value), specs)
+		if v.err != nil {
+			break
+		}
+	}
+	return v.result()
+}
+
+// lookupPath returns the value of the dotted path `key` in `data`, with the
+// map holding it. A key holding dots itself is matched first.
+func lookupPath(data map[string]interface{}, key string) (parent map[string]interface{}, value interface{}) {
+	parent = data
+	for {
+		if value, ok := parent[key]; ok {
+			return parent, value
+		}
+		head, rest, ok := strings.Cut(key, ".")
+		if !ok {
+			return parent, nil
+		}
+		child, isMap := parent[head].(map[string]interface{})
+		if !isMap {
+			return nil, nil
+		}
+		parent, key = child, rest
+	}
+}
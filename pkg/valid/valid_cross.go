@@ -0,0 +1,156 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package valid
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+)
+
+// crossFunc checks a value against a rule involving the sibling fields of
+// `in`. It returns the key of the message describing a violation, or "" if
+// the value is valid.
+type crossFunc func(rv reflect.Value, r ruleSpec, in RuleInput) (string, error)
+
+// crossRules holds the built-in rules comparing fields, by name. Their
+// parameters name sibling fields by json or Go name, or map keys.
+//
+// The required variants make a field required depending on other fields:
+//
+//	required-if:Field,value...     when Field equals one of the values
+//	required-unless:Field,value... unless Field equals one of the values
+//	required-with:Field...         when one of the fields is present
+//	required-without:Field...      when one of the fields is absent
+//
+// The comparisons eq-field, ne-field, gt-field, gte-field, lt-field and
+// lte-field compare the value with the field named by their parameter:
+// numbers by value, strings lexically and times chronologically. Like other
+// built-in rules, they are skipped for absent values.
+var crossRules = map[string]crossFunc{
+	"required-if":      requiredRule(func(in RuleInput, p []string) (bool, error) { return fieldIn(in, p) }),
+	"required-unless":  requiredRule(func(in RuleInput, p []string) (bool, error) { ok, err := fieldIn(in, p); return !ok, err }),
+	"required-with":    requiredRule(func(in RuleInput, p []string) (bool, error) { return anyPresent(in, p, true) }),
+	"required-without": requiredRule(func(in RuleInput, p []string) (bool, error) { return anyPresent(in, p, false) }),
+	"eq-field":         compareFieldRule(func(c int) bool { return c == 0 }),
+	"ne-field":         compareFieldRule(func(c int) bool { return c != 0 }),
+	"gt-field":         compareFieldRule(func(c int) bool { return c > 0 }),
+	"gte-field":        compareFieldRule(func(c int) bool { return c >= 0 }),
+	"lt-field":         compareFieldRule(func(c int) bool { return c < 0 }),
+	"lte-field":        compareFieldRule(func(c int) bool { return c <= 0 }),
+}
+
+// requiredRule returns a rule requiring the value when `when` reports so.
+func requiredRule(when func(in RuleInput, params []string) (bool, error)) crossFunc {
+	return func(rv reflect.Value, r ruleSpec, in RuleInput) (string, error) {
+		if len(r.params) == 0 {
+			return "", errors.New("missing field")
+		}
+		required, err := when(in, r.params)
+		if err != nil || !required || isPresent(rv) {
+			return "", err
+		}
+		return r.name, nil
+	}
+}
+
+// compareFieldRule returns a rule comparing the value with a field, valid
+// if `ok` accepts the result of the comparison.
+func compareFieldRule(ok func(c int) bool) crossFunc {
+	return func(rv reflect.Value, r ruleSpec, in RuleInput) (string, error) {
+		if len(r.params) != 1 {
+			return "", fmt.Errorf("want 1 field, got %d", len(r.params))
+		}
+		if isAbsent(rv) {
+			return "", nil
+		}
+		other, err := lookupField(in, r.params[0])
+		if err != nil {
+			return "", err
+		}
+		if !other.IsValid() {
+			return r.name, nil
+		}
+		c, err := compareValues(rv, other)
+		if err != nil {
+			return "", err
+		}
+		if !ok(c) {
+			return r.name, nil
+		}
+		return "", nil
+	}
+}
+
+// fieldIn reports whether the field named by params[0] has the string form
+// of one of the other parameters.
+func fieldIn(in RuleInput, params []string) (bool, error) {
+	if len(params) < 2 {
+		return false, fmt.Errorf("want a field and values, got %d parameters", len(params))
+	}
+	other, err := lookupField(in, params[0])
+	if err != nil || !other.IsValid() {
+		return false, err
+	}
+	return slices.Contains(params[1:], toString(other)), nil
+}
+
+// anyPresent reports whether one of the fields named by `params` is present,
+// or absent if `present` is false.
+func anyPresent(in RuleInput, params []string, present bool) (bool, error) {
+	for _, name := range params {
+		other, err := lookupField(in, name)
+		if err != nil {
+			return false, err
+		}
+		if isPresent(other) == present {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// lookupField returns the sibling field `name` of `in`, dereferenced, or an
+// error if it does not exist. The zero Value stands for nil. Map keys always
+// exist, missing ones being absent.
+func lookupField(in RuleInput, name string) (reflect.Value, error) {
+	value, ok := in.Lookup(name)
+	if !ok && in.parent.Kind() != reflect.Map {
+		return reflect.Value{}, fmt.Errorf("unknown field %q", name)
+	}
+	return indirect(reflect.ValueOf(value)), nil
+}
+
+// isPresent reports whether `rv` is set: neither absent nor the zero value.
+func isPresent(rv reflect.Value) bool {
+	return !isAbsent(rv) && !rv.IsZero()
+}
+
+// compareValues compares `a` and `b`, returning -1, 0 or +1.
+func compareValues(a, b reflect.Value) (int, error) {
+	if ta, ok := a.Interface().(time.Time); ok {
+		if tb, ok := b.Interface().(time.Time); ok {
+			return ta.Compare(tb), nil
+		}
+	}
+	if a.Kind() == reflect.String && b.Kind() == reflect.String {
+		return strings.Compare(a.String(), b.String()), nil
+	}
+	fa, aLen, errA := measure(a)
+	fb, bLen, errB := measure(b)
+	if errA != nil || errB != nil || aLen || bLen {
+		return 0, fmt.Errorf("cannot compare %s with %s", a.Type(), b.Type())
+	}
+	switch {
+	case fa < fb:
+		return -1, nil
+	case fa > fb:
+		return 1, nil
+	}
+	return 0, nil
+}
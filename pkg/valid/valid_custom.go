@@ -0,0 +1,97 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package valid
+
+import (
+	"context"
+	"reflect"
+	"sync"
+)
+
+// RuleFunc is a custom rule. It returns an error describing the violation,
+// whose text becomes the message, or nil if the value is valid.
+type RuleFunc func(ctx context.Context, in RuleInput) error
+
+// RuleInput is the field checked by a RuleFunc.
+type RuleInput struct {
+	// Rule is the name of the rule and Params its parameters.
+	Rule   string
+	Params []string
+
+	// Field is the path of the field.
+	Field string
+
+	// Value is the value of the field with pointers dereferenced, nil for a
+	// nil pointer.
+	Value interface{}
+
+	// Struct is the struct holding the field, the map holding it for
+	// CheckMap, or nil for CheckValue.
+	Struct interface{}
+
+	// parent is the reflected Struct.
+	parent reflect.Value
+}
+
+// Lookup returns the value of the field of Struct named `name`, by json or
+// Go name, or of its key `name` for a map, with pointers dereferenced,
+// reporting whether it exists. It suits rules comparing fields, such as
+// "same:password".
+func (in RuleInput) Lookup(name string) (interface{}, bool) {
+	if !in.parent.IsValid() {
+		return nil, false
+	}
+	if in.parent.Kind() == reflect.Map {
+		m, ok := in.parent.Interface().(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		value, ok := m[name]
+		if rv := indirect(reflect.ValueOf(value)); rv.IsValid() {
+			return rv.Interface(), ok
+		}
+		return nil, ok
+	}
+	t := in.parent.Type()
+	for _, f := range cachedType(t).fields {
+		if f.name != name && t.FieldByIndex(f.index).Name != name {
+			continue
+		}
+		fv, err := in.parent.FieldByIndexErr(f.index)
+		if err != nil {
+			return nil, true
+		}
+		if fv = indirect(fv); !fv.IsValid() {
+			return nil, true
+		}
+		return fv.Interface(), true
+	}
+	return nil, false
+}
+
+// customRules holds the registered rules by name.
+var customRules = struct {
+	sync.RWMutex
+	rules map[string]RuleFunc
+}{
+	rules: make(map[string]RuleFunc),
+}
+
+// RegisterRule makes `fn` available in tags as the rule `name`, replacing
+// any rule of the same name except required. Unlike built-in rules, custom
+// rules are also called for absent values, so they can implement
+// conditional requirements.
+func RegisterRule(name string, fn RuleFunc) {
+	customRules.Lock()
+	defer customRules.Unlock()
+	customRules.rules[name] = fn
+}
+
+// lookupRule returns the custom rule named `name`, or nil.
+func lookupRule(name string) RuleFunc {
+	customRules.RLock()
+	defer customRules.RUnlock()
+	return customRules.rules[name]
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package valid
+
+import (
+	"strings"
+
+	"github.com/focela/aegis/pkg/errors"
+	"github.com/focela/aegis/pkg/errors/code"
+)
+
+// FieldError is a violation of a rule by a field.
+type FieldError struct {
+	// Field is the path of the field, such as "items[2].name".
+	Field string `json:"field"`
+
+	// Rule is the name of the violated rule and Params its parameters.
+	Rule   string   `json:"rule"`
+	Params []string `json:"params,omitempty"`
+
+	// Value is the value of the field. It is left out of JSON, as it may
+	// hold secrets such as passwords.
+	Value interface{} `json:"-"`
+
+	// Message describes the violation.
+	Message string `json:"message"`
+}
+
+// Error returns the message of the violation.
+func (e *FieldError) Error() string {
+	return e.Message
+}
+
+// Error is the failure of a validation, listing its violations in field
+// order. Its code is code.CodeValidationFailed with the violations as
+// detail, so it encodes to JSON like the errors of pkg/errors:
+//
+//	{"code":51,"message":"name is required","detail":[{"field":"name","rule":"required","message":"name is required"}]}
+type Error struct {
+	// fields holds the violations.
+	fields []*FieldError
+}
+
+// Error returns the messages of the violations separated by "; ".
+func (e *Error) Error() string {
+	messages := make([]string, len(e.fields))
+	for i, fe := range e.fields {
+		messages[i] = fe.Message
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Code returns code.CodeValidationFailed with the violations as detail.
+func (e *Error) Code() code.Code {
+	return code.WithDetail(code.CodeValidationFailed, e.fields)
+}
+
+// Fields returns the violations.
+func (e *Error) Fields() []*FieldError {
+	return e.fields
+}
+
+// Field returns the violations of the field at the path `path`.
+func (e *Error) Field(path string) []*FieldError {
+	var fields []*FieldError
+	for _, fe := range e.fields {
+		if fe.Field == path {
+			fields = append(fields, fe)
+		}
+	}
+	return fields
+}
+
+// Map returns the messages of the violations by field path.
+func (e *Error) Map() map[string][]string {
+	m := make(map[string][]string, len(e.fields))
+	for _, fe := range e.fields {
+		m[fe.Field] = append(m[fe.Field], fe.Message)
+	}
+	return m
+}
+
+// Unwrap returns the violations, so errors.As finds a *FieldError.
+func (e *Error) Unwrap() []error {
+	errs := make([]error, len(e.fields))
+	for i, fe := range e.fields {
+		errs[i] = fe
+	}
+	return errs
+}
+
+// MarshalJSON encodes the error as errors.MarshalJSON does.
+func (e *Error) MarshalJSON() ([]byte, error) {
+	return errors.MarshalJSON(e)
+}
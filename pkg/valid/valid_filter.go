@@ -0,0 +1,197 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package valid
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/focela/aegis/pkg/conv"
+)
+
+// FilterTagName is the struct tag holding the filters of a field.
+const FilterTagName = "f"
+
+// filterFunc modifies the settable field `rv` according to a filter.
+type filterFunc func(rv reflect.Value, params []string) error
+
+// filters holds the filters by name. String filters apply to strings and to
+// the elements of string slices:
+//
+//	trim[:cutset]   removes leading and trailing spaces, or runes of cutset
+//	trim-left       removes leading spaces
+//	trim-right      removes trailing spaces
+//	lower, upper    changes the case
+//	collapse        trims and replaces runs of spaces with a single space
+//	truncate:n      keeps the first n runes
+//
+// default:value sets a zero field to value, converted with pkg/conv.
+var filters = map[string]filterFunc{
+	"trim": stringFilter(func(s string, p []string) (string, error) {
+		if len(p) > 0 {
+			return strings.Trim(s, strings.Join(p, ",")), nil
+		}
+		return strings.TrimSpace(s), nil
+	}),
+	"trim-left": stringFilter(func(s string, _ []string) (string, error) {
+		return strings.TrimLeftFunc(s, unicode.IsSpace), nil
+	}),
+	"trim-right": stringFilter(func(s string, _ []string) (string, error) {
+		return strings.TrimRightFunc(s, unicode.IsSpace), nil
+	}),
+	"lower": stringFilter(func(s string, _ []string) (string, error) {
+		return strings.ToLower(s), nil
+	}),
+	"upper": stringFilter(func(s string, _ []string) (string, error) {
+		return strings.ToUpper(s), nil
+	}),
+	"collapse": stringFilter(func(s string, _ []string) (string, error) {
+		return strings.Join(strings.Fields(s), " "), nil
+	}),
+	"truncate": stringFilter(func(s string, p []string) (string, error) {
+		if len(p) != 1 {
+			return "", errors.New("want 1 parameter")
+		}
+		n, err := strconv.Atoi(p[0])
+		if err != nil || n < 0 {
+			return "", fmt.Errorf("invalid parameter %q", p[0])
+		}
+		if runes := []rune(s); len(runes) > n {
+			return string(runes[:n]), nil
+		}
+		return s, nil
+	}),
+	"default": defaultFilter,
+}
+
+// Filter applies the filters of the "f" tags of the struct pointed to by
+// `pointer`, and of the structs it holds, in tag order. It returns an error
+// if a filter is unknown, malformed or applied to a field of the wrong type.
+func Filter(pointer interface{}, opts ...Option) error {
+	rv := reflect.ValueOf(pointer)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return fmt.Errorf("valid: Filter needs a non-nil pointer, got %T", pointer)
+	}
+	v := &validator{maxDepth: DefaultMaxDepth}
+	for _, opt := range opts {
+		opt(v)
+	}
+	return v.filter("", rv, 0)
+}
+
+// filter applies the filters of the settable structs held by `rv`, at the
+// path `path` and nesting level `depth`. Values that cannot be set, such as
+// the struct values of maps, are left unchanged.
+func (v *validator) filter(path string, rv reflect.Value, depth int) error {
+	rv = indirect(rv)
+	if !rv.IsValid() || depth >= v.maxDepth {
+		// Validation reports the depth overflow
+		return nil
+	}
+	switch rv.Kind() {
+	case reflect.Struct:
+		spec := cachedType(rv.Type())
+		if spec.err != nil {
+			return spec.err
+		}
+		for _, f := range spec.fields {
+			fv, err := rv.FieldByIndexErr(f.index)
+			if err != nil {
+				continue
+			}
+			fpath := joinPath(path, f.name)
+			if fv.CanSet() {
+				for _, r := range f.filters {
+					fn, ok := filters[r.name]
+					if !ok {
+						return fmt.Errorf("valid: unknown filter %q on %s", r.name, fpath)
+					}
+					if err := fn(fv, r.params); err != nil {
+						return fmt.Errorf("valid: filter %q on %s: %w", r.name, fpath, err)
+					}
+				}
+			}
+			if err := v.filter(fpath, fv, depth+1); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		if !holdsStructs(rv.Type().Elem()) {
+			return nil
+		}
+		for i := 0; i < rv.Len(); i++ {
+			if err := v.filter(fmt.Sprintf("%s[%d]", path, i), rv.Index(i), depth+1); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		if !holdsStructs(rv.Type().Elem()) {
+			return nil
+		}
+		iter := rv.MapRange()
+		for iter.Next() {
+			if err := v.filter(fmt.Sprintf("%s[%v]", path, iter.Key()), iter.Value(), depth+1); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// stringFilter returns a filter applying `fn` to a string field, through
+// pointers, or to the elements of a string slice.
+func stringFilter(fn func(s string, params []string) (string, error)) filterFunc {
+	return func(rv reflect.Value, params []string) error {
+		rv = indirect(rv)
+		switch {
+		case !rv.IsValid():
+			return nil
+		case rv.Kind() == reflect.String:
+			s, err := fn(rv.String(), params)
+			if err != nil {
+				return err
+			}
+			rv.SetString(s)
+			return nil
+		case (rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array) && rv.Type().Elem().Kind() == reflect.String:
+			for i := 0; i < rv.Len(); i++ {
+				s, err := fn(rv.Index(i).String(), params)
+				if err != nil {
+					return err
+				}
+				rv.Index(i).SetString(s)
+			}
+			return nil
+		}
+		return fmt.Errorf("cannot apply to %s", rv.Type())
+	}
+}
+
+// defaultFilter sets a zero field to the value of the parameters, joined
+// with ',' so that defaults may hold commas.
+func defaultFilter(rv reflect.Value, params []string) error {
+	if !rv.IsZero() {
+		return nil
+	}
+	holder := reflect.New(reflect.StructOf([]reflect.StructField{{
+		Name: "V",
+		Type: rv.Type(),
+		Tag:  `conv:"v"`,
+	}}))
+	value := strings.Join(params, ",")
+	if err := conv.Struct(map[string]interface{}{"v": value}, holder.Interface()); err != nil {
+		// Drop the mention of the holder field
+		if cause := errors.Unwrap(err); cause != nil {
+			err = cause
+		}
+		return fmt.Errorf("invalid default %q: %w", value, err)
+	}
+	rv.Set(holder.Elem().Field(0))
+	return nil
+}
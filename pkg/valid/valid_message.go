@@ -0,0 +1,160 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package valid
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// defaultMessages holds the templates of DefaultLocale by rule, with
+// variants for lengths keyed by the rule name suffixed with ".len".
+var defaultMessages = map[string]string{
+	"required":    "{field} is required",
+	"min":         "{field} must be at least {0}",
+	"min.len":     "{field} must have a length of at least {0}",
+	"max":         "{field} must be at most {0}",
+	"max.len":     "{field} must have a length of at most {0}",
+	"between":     "{field} must be between {0} and {1}",
+	"between.len": "{field} must have a length between {0} and {1}",
+	"len":         "{field} must have a length of {0}",
+	"in":          "{field} must be one of {params}",
+	"not-in":      "{field} must not be one of {params}",
+	"email":       "{field} must be a valid email address",
+	"url":         "{field} must be a valid URL",
+	"ip":          "{field} must be a valid IP address",
+	"ipv4":        "{field} must be a valid IPv4 address",
+	"ipv6":        "{field} must be a valid IPv6 address",
+	"uuid":        "{field} must be a valid UUID",
+	"alpha":       "{field} must contain only letters",
+	"alphanum":    "{field} must contain only letters and digits",
+	"numeric":     "{field} must be a number",
+	"integer":     "{field} must be an integer",
+	"regex":       "{field} has an invalid format",
+	"not-regex":   "{field} has an invalid format",
+
+	"required-if":      "{field} is required given the value of {0}",
+	"required-unless":  "{field} is required given the value of {0}",
+	"required-with":    "{field} is required when {params} is present",
+	"required-without": "{field} is required when {params} is absent",
+	"eq-field":         "{field} must be equal to {0}",
+	"ne-field":         "{field} must differ from {0}",
+	"gt-field":         "{field} must be greater than {0}",
+	"gte-field":        "{field} must be greater than or equal to {0}",
+	"lt-field":         "{field} must be less than {0}",
+	"lte-field":        "{field} must be less than or equal to {0}",
+}
+
+// DefaultLocale is the locale of the built-in messages, used when the
+// locale of the context has no template for a rule.
+const DefaultLocale = "en"
+
+// defaultMessage is the template of rules without one.
+const defaultMessage = "{field} is invalid"
+
+// messages holds the templates by locale, then by rule.
+var messages = struct {
+	sync.RWMutex
+	locales map[string]map[string]string
+}{
+	locales: map[string]map[string]string{DefaultLocale: defaultMessages},
+}
+
+// localeKey is the context key of the locale.
+type localeKey struct{}
+
+// SetMessages adds the message `templates` of `locale`, such as "fr" or
+// "pt-BR", by rule name, replacing existing ones. Templates may use the
+// placeholders {field}, {value}, {params} and {0}, {1}… for the rule
+// parameters; the length variants of min, max and between are keyed
+// "min.len", "max.len" and "between.len". A template for a custom rule
+// replaces the text of the error it returns.
+func SetMessages(locale string, templates map[string]string) {
+	locale = normalizeLocale(locale)
+	messages.Lock()
+	defer messages.Unlock()
+	m := make(map[string]string, len(messages.locales[locale])+len(templates))
+	for rule, template := range messages.locales[locale] {
+		m[rule] = template
+	}
+	for rule, template := range templates {
+		m[rule] = template
+	}
+	messages.locales[locale] = m
+}
+
+// WithLocale returns a copy of `ctx` selecting the messages of `locale` for
+// ValidateContext, CheckValueContext and CheckMapContext.
+func WithLocale(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, localeKey{}, locale)
+}
+
+// Locale returns the locale selected in `ctx`, or DefaultLocale.
+func Locale(ctx context.Context) string {
+	if locale, ok := ctx.Value(localeKey{}).(string); ok && locale != "" {
+		return locale
+	}
+	return DefaultLocale
+}
+
+// lookupMessage returns the template of the rule `key` in `locale`, then in
+// its base language, then in DefaultLocale. Length variants fall back to the
+// template of their rule.
+func lookupMessage(locale, key string) (string, bool) {
+	locale = normalizeLocale(locale)
+	candidates := []string{locale}
+	if base, _, ok := strings.Cut(locale, "-"); ok {
+		candidates = append(candidates, base)
+	}
+	candidates = append(candidates, DefaultLocale)
+	messages.RLock()
+	defer messages.RUnlock()
+	for _, candidate := range candidates {
+		m := messages.locales[candidate]
+		if template, ok := m[key]; ok {
+			return template, true
+		}
+		if rule, ok := strings.CutSuffix(key, ".len"); ok {
+			if template, ok := m[rule]; ok {
+				return template, true
+			}
+		}
+	}
+	return "", false
+}
+
+// normalizeLocale returns `locale` lower-cased with '-' separators.
+func normalizeLocale(locale string) string {
+	return strings.ToLower(strings.ReplaceAll(locale, "_", "-"))
+}
+
+// formatMessage returns the message of the rule `key` in `locale` for the
+// field `field` of value `value` and rule parameters `params`.
+func formatMessage(locale, key, field string, value interface{}, params []string) string {
+	template, ok := lookupMessage(locale, key)
+	if !ok {
+		template = defaultMessage
+	}
+	return expandMessage(template, field, value, params)
+}
+
+// expandMessage replaces the placeholders of `template`.
+func expandMessage(template, field string, value interface{}, params []string) string {
+	var text string
+	if value != nil {
+		text = fmt.Sprint(value)
+	}
+	args := []string{
+		"{field}", field,
+		"{value}", text,
+		"{params}", strings.Join(params, ", "),
+	}
+	for i, p := range params {
+		args = append(args, fmt.Sprintf("{%d}", i), p)
+	}
+	return strings.NewReplacer(args...).Replace(template)
+}
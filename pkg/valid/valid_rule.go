@@ -0,0 +1,194 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package valid
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"unicode"
+	"unicode/utf8"
+)
+
+// builtinFunc checks a value against a rule. It returns the key of the
+// message describing a violation, or "" if the value is valid.
+type builtinFunc func(rv reflect.Value, r ruleSpec) (string, error)
+
+// builtinRules holds the built-in rules by name.
+//
+// min, max, between and len compare numbers by value and strings, slices,
+// arrays and maps by length, strings counting runes.
+var builtinRules = map[string]builtinFunc{
+	"min":       compareRule(1, func(n float64, p []float64) bool { return n >= p[0] }),
+	"max":       compareRule(1, func(n float64, p []float64) bool { return n <= p[0] }),
+	"between":   compareRule(2, func(n float64, p []float64) bool { return n >= p[0] && n <= p[1] }),
+	"len":       lenRule,
+	"in":        inRule(true),
+	"not-in":    inRule(false),
+	"email":     stringRule(isEmail),
+	"url":       stringRule(isURL),
+	"ip":        stringRule(func(s string) bool { return net.ParseIP(s) != nil }),
+	"ipv4":      stringRule(func(s string) bool { ip := net.ParseIP(s); return ip != nil && ip.To4() != nil }),
+	"ipv6":      stringRule(func(s string) bool { ip := net.ParseIP(s); return ip != nil && ip.To4() == nil }),
+	"uuid":      stringRule(uuidPattern.MatchString),
+	"alpha":     stringRule(allRunes(unicode.IsLetter)),
+	"alphanum":  stringRule(allRunes(func(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) })),
+	"numeric":   stringRule(func(s string) bool { _, err := strconv.ParseFloat(s, 64); return err == nil }),
+	"integer":   stringRule(func(s string) bool { _, err := strconv.ParseInt(s, 10, 64); return err == nil }),
+	"regex":     regexRule(true),
+	"not-regex": regexRule(false),
+}
+
+// uuidPattern matches UUIDs in their canonical form.
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// compareRule returns a rule comparing the number or length of a value with
+// `n` numeric parameters through `ok`. Lengths are reported with the message
+// key suffixed with ".len".
+func compareRule(n int, ok func(v float64, params []float64) bool) builtinFunc {
+	return func(rv reflect.Value, r ruleSpec) (string, error) {
+		params, err := floatParams(r.params, n)
+		if err != nil {
+			return "", err
+		}
+		v, isLen, err := measure(rv)
+		if err != nil {
+			return "", err
+		}
+		switch {
+		case ok(v, params):
+			return "", nil
+		case isLen:
+			return r.name + ".len", nil
+		}
+		return r.name, nil
+	}
+}
+
+// lenRule checks the exact length of a value.
+func lenRule(rv reflect.Value, r ruleSpec) (string, error) {
+	params, err := floatParams(r.params, 1)
+	if err != nil {
+		return "", err
+	}
+	v, isLen, err := measure(rv)
+	if err != nil {
+		return "", err
+	}
+	if !isLen {
+		return "", fmt.Errorf("cannot measure the length of %s", rv.Type())
+	}
+	if v != params[0] {
+		return r.name, nil
+	}
+	return "", nil
+}
+
+// inRule returns a rule checking that the value is one of the parameters,
+// or is not if `in` is false.
+func inRule(in bool) builtinFunc {
+	return func(rv reflect.Value, r ruleSpec) (string, error) {
+		if slices.Contains(r.params, toString(rv)) != in {
+			return r.name, nil
+		}
+		return "", nil
+	}
+}
+
+// stringRule returns a rule checking the string form of a value with `ok`.
+func stringRule(ok func(s string) bool) builtinFunc {
+	return func(rv reflect.Value, r ruleSpec) (string, error) {
+		if !ok(toString(rv)) {
+			return r.name, nil
+		}
+		return "", nil
+	}
+}
+
+// regexRule returns a rule checking that the string form of a value matches
+// the pattern, or does not if `match` is false.
+func regexRule(match bool) builtinFunc {
+	return func(rv reflect.Value, r ruleSpec) (string, error) {
+		if r.re == nil {
+			return "", errors.New("missing pattern")
+		}
+		if r.re.MatchString(toString(rv)) != match {
+			return r.name, nil
+		}
+		return "", nil
+	}
+}
+
+// measure returns the number of a numeric value, or the length of another
+// one, reporting which.
+func measure(rv reflect.Value) (v float64, isLen bool, err error) {
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), false, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(rv.Uint()), false, nil
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), false, nil
+	case reflect.String:
+		return float64(utf8.RuneCountInString(rv.String())), true, nil
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return float64(rv.Len()), true, nil
+	}
+	return 0, false, fmt.Errorf("cannot compare %s", rv.Type())
+}
+
+// floatParams parses `params`, which must number `n`.
+func floatParams(params []string, n int) ([]float64, error) {
+	if len(params) != n {
+		return nil, fmt.Errorf("want %d parameters, got %d", n, len(params))
+	}
+	floats := make([]float64, n)
+	for i, p := range params {
+		f, err := strconv.ParseFloat(p, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid parameter %q", p)
+		}
+		floats[i] = f
+	}
+	return floats, nil
+}
+
+// toString returns the string form of `rv`.
+func toString(rv reflect.Value) string {
+	if rv.Kind() == reflect.String {
+		return rv.String()
+	}
+	return fmt.Sprint(rv.Interface())
+}
+
+// allRunes returns a check that all runes of a string satisfy `ok`.
+func allRunes(ok func(r rune) bool) func(s string) bool {
+	return func(s string) bool {
+		for _, r := range s {
+			if !ok(r) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// isEmail reports whether `s` is a bare email address.
+func isEmail(s string) bool {
+	addr, err := mail.ParseAddress(s)
+	return err == nil && addr.Address == s
+}
+
+// isURL reports whether `s` is an absolute URL with a host.
+func isURL(s string) bool {
+	u, err := url.Parse(s)
+	return err == nil && u.Scheme != "" && u.Host != ""
+}